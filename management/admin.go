@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -13,13 +16,15 @@ import (
 
 type AdminService struct {
 	k8sClient *K8sClient
-	config    *Config
+
+	summaryMu        sync.Mutex
+	summary          *AdminSummaryResponse
+	summaryFetchedAt time.Time
 }
 
 func NewAdminService(k8sClient *K8sClient) *AdminService {
 	return &AdminService{
 		k8sClient: k8sClient,
-		config:    NewConfig(),
 	}
 }
 
@@ -123,7 +128,7 @@ func (s *AdminService) GetTenants(c *gin.Context) {
 	// Filter for tenant namespaces
 	var tenants []NamespaceInfo
 	for _, ns := range namespaces {
-		if s.config.IsValidTenant(ns.Name) {
+		if ActiveConfig().IsValidTenant(ns.Name) {
 			tenants = append(tenants, NamespaceInfo{
 				Name:      ns.Name,
 				Status:    string(ns.Status.Phase),
@@ -555,78 +560,13 @@ func (s *AdminService) GetResources(c *gin.Context) {
 	// Convert InferenceServices to response format
 	var inferenceServiceInfos []InferenceServiceInfo
 	for _, is := range inferenceServices {
-		metadata := is["metadata"].(map[string]interface{})
-		
-		ready := false
-		url := ""
-		framework := ""
-		
-		if status, ok := is["status"].(map[string]interface{}); ok {
-			if conditions, ok := status["conditions"].([]interface{}); ok {
-				for _, condition := range conditions {
-					if c, ok := condition.(map[string]interface{}); ok {
-						if c["type"].(string) == "Ready" && c["status"].(string) == "True" {
-							ready = true
-							break
-						}
-					}
-				}
-			}
-			if u, ok := status["url"].(string); ok {
-				url = u
-			}
-		}
-		
-		if spec, ok := is["spec"].(map[string]interface{}); ok {
-			if predictor, ok := spec["predictor"].(map[string]interface{}); ok {
-				for key := range predictor {
-					if key != "serviceAccountName" && key != "containers" {
-						framework = key
-						break
-					}
-				}
-			}
-		}
-		
-		inferenceServiceInfos = append(inferenceServiceInfos, InferenceServiceInfo{
-			Name:      metadata["name"].(string),
-			Namespace: metadata["namespace"].(string),
-			Ready:     ready,
-			URL:       url,
-			Framework: framework,
-			CreatedAt: parseTime(metadata["creationTimestamp"].(string)),
-		})
+		inferenceServiceInfos = append(inferenceServiceInfos, toInferenceServiceInfo(is))
 	}
 
 	// Convert ServingRuntimes to response format
 	var servingRuntimeInfos []ServingRuntimeInfo
 	for _, sr := range servingRuntimes {
-		metadata := sr["metadata"].(map[string]interface{})
-		spec := sr["spec"].(map[string]interface{})
-		
-		disabled := false
-		if d, ok := spec["disabled"].(bool); ok {
-			disabled = d
-		}
-		
-		var modelFormat []string
-		if supportedModelFormats, ok := spec["supportedModelFormats"].([]interface{}); ok {
-			for _, format := range supportedModelFormats {
-				if f, ok := format.(map[string]interface{}); ok {
-					if name, ok := f["name"].(string); ok {
-						modelFormat = append(modelFormat, name)
-					}
-				}
-			}
-		}
-		
-		servingRuntimeInfos = append(servingRuntimeInfos, ServingRuntimeInfo{
-			Name:        metadata["name"].(string),
-			Namespace:   metadata["namespace"].(string),
-			Disabled:    disabled,
-			ModelFormat: modelFormat,
-			CreatedAt:   parseTime(metadata["creationTimestamp"].(string)),
-		})
+		servingRuntimeInfos = append(servingRuntimeInfos, toServingRuntimeInfo(sr))
 	}
 
 	// Convert ClusterServingRuntimes to response format
@@ -640,21 +580,10 @@ func (s *AdminService) GetResources(c *gin.Context) {
 			disabled = d
 		}
 		
-		var modelFormat []string
-		if supportedModelFormats, ok := spec["supportedModelFormats"].([]interface{}); ok {
-			for _, format := range supportedModelFormats {
-				if f, ok := format.(map[string]interface{}); ok {
-					if name, ok := f["name"].(string); ok {
-						modelFormat = append(modelFormat, name)
-					}
-				}
-			}
-		}
-		
 		clusterServingRuntimeInfos = append(clusterServingRuntimeInfos, ClusterServingRuntimeInfo{
 			Name:        metadata["name"].(string),
 			Disabled:    disabled,
-			ModelFormat: modelFormat,
+			ModelFormat: modelFormatNames(servingRuntimeModelFormats(spec)),
 			CreatedAt:   parseTime(metadata["creationTimestamp"].(string)),
 		})
 	}
@@ -676,6 +605,139 @@ func (s *AdminService) GetResources(c *gin.Context) {
 	})
 }
 
+// toInferenceServiceInfo converts an InferenceService object to its admin summary
+func toInferenceServiceInfo(is map[string]interface{}) InferenceServiceInfo {
+	metadata := is["metadata"].(map[string]interface{})
+	
+	ready := false
+	url := ""
+	framework := ""
+	
+	if status, ok := is["status"].(map[string]interface{}); ok {
+		if conditions, ok := status["conditions"].([]interface{}); ok {
+			for _, condition := range conditions {
+				if c, ok := condition.(map[string]interface{}); ok {
+					if c["type"].(string) == "Ready" && c["status"].(string) == "True" {
+						ready = true
+						break
+					}
+				}
+			}
+		}
+		if u, ok := status["url"].(string); ok {
+			url = u
+		}
+	}
+	
+	if spec, ok := is["spec"].(map[string]interface{}); ok {
+		framework = detectFramework(spec)
+	}
+	
+	return InferenceServiceInfo{
+		Name:      metadata["name"].(string),
+		Namespace: metadata["namespace"].(string),
+		Ready:     ready,
+		URL:       url,
+		Framework: framework,
+		CreatedBy: modelCreatedBy(is),
+		CreatedAt: parseTime(metadata["creationTimestamp"].(string)),
+	}
+}
+
+// ListModels handles GET /api/admin/models
+// It lists InferenceServices across namespaces, filtered by framework, readiness, namespace, name
+// and creator.
+func (s *AdminService) ListModels(c *gin.Context) {
+	framework := strings.ToLower(c.Query("framework"))
+	namespace := c.Query("namespace")
+	search := strings.ToLower(c.Query("search"))
+	// Sanitized like the label, so a user name such as an email matches its label value
+	createdBy := c.Query("createdBy")
+	if createdBy != "" {
+		createdBy = sanitizeLabelValue(createdBy)
+		if createdBy == "" {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "CreatedBy must contain at least one letter or digit",
+			})
+			return
+		}
+	}
+	
+	readyFilter := c.Query("ready")
+	if readyFilter != "" && readyFilter != "true" && readyFilter != "false" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Ready must be true or false",
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 || limit > 500 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Limit must be between 1 and 500",
+		})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Offset must be a non-negative integer",
+		})
+		return
+	}
+	
+	// An empty namespace lists across all namespaces
+	inferenceServices, err := s.k8sClient.GetInferenceServices(namespace)
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to list models",
+			Details: err.Error(),
+		})
+		return
+	}
+	
+	models := []InferenceServiceInfo{}
+	for _, is := range inferenceServices {
+		info := toInferenceServiceInfo(is)
+		if framework != "" && strings.ToLower(info.Framework) != framework {
+			continue
+		}
+		if readyFilter != "" && info.Ready != (readyFilter == "true") {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(info.Name), search) {
+			continue
+		}
+		if createdBy != "" && info.CreatedBy != createdBy {
+			continue
+		}
+		models = append(models, info)
+	}
+	
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].Namespace != models[j].Namespace {
+			return models[i].Namespace < models[j].Namespace
+		}
+		return models[i].Name < models[j].Name
+	})
+	
+	total := len(models)
+	page := []InferenceServiceInfo{}
+	if offset < total {
+		end := offset + limit
+		if end > total {
+			end = total
+		}
+		page = models[offset:end]
+	}
+	
+	c.JSON(http.StatusOK, AdminModelsResponse{
+		Models: page,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
 // GetLogs handles GET /api/admin/logs
 func (s *AdminService) GetLogs(c *gin.Context) {
 	namespace := c.Query("namespace")
@@ -684,13 +746,20 @@ func (s *AdminService) GetLogs(c *gin.Context) {
 
 	lines := 100
 	if linesParam != "" {
-		if parsedLines, err := strconv.Atoi(linesParam); err == nil {
+		if parsedLines, err := strconv.Atoi(linesParam); err == nil && parsedLines > 0 {
 			lines = parsedLines
 		}
 	}
 
+	config := ActiveConfig()
+	timeout, err := parseDuration(config.SystemLogsTimeout, time.Second, 5*time.Minute)
+	if err != nil {
+		log.Printf("Invalid SYSTEM_LOGS_TIMEOUT: %v, using 15s", err)
+		timeout = 15 * time.Second
+	}
+
 	// Get system logs
-	logs, err := s.k8sClient.GetSystemLogs(namespace, component, lines)
+	logs, err := s.k8sClient.GetSystemLogs(namespace, component, lines, config.SystemLogsConcurrency, timeout)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "Failed to get logs",
@@ -731,42 +800,42 @@ func (s *AdminService) ExecuteKubectl(c *gin.Context) {
 	})
 }
 
-// GetAIGatewayService handles GET /api/admin/ai-gateway-service
-func (s *AdminService) GetAIGatewayService(c *gin.Context) {
-	// First try to get istio-ingressgateway service (preferred for DNS resolution)
-	istioServices, err := s.k8sClient.GetServices("istio-system")
-	if err == nil {
-		// Find the istio-ingressgateway service
-		for _, service := range istioServices {
-			if service.Name == "istio-ingressgateway" {
-				serviceInfo := map[string]interface{}{
-					"name":      service.Name,
-					"namespace": service.Namespace,
-					"type":      string(service.Spec.Type),
-					"clusterIP": service.Spec.ClusterIP,
-					"ports":     service.Spec.Ports,
-					"gateway":   "istio-ingressgateway",
-				}
+// ReloadConfig handles POST /api/admin/config/reload
+// It re-reads the environment and the override ConfigMap and swaps in the new configuration.
+func (s *AdminService) ReloadConfig(c *gin.Context) {
+	changed, restartRequired, err := ReloadConfig(s.k8sClient)
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to reload configuration",
+			Details: err.Error(),
+		})
+		return
+	}
 
-				// Add external IP if available
-				if len(service.Status.LoadBalancer.Ingress) > 0 {
-					ingress := service.Status.LoadBalancer.Ingress[0]
-					if ingress.IP != "" {
-						serviceInfo["externalIP"] = ingress.IP
-					}
-					if ingress.Hostname != "" {
-						serviceInfo["externalHostname"] = ingress.Hostname
-					}
-				}
+	if changed == nil {
+		changed = []string{}
+	}
+	if restartRequired == nil {
+		restartRequired = []string{}
+	}
+	log.Printf("Configuration reloaded: changed %v, restart required for %v", changed, restartRequired)
 
-				c.JSON(http.StatusOK, serviceInfo)
-				return
-			}
-		}
+	message := fmt.Sprintf("Configuration reloaded, %d setting(s) changed", len(changed))
+	if len(restartRequired) > 0 {
+		message += fmt.Sprintf(", %d setting(s) need a restart to take effect", len(restartRequired))
 	}
 
-	// Fallback to envoy-gateway service
-	services, err := s.k8sClient.GetServices("envoy-gateway-system")
+	c.JSON(http.StatusOK, ConfigReloadResponse{
+		Message:         message,
+		Changed:         changed,
+		RestartRequired: restartRequired,
+		ReloadedAt:      time.Now(),
+	})
+}
+
+// GetAIGatewayService handles GET /api/admin/ai-gateway-service
+func (s *AdminService) GetAIGatewayService(c *gin.Context) {
+	gatewayService, gateway, err := s.findGatewayService()
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "Failed to get gateway services",
@@ -775,15 +844,6 @@ func (s *AdminService) GetAIGatewayService(c *gin.Context) {
 		return
 	}
 
-	// Find the envoy-gateway service
-	var gatewayService *corev1.Service
-	for _, service := range services {
-		if service.Name == "envoy-gateway" {
-			gatewayService = &service
-			break
-		}
-	}
-
 	if gatewayService == nil {
 		c.JSON(http.StatusNotFound, ErrorResponse{
 			Error: "No gateway service found (tried istio-ingressgateway and envoy-gateway)",
@@ -798,7 +858,7 @@ func (s *AdminService) GetAIGatewayService(c *gin.Context) {
 		"type":      string(gatewayService.Spec.Type),
 		"clusterIP": gatewayService.Spec.ClusterIP,
 		"ports":     gatewayService.Spec.Ports,
-		"gateway":   "envoy-gateway",
+		"gateway":   gateway,
 	}
 
 	// Add external IP if available
@@ -815,6 +875,31 @@ func (s *AdminService) GetAIGatewayService(c *gin.Context) {
 	c.JSON(http.StatusOK, serviceInfo)
 }
 
+// findGatewayService returns the gateway service and which gateway it belongs to, or a nil
+// service when neither exists. The istio-ingressgateway is preferred for DNS resolution, and the
+// envoy-gateway service is the fallback.
+func (s *AdminService) findGatewayService() (*corev1.Service, string, error) {
+	istioServices, err := s.k8sClient.GetServices("istio-system")
+	if err == nil {
+		for i := range istioServices {
+			if istioServices[i].Name == "istio-ingressgateway" {
+				return &istioServices[i], "istio-ingressgateway", nil
+			}
+		}
+	}
+
+	services, err := s.k8sClient.GetServices("envoy-gateway-system")
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range services {
+		if services[i].Name == "envoy-gateway" {
+			return &services[i], "envoy-gateway", nil
+		}
+	}
+	return nil, "", nil
+}
+
 // Helper function to convert resource list to map
 func convertResourceList(resources corev1.ResourceList) map[string]interface{} {
 	result := make(map[string]interface{})
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// How long a computed admin summary is served before the cluster is queried again
+const adminSummaryCacheTTL = 10 * time.Second
+
+// GetSummary handles GET /api/admin/summary
+// It returns the counts of the admin overview in one response. Add ?refresh=true to bypass the cache.
+func (s *AdminService) GetSummary(c *gin.Context) {
+	s.summaryMu.Lock()
+	cached, fetchedAt := s.summary, s.summaryFetchedAt
+	s.summaryMu.Unlock()
+	if cached != nil && c.Query("refresh") != "true" && time.Since(fetchedAt) < adminSummaryCacheTTL {
+		c.Header("X-Cache", "HIT")
+		c.JSON(http.StatusOK, cached)
+		return
+	}
+
+	var (
+		nodes             []corev1.Node
+		pods              []corev1.Pod
+		inferenceServices []map[string]interface{}
+		publishedModels   []map[string]interface{}
+		apiKeys           []map[string]interface{}
+	)
+
+	// The sources are independent, so list them all at once
+	fetches := []struct {
+		what  string
+		fetch func() error
+	}{
+		{"nodes", func() (err error) { nodes, err = s.k8sClient.GetNodes(); return }},
+		{"pods", func() (err error) { pods, err = s.k8sClient.GetPods(""); return }},
+		{"models", func() (err error) { inferenceServices, err = s.k8sClient.GetInferenceServices(""); return }},
+		{"published models", func() (err error) { publishedModels, err = s.k8sClient.ListPublishedModels(""); return }},
+		{"API keys", func() (err error) { apiKeys, err = s.k8sClient.ListAPIKeySecrets(""); return }},
+	}
+	errs := make([]error, len(fetches))
+	var wg sync.WaitGroup
+	for i, f := range fetches {
+		wg.Add(1)
+		go func(i int, fetch func() error) {
+			defer wg.Done()
+			errs[i] = fetch()
+		}(i, f.fetch)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+				Error:   "Failed to get " + fetches[i].what,
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	summary := &AdminSummaryResponse{
+		PublishedModels: len(publishedModels),
+		APIKeys:         len(apiKeys),
+		Tenants:         []TenantModelSummary{},
+		GeneratedAt:     time.Now(),
+	}
+
+	tenants := make(map[string]*TenantModelSummary)
+	tenant := func(namespace string) *TenantModelSummary {
+		if tenants[namespace] == nil {
+			tenants[namespace] = &TenantModelSummary{Namespace: namespace}
+		}
+		return tenants[namespace]
+	}
+	for _, is := range inferenceServices {
+		info := toInferenceServiceInfo(is)
+		t := tenant(info.Namespace)
+		t.Models++
+		summary.Models.Total++
+		if info.Ready {
+			t.Ready++
+			summary.Models.Ready++
+		}
+	}
+	for _, metadata := range publishedModels {
+		if namespace, ok := metadata["namespace"].(string); ok {
+			tenant(namespace).PublishedModels++
+		}
+	}
+	for _, t := range tenants {
+		summary.Tenants = append(summary.Tenants, *t)
+	}
+	sort.Slice(summary.Tenants, func(i, j int) bool {
+		return summary.Tenants[i].Namespace < summary.Tenants[j].Namespace
+	})
+
+	for _, node := range nodes {
+		summary.Nodes.Total++
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+				summary.Nodes.Ready++
+			}
+		}
+	}
+
+	for _, pod := range pods {
+		summary.Pods.Total++
+		if podUnhealthy(pod) {
+			summary.Pods.Unhealthy++
+		}
+	}
+
+	s.summaryMu.Lock()
+	s.summary, s.summaryFetchedAt = summary, time.Now()
+	s.summaryMu.Unlock()
+
+	c.Header("X-Cache", "MISS")
+	c.JSON(http.StatusOK, summary)
+}
+
+// podUnhealthy reports whether a pod is failed, stuck or running with a container that is not ready.
+// Completed pods are healthy.
+func podUnhealthy(pod corev1.Pod) bool {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return false
+	case corev1.PodRunning:
+		for _, status := range pod.Status.ContainerStatuses {
+			if !status.Ready {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
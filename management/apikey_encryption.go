@@ -0,0 +1,102 @@
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// Prefix of API key values encrypted with API_KEY_ENCRYPTION_KEY. Values without it are plaintext.
+const encryptedAPIKeyPrefix = "enc:v1:"
+
+// apiKeyCipher returns an AES-256-GCM cipher for the configured encryption key, or nil when
+// encryption is disabled
+func apiKeyCipher(config *Config) (cipher.AEAD, error) {
+	if config.APIKeyEncryptionKey == "" {
+		return nil, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(config.APIKeyEncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("API_KEY_ENCRYPTION_KEY is not valid base64: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("API_KEY_ENCRYPTION_KEY must decode to 32 bytes, got %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// apiKeyAssociatedData binds a ciphertext to its tenant and model, so a stored value copied into
+// another namespace's secret does not decrypt
+func apiKeyAssociatedData(namespace, modelName string) []byte {
+	return []byte(namespace + "/" + modelName)
+}
+
+// encryptAPIKey encrypts an API key for storage. It returns the key unchanged when encryption is disabled.
+func encryptAPIKey(config *Config, namespace, modelName, apiKey string) (string, error) {
+	aead, err := apiKeyCipher(config)
+	if err != nil || aead == nil {
+		return apiKey, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(apiKey), apiKeyAssociatedData(namespace, modelName))
+	return encryptedAPIKeyPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptAPIKey returns the plaintext of a stored API key. Plaintext values written before
+// encryption was enabled are returned as is.
+func decryptAPIKey(config *Config, namespace, modelName, stored string) (string, error) {
+	if !strings.HasPrefix(stored, encryptedAPIKeyPrefix) {
+		return stored, nil
+	}
+
+	aead, err := apiKeyCipher(config)
+	if err != nil {
+		return "", err
+	}
+	if aead == nil {
+		return "", fmt.Errorf("API key is encrypted but API_KEY_ENCRYPTION_KEY is not set")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, encryptedAPIKeyPrefix))
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("malformed encrypted API key")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, apiKeyAssociatedData(namespace, modelName))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt API key: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// storedAPIKeyMatches reports whether an API key secret, as returned by ListAPIKeySecrets,
+// holds the given key
+func storedAPIKeyMatches(config *Config, namespace string, secret map[string]interface{}, apiKey string) bool {
+	stored, ok := secret["apiKey"].(string)
+	if !ok {
+		return false
+	}
+	modelName, _ := secret["modelName"].(string)
+
+	plaintext, err := decryptAPIKey(config, namespace, modelName, stored)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(plaintext), []byte(apiKey)) == 1
+}
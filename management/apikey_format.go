@@ -0,0 +1,80 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// Bounds for the random part of API keys. 16 bytes keeps every key at 128 bits of entropy or more.
+const (
+	minAPIKeyBytes     = 16
+	maxAPIKeyBytes     = 128
+	defaultAPIKeyBytes = 32
+)
+
+// Encodings of the random part of API keys. base62 and hex are alphanumeric, for gateways and
+// clients that cannot handle the - and _ of base64url.
+const (
+	APIKeyEncodingBase64URL = "base64url"
+	APIKeyEncodingBase62    = "base62"
+	APIKeyEncodingHex       = "hex"
+)
+
+// generateAPIKeySecret returns byteLength random bytes in the given encoding
+func generateAPIKeySecret(byteLength int, encoding string) (string, error) {
+	keyBytes := make([]byte, byteLength)
+	if _, err := rand.Read(keyBytes); err != nil {
+		return "", err
+	}
+	return encodeAPIKeySecret(keyBytes, encoding)
+}
+
+// encodeAPIKeySecret encodes the random part of an API key. base62 output is left-padded to a
+// fixed length, so every key of a configuration has the same length and leading zero bytes keep
+// their entropy.
+func encodeAPIKeySecret(keyBytes []byte, encoding string) (string, error) {
+	switch encoding {
+	case APIKeyEncodingBase64URL:
+		return base64.RawURLEncoding.EncodeToString(keyBytes), nil
+	case APIKeyEncodingHex:
+		return hex.EncodeToString(keyBytes), nil
+	case APIKeyEncodingBase62:
+		encoded := new(big.Int).SetBytes(keyBytes).Text(62)
+		length := int(math.Ceil(float64(len(keyBytes)*8) / math.Log2(62)))
+		if len(encoded) < length {
+			encoded = strings.Repeat("0", length-len(encoded)) + encoded
+		}
+		return encoded, nil
+	default:
+		return "", fmt.Errorf("unsupported API key encoding %q", encoding)
+	}
+}
+
+// loadAPIKeyBytes reads API_KEY_BYTES. Values outside the allowed range fall back to the default,
+// so a misconfiguration never produces weak keys.
+func loadAPIKeyBytes() int {
+	byteLength := getEnvInt("API_KEY_BYTES", defaultAPIKeyBytes)
+	if byteLength < minAPIKeyBytes || byteLength > maxAPIKeyBytes {
+		log.Printf("Invalid API_KEY_BYTES %d, must be between %d and %d; using %d", byteLength, minAPIKeyBytes, maxAPIKeyBytes, defaultAPIKeyBytes)
+		return defaultAPIKeyBytes
+	}
+	return byteLength
+}
+
+// loadAPIKeyEncoding reads API_KEY_ENCODING, falling back to base64url for unknown encodings
+func loadAPIKeyEncoding() string {
+	encoding := strings.ToLower(strings.TrimSpace(getEnv("API_KEY_ENCODING", APIKeyEncodingBase64URL)))
+	switch encoding {
+	case APIKeyEncodingBase64URL, APIKeyEncodingBase62, APIKeyEncodingHex:
+		return encoding
+	default:
+		log.Printf("Invalid API_KEY_ENCODING %q, must be base64url, base62 or hex; using base64url", encoding)
+		return APIKeyEncodingBase64URL
+	}
+}
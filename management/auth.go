@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
 	"time"
@@ -12,13 +13,11 @@ import (
 )
 
 type AuthService struct {
-	config    *Config
 	k8sClient *K8sClient
 }
 
-func NewAuthService(config *Config, k8sClient *K8sClient) *AuthService {
+func NewAuthService(k8sClient *K8sClient) *AuthService {
 	return &AuthService{
-		config:    config,
 		k8sClient: k8sClient,
 	}
 }
@@ -106,6 +105,11 @@ func (s *AuthService) ValidateToken(tokenString string) (*User, error) {
 		return nil, fmt.Errorf("invalid token claims")
 	}
 
+	// Impersonation tokens are issued by this service, so their signature and expiry are checked
+	if iss, _ := claims["iss"].(string); iss == impersonationTokenIssuer {
+		return verifyImpersonationToken(tokenString)
+	}
+
 	// Extract tenant information
 	tenant, ok := claims["tenant"].(string)
 	if !ok || tenant == "" {
@@ -148,7 +152,8 @@ func (s *AuthService) AdminLogin(c *gin.Context) {
 		return
 	}
 
-	if req.Username == s.config.SuperAdminUsername && req.Password == s.config.SuperAdminPassword {
+	config := ActiveConfig()
+	if req.Username == config.SuperAdminUsername && req.Password == config.SuperAdminPassword {
 		response := LoginResponse{
 			Token: "super-admin-token",
 			User: User{
@@ -271,8 +276,12 @@ func (s *AuthService) ValidateAPIKey(apiKey string) (*User, error) {
 
 // findAPIKeyMetadata searches for API key metadata in all namespaces
 func (s *AuthService) findAPIKeyMetadata(apiKey string) (*APIKeyMetadata, error) {
-	// Search for API key across all namespaces
+	// Search for API key across all namespaces, or only the one a prefixed key names
 	namespaces := []string{"tenant-a", "tenant-b", "tenant-c"}
+	if namespace, shortID, ok := parseAPIKeyPrefix(apiKey); ok {
+		log.Printf("Looking up API key %s in namespace %s", shortID, namespace)
+		namespaces = []string{namespace}
+	}
 	
 	for _, namespace := range namespaces {
 		// Get all API key secrets in this namespace
@@ -283,7 +292,7 @@ func (s *AuthService) findAPIKeyMetadata(apiKey string) (*APIKeyMetadata, error)
 		
 		for _, secret := range secrets {
 			// Check if this secret contains the API key
-			if storedKey, ok := secret["apiKey"].(string); ok && storedKey == apiKey {
+			if storedAPIKeyMatches(ActiveConfig(), namespace, secret, apiKey) {
 				// Found matching API key, construct metadata
 				metadata := &APIKeyMetadata{
 					Namespace: namespace,
@@ -356,6 +365,7 @@ func (s *AuthService) GetTenantInfo(c *gin.Context) {
 		Issuer:    u.Issuer,
 		Audience:  u.Audience,
 		ExpiresAt: expiresAt,
+		ImpersonatedBy: u.ImpersonatedBy,
 	}
 
 	// Use subject as fallback for user name
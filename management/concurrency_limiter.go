@@ -0,0 +1,261 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConcurrencyLimiter bounds in-flight requests per tenant and per tenant+model. A limit of 0
+// disables that level. A request over a limit waits in its model's queue for up to
+// queueTimeout, and is rejected when the queue is full or the wait runs out. The counts are
+// kept for every tenant and model so proxy saturation can be observed.
+//
+// At most maxProxyLoadEntries tenants and models are tracked. Past that, the counters of those
+// with no requests in flight or queued are dropped, and their totals start over on their next request.
+type ConcurrencyLimiter struct {
+	perModel     int
+	perTenant    int
+	maxQueued    int           // Requests per model that may wait for a slot, 0 disables queuing
+	queueTimeout time.Duration // How long a queued request waits before it is rejected
+
+	mu       sync.Mutex
+	loads    map[string]*proxyLoadCounters
+	released chan struct{} // Closed and replaced whenever a slot is released, to wake queued requests
+}
+
+// proxyLoadCounters tracks the requests of one tenant or tenant+model since the service started
+type proxyLoadCounters struct {
+	inFlight    int64
+	queued      int64
+	admitted    int64
+	queuedTotal int64
+	rejected    int64
+}
+
+// NewConcurrencyLimiter creates a new limiter with the given per-model and per-tenant limits
+// and per-model wait queue
+func NewConcurrencyLimiter(perModel, perTenant, maxQueued int, queueTimeout time.Duration) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{
+		perModel:     perModel,
+		perTenant:    perTenant,
+		maxQueued:    maxQueued,
+		queueTimeout: queueTimeout,
+		loads:        make(map[string]*proxyLoadCounters),
+		released:     make(chan struct{}),
+	}
+}
+
+// maxProxyLoadEntries bounds the number of tenants and models whose counters are kept
+const maxProxyLoadEntries = 10000
+
+func tenantLoadKey(tenant string) string {
+	return "tenant/" + tenant
+}
+
+func modelLoadKey(tenant, modelName string) string {
+	return "model/" + tenant + "/" + modelName
+}
+
+// Acquire reserves a slot for the tenant and model, queuing the request while a limit is
+// reached. It returns a release function and true on success, or false when the request was
+// rejected. A rejection is counted only at the level whose limit turned the request away.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context, tenant, modelName string) (func(), bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Make room before looking up the counters, so the ones used below are never dropped
+	if len(l.loads) >= maxProxyLoadEntries {
+		l.dropIdle()
+	}
+	tenantLoad := l.load(tenantLoadKey(tenant))
+	modelLoad := l.load(modelLoadKey(tenant, modelName))
+
+	limited := l.limitedAt(tenantLoad, modelLoad)
+	if limited != nil && modelLoad.queued < int64(l.maxQueued) {
+		limited = l.wait(ctx, tenantLoad, modelLoad)
+	}
+	if limited != nil {
+		limited.rejected++
+		return nil, false
+	}
+
+	tenantLoad.inFlight++
+	tenantLoad.admitted++
+	modelLoad.inFlight++
+	modelLoad.admitted++
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		modelLoad.inFlight--
+		tenantLoad.inFlight--
+		close(l.released)
+		l.released = make(chan struct{})
+	}, true
+}
+
+// limitedAt returns the counters of the level whose limit is reached, checking the model
+// before the tenant, or nil when a slot is free. Callers hold l.mu.
+func (l *ConcurrencyLimiter) limitedAt(tenantLoad, modelLoad *proxyLoadCounters) *proxyLoadCounters {
+	if atLimit(modelLoad, l.perModel) {
+		return modelLoad
+	}
+	if atLimit(tenantLoad, l.perTenant) {
+		return tenantLoad
+	}
+	return nil
+}
+
+// wait queues a request until a slot is free, the queue timeout passes or ctx is done. It
+// returns the counters of the level still at its limit, or nil when the request can be
+// admitted. Callers hold l.mu, which is released while waiting.
+func (l *ConcurrencyLimiter) wait(ctx context.Context, tenantLoad, modelLoad *proxyLoadCounters) *proxyLoadCounters {
+	tenantLoad.queued++
+	tenantLoad.queuedTotal++
+	modelLoad.queued++
+	modelLoad.queuedTotal++
+	defer func() {
+		tenantLoad.queued--
+		modelLoad.queued--
+	}()
+
+	timer := time.NewTimer(l.queueTimeout)
+	defer timer.Stop()
+
+	for {
+		released := l.released
+		l.mu.Unlock()
+		select {
+		case <-released:
+		case <-timer.C:
+			l.mu.Lock()
+			return l.limitedAt(tenantLoad, modelLoad)
+		case <-ctx.Done():
+			l.mu.Lock()
+			return l.limitedAt(tenantLoad, modelLoad)
+		}
+		l.mu.Lock()
+
+		if limited := l.limitedAt(tenantLoad, modelLoad); limited == nil {
+			return nil
+		}
+	}
+}
+
+// load returns the counters for key, creating them on first use. Callers hold l.mu.
+func (l *ConcurrencyLimiter) load(key string) *proxyLoadCounters {
+	counters, ok := l.loads[key]
+	if !ok {
+		counters = &proxyLoadCounters{}
+		l.loads[key] = counters
+	}
+	return counters
+}
+
+// dropIdle removes the counters of tenants and models with no requests in flight or queued.
+// Callers hold l.mu.
+func (l *ConcurrencyLimiter) dropIdle() {
+	for key, counters := range l.loads {
+		if counters.inFlight == 0 && counters.queued == 0 {
+			delete(l.loads, key)
+		}
+	}
+}
+
+func atLimit(counters *proxyLoadCounters, limit int) bool {
+	return limit > 0 && counters.inFlight >= int64(limit)
+}
+
+// stats converts the counters of key to their API form
+func (l *ConcurrencyLimiter) stats(key string, limit int) ProxyLoadStats {
+	stats := ProxyLoadStats{Limit: limit}
+	if counters, ok := l.loads[key]; ok {
+		stats.InFlight = counters.inFlight
+		stats.Queued = counters.queued
+		stats.Admitted = counters.admitted
+		stats.QueuedTotal = counters.queuedTotal
+		stats.Rejected = counters.rejected
+	}
+	if limit > 0 {
+		stats.Utilization = float64(stats.InFlight) / float64(limit)
+	}
+	return stats
+}
+
+// Load returns the current proxy load of a model and of its tenant
+func (l *ConcurrencyLimiter) Load(tenant, modelName string) *ProxyLoad {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return &ProxyLoad{
+		Model:  l.stats(modelLoadKey(tenant, modelName), l.perModel),
+		Tenant: l.stats(tenantLoadKey(tenant), l.perTenant),
+	}
+}
+
+// Snapshot returns the proxy load of every tenant and model that has sent requests, sorted by
+// namespace and model name
+func (l *ConcurrencyLimiter) Snapshot() ProxyLoadResponse {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	response := ProxyLoadResponse{
+		Tenants: []TenantProxyLoad{},
+		Models:  []ModelProxyLoad{},
+	}
+	for key := range l.loads {
+		if tenant, ok := strings.CutPrefix(key, "tenant/"); ok {
+			response.Tenants = append(response.Tenants, TenantProxyLoad{
+				Namespace:      tenant,
+				ProxyLoadStats: l.stats(key, l.perTenant),
+			})
+			continue
+		}
+		tenant, modelName, _ := strings.Cut(strings.TrimPrefix(key, "model/"), "/")
+		response.Models = append(response.Models, ModelProxyLoad{
+			Namespace:      tenant,
+			ModelName:      modelName,
+			ProxyLoadStats: l.stats(key, l.perModel),
+		})
+	}
+
+	sort.Slice(response.Tenants, func(i, j int) bool {
+		return response.Tenants[i].Namespace < response.Tenants[j].Namespace
+	})
+	sort.Slice(response.Models, func(i, j int) bool {
+		if response.Models[i].Namespace != response.Models[j].Namespace {
+			return response.Models[i].Namespace < response.Models[j].Namespace
+		}
+		return response.Models[i].ModelName < response.Models[j].ModelName
+	})
+	return response
+}
+
+// proxyLoadMetrics renders the proxy load of a model and its tenant in Prometheus text format
+func proxyLoadMetrics(tenant, modelName string, load *ProxyLoad) string {
+	var b strings.Builder
+	write := func(name, help, metricType, labels string, value interface{}) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n%s{%s} %v\n", name, help, name, metricType, name, labels, value)
+	}
+
+	modelLabels := fmt.Sprintf("namespace=%q,model=%q", tenant, modelName)
+	write("management_proxy_model_in_flight_requests", "Prediction proxy requests in flight for the model.", "gauge", modelLabels, load.Model.InFlight)
+	write("management_proxy_model_queued_requests", "Prediction proxy requests waiting for a slot for the model.", "gauge", modelLabels, load.Model.Queued)
+	write("management_proxy_model_concurrency_limit", "In-flight request limit per model, 0 when unlimited.", "gauge", modelLabels, load.Model.Limit)
+	write("management_proxy_model_admitted_requests_total", "Prediction proxy requests admitted for the model.", "counter", modelLabels, load.Model.Admitted)
+	write("management_proxy_model_queued_requests_total", "Prediction proxy requests for the model that waited for a slot.", "counter", modelLabels, load.Model.QueuedTotal)
+	write("management_proxy_model_rejected_requests_total", "Prediction proxy requests rejected at the model's concurrency limit.", "counter", modelLabels, load.Model.Rejected)
+
+	tenantLabels := fmt.Sprintf("namespace=%q", tenant)
+	write("management_proxy_tenant_in_flight_requests", "Prediction proxy requests in flight for the tenant.", "gauge", tenantLabels, load.Tenant.InFlight)
+	write("management_proxy_tenant_queued_requests", "Prediction proxy requests waiting for a slot for the tenant.", "gauge", tenantLabels, load.Tenant.Queued)
+	write("management_proxy_tenant_concurrency_limit", "In-flight request limit per tenant, 0 when unlimited.", "gauge", tenantLabels, load.Tenant.Limit)
+	write("management_proxy_tenant_admitted_requests_total", "Prediction proxy requests admitted for the tenant.", "counter", tenantLabels, load.Tenant.Admitted)
+	write("management_proxy_tenant_queued_requests_total", "Prediction proxy requests for the tenant that waited for a slot.", "counter", tenantLabels, load.Tenant.QueuedTotal)
+	write("management_proxy_tenant_rejected_requests_total", "Prediction proxy requests rejected at the tenant's concurrency limit.", "counter", tenantLabels, load.Tenant.Rejected)
+	return b.String()
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestConcurrencyLimiterDropsIdleCounters(t *testing.T) {
+	l := NewConcurrencyLimiter(10, 50, 0, 0)
+
+	busy, ok := l.Acquire(context.Background(), "tenant-a", "busy-model")
+	if !ok {
+		t.Fatal("Acquire(busy-model) was rejected")
+	}
+	for i := 0; len(l.loads) < maxProxyLoadEntries; i++ {
+		release, ok := l.Acquire(context.Background(), "tenant-b", fmt.Sprintf("model-%d", i))
+		if !ok {
+			t.Fatalf("Acquire(model-%d) was rejected", i)
+		}
+		release()
+	}
+
+	release, ok := l.Acquire(context.Background(), "tenant-c", "new-model")
+	if !ok {
+		t.Fatal("Acquire(new-model) was rejected")
+	}
+	defer release()
+
+	if len(l.loads) != 4 {
+		t.Errorf("tracked %d entries after dropping idle ones, want 4", len(l.loads))
+	}
+	if load := l.Load("tenant-a", "busy-model"); load.Model.InFlight != 1 || load.Tenant.InFlight != 1 {
+		t.Errorf("busy model load = %+v, want its in-flight request kept", load)
+	}
+
+	busy()
+	if load := l.Load("tenant-a", "busy-model"); load.Model.InFlight != 0 || load.Model.Admitted != 1 {
+		t.Errorf("busy model load after release = %+v, want 0 in flight and 1 admitted", load.Model)
+	}
+}
+
+func TestConcurrencyLimiterCountsRejectionsAtTheLimitingLevel(t *testing.T) {
+	l := NewConcurrencyLimiter(1, 2, 0, 0)
+	ctx := context.Background()
+
+	if _, ok := l.Acquire(ctx, "tenant-a", "model-a"); !ok {
+		t.Fatal("first request to model-a was rejected")
+	}
+	if _, ok := l.Acquire(ctx, "tenant-a", "model-a"); ok {
+		t.Fatal("second request to model-a was admitted over the model limit")
+	}
+	if _, ok := l.Acquire(ctx, "tenant-a", "model-b"); !ok {
+		t.Fatal("first request to model-b was rejected")
+	}
+	if _, ok := l.Acquire(ctx, "tenant-a", "model-c"); ok {
+		t.Fatal("request to model-c was admitted over the tenant limit")
+	}
+
+	tests := []struct {
+		modelName    string
+		wantModel    int64
+		wantTenant   int64
+		wantAdmitted int64
+	}{
+		{modelName: "model-a", wantModel: 1, wantTenant: 1, wantAdmitted: 1},
+		{modelName: "model-b", wantModel: 0, wantTenant: 1, wantAdmitted: 1},
+		{modelName: "model-c", wantModel: 0, wantTenant: 1, wantAdmitted: 0},
+	}
+	for _, tt := range tests {
+		load := l.Load("tenant-a", tt.modelName)
+		if load.Model.Rejected != tt.wantModel || load.Model.Admitted != tt.wantAdmitted {
+			t.Errorf("%s model stats = %+v, want %d rejected and %d admitted", tt.modelName, load.Model, tt.wantModel, tt.wantAdmitted)
+		}
+		if load.Tenant.Rejected != tt.wantTenant {
+			t.Errorf("%s tenant rejected = %d, want %d", tt.modelName, load.Tenant.Rejected, tt.wantTenant)
+		}
+	}
+}
+
+func TestConcurrencyLimiterQueue(t *testing.T) {
+	l := NewConcurrencyLimiter(1, 0, 1, time.Minute)
+	ctx := context.Background()
+
+	release, ok := l.Acquire(ctx, "tenant-a", "my-model")
+	if !ok {
+		t.Fatal("first request was rejected")
+	}
+
+	admitted := make(chan bool)
+	go func() {
+		next, ok := l.Acquire(ctx, "tenant-a", "my-model")
+		if ok {
+			defer next()
+		}
+		admitted <- ok
+	}()
+
+	// Wait for the second request to be queued
+	deadline := time.Now().Add(5 * time.Second)
+	for l.Load("tenant-a", "my-model").Model.Queued != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("second request was never queued")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// The queue holds one request, so a third is rejected at once
+	if _, ok := l.Acquire(ctx, "tenant-a", "my-model"); ok {
+		t.Fatal("third request was admitted past a full queue")
+	}
+
+	release()
+	if !<-admitted {
+		t.Fatal("queued request was rejected after a slot was released")
+	}
+
+	load := l.Load("tenant-a", "my-model")
+	if load.Model.Queued != 0 || load.Model.QueuedTotal != 1 || load.Model.Admitted != 2 || load.Model.Rejected != 1 {
+		t.Errorf("model stats = %+v, want 0 queued, 1 queued in total, 2 admitted and 1 rejected", load.Model)
+	}
+	if load.Tenant.Rejected != 0 {
+		t.Errorf("tenant rejected = %d, want 0 since only the model limit was reached", load.Tenant.Rejected)
+	}
+}
+
+func TestConcurrencyLimiterQueueTimeout(t *testing.T) {
+	l := NewConcurrencyLimiter(1, 0, 1, 10*time.Millisecond)
+	ctx := context.Background()
+
+	release, ok := l.Acquire(ctx, "tenant-a", "my-model")
+	if !ok {
+		t.Fatal("first request was rejected")
+	}
+	defer release()
+
+	if _, ok := l.Acquire(ctx, "tenant-a", "my-model"); ok {
+		t.Fatal("queued request was admitted while the slot was still held")
+	}
+	load := l.Load("tenant-a", "my-model")
+	if load.Model.Queued != 0 || load.Model.QueuedTotal != 1 || load.Model.Rejected != 1 {
+		t.Errorf("model stats = %+v, want 0 queued, 1 queued in total and 1 rejected", load.Model)
+	}
+}
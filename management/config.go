@@ -1,7 +1,16 @@
 package main
 
 import (
+	"encoding/json"
+	"log"
 	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 type Config struct {
@@ -11,6 +20,144 @@ type Config struct {
 	SuperAdminPassword string
 	ValidTenants       []string
 	SupportedFrameworks []Framework
+	ReconcileInterval  string // How often published models are checked against gateway resources
+	ReconcileRecreate  bool   // Re-create missing published-model resources during reconciliation
+	ReconcileOrphanGracePeriod string // How long an orphaned published model is kept before it is unpublished, disabled when empty
+	LogSinkURL         string // Webhook that receives audit and usage entries, disabled when empty
+	LogSinkAuthToken   string // Optional bearer token sent to the log sink
+	LogBodyMaxBytes    int      // Maximum request/response body size printed by detailed logging
+	LogBodySampleRate  int      // Log bodies for 1 in N requests
+	LogBodyExcludePaths []string // Paths whose bodies are never logged
+	LogPredictionBodies bool     // Log bodies on prediction routes (/predict, /explain, /chat/completions, ...)
+	LogPredictionRedactFields []string // JSON fields or dot paths redacted from logged prediction bodies
+	PredictionCacheMaxEntries int // Maximum number of cached prediction responses
+	PredictionCacheMaxBytes   int // Maximum total size of cached prediction responses
+	PredictMaxConcurrencyPerModel  int // In-flight prediction proxy requests allowed per model, 0 disables
+	PredictMaxConcurrencyPerTenant int // In-flight prediction proxy requests allowed per tenant, 0 disables
+	PredictMaxQueuedPerModel       int    // Prediction proxy requests per model that may wait for a slot, 0 disables queuing
+	PredictQueueTimeout            string // How long a queued prediction proxy request waits before it is rejected
+	PredictColdStartTimeout string // How long predictions retry 503/connection-refused while a model scales up, 0s disables
+	ModelWarmupTimeout      string // How long a warm-up waits for a new model to become ready
+	MaxModelVersions int // Stored model versions kept per model before the oldest are pruned, 0 keeps all
+	LogRetentionDays int // Days of daily usage, audit and error logs kept by the background pruner, 0 keeps all
+	PermissionCheckStrict bool // Refuse to start when the startup RBAC self-test finds missing permissions
+	AllowedImageRegistries []string // Registries (or registry paths) allowed for init and sidecar containers
+	ModelTypeDetection ModelTypeDetectionRules // Match lists used to detect OpenAI-compatible models when publishing
+	DefaultRateLimit   RateLimitConfig         // Rate limiting applied when a publish request omits it
+	MaxGatewayListeners int                    // Listeners the shared gateway may have before custom-hostname publishes are rejected, 0 disables
+	KServeDomainSuffix  string                 // Domain of predictor hostnames built for InferenceServices without a status URL
+	APIKeyEncryptionKey string // Base64 AES-256 key used to encrypt API keys stored in Secrets, disabled when empty
+	APIKeyBytes         int    // Random bytes in the secret part of new API keys, at least 16
+	APIKeyEncoding      string // Encoding of the secret part of new API keys: base64url, base62 or hex
+	ImpersonationSigningKey string // HMAC key signing admin impersonation tokens, generated per process when empty
+	ImpersonationTokenTTL   string // Default lifetime of admin impersonation tokens
+	AllowInsecureSkipVerify bool // Let non-admin users skip TLS verification on prediction and test calls
+	SystemLogsConcurrency int    // Containers read in parallel by the admin logs endpoint
+	SystemLogsTimeout     string // Total time the admin logs endpoint spends collecting logs
+	ServerReadHeaderTimeout  string // Time allowed to read request headers
+	ServerReadTimeout        string // Time allowed to read a whole request
+	ServerWriteTimeout       string // Time allowed to write a response
+	ServerLongWriteTimeout   string // Read and write time allowed on long-running routes such as predict and logs
+	ServerIdleTimeout        string // How long idle keep-alive connections are kept open
+	ServerMaxHeaderBytes     int    // Largest accepted request header size
+	ServerH2C                bool   // Serve HTTP/2 without TLS (h2c) alongside HTTP/1.1
+}
+
+// ModelTypeDetectionRules lists the lowercase substrings that mark a model as OpenAI-compatible
+type ModelTypeDetectionRules struct {
+	Images          []string `json:"images"`          // Custom container images of OpenAI-compatible runtimes
+	ImageIndicators []string `json:"imageIndicators"` // LLM names in custom container images
+	Tasks           []string `json:"tasks"`           // HuggingFace tasks
+	URIIndicators   []string `json:"uriIndicators"`   // Transformer names in HuggingFace and PyTorch model URIs
+}
+
+// defaultModelTypeDetectionRules are the built-in rules used when no overrides are configured
+func defaultModelTypeDetectionRules() ModelTypeDetectionRules {
+	return ModelTypeDetectionRules{
+		Images: []string{
+			"vllm/vllm-openai",
+			"ghcr.io/huggingface/text-generation-inference",
+			"openai/triton-inference-server",
+			"nvidia/tritonserver",
+			"text-generation-inference",
+			"vllm",
+		},
+		ImageIndicators: []string{
+			"llama", "mistral", "falcon", "vicuna", "alpaca",
+			"gpt", "bert", "t5", "bloom", "opt",
+		},
+		Tasks: []string{
+			"text-generation",
+			"text2text-generation",
+			"conversational",
+			"feature-extraction",
+		},
+		URIIndicators: []string{
+			"transformer", "llama", "mistral", "falcon", "vicuna",
+			"gpt", "bert", "t5", "bloom", "opt", "alpaca",
+		},
+	}
+}
+
+// loadModelTypeDetectionRules reads MODEL_TYPE_DETECTION_RULES, a JSON object whose lists replace
+// the matching built-in lists. Omitted lists keep their defaults and an empty list disables a rule.
+func loadModelTypeDetectionRules() ModelTypeDetectionRules {
+	rules := defaultModelTypeDetectionRules()
+	value := getEnv("MODEL_TYPE_DETECTION_RULES", "")
+	if value == "" {
+		return rules
+	}
+
+	overrides := defaultModelTypeDetectionRules()
+	if err := json.Unmarshal([]byte(value), &overrides); err != nil {
+		log.Printf("Invalid MODEL_TYPE_DETECTION_RULES, using built-in rules: %v", err)
+		return rules
+	}
+	for _, list := range []*[]string{&overrides.Images, &overrides.ImageIndicators, &overrides.Tasks, &overrides.URIIndicators} {
+		for i, item := range *list {
+			(*list)[i] = strings.ToLower(strings.TrimSpace(item))
+		}
+	}
+	return overrides
+}
+
+// defaultRateLimit is the built-in rate limiting applied to publish requests without one
+func defaultRateLimit() RateLimitConfig {
+	return RateLimitConfig{RequestsPerMinute: 60, RequestsPerHour: 1000}
+}
+
+// loadDefaultRateLimit reads DEFAULT_RATE_LIMIT, a JSON rateLimiting object. Invalid values fall
+// back to the built-in default, so publishing without rate limiting never fails validation.
+func loadDefaultRateLimit() RateLimitConfig {
+	value := getEnv("DEFAULT_RATE_LIMIT", "")
+	if value == "" {
+		return defaultRateLimit()
+	}
+
+	var rateLimiting RateLimitConfig
+	if err := json.Unmarshal([]byte(value), &rateLimiting); err != nil {
+		log.Printf("Invalid DEFAULT_RATE_LIMIT, using the built-in default: %v", err)
+		return defaultRateLimit()
+	}
+	if rateLimiting.RequestsPerMinute <= 0 || rateLimiting.RequestsPerHour <= 0 || rateLimiting.RequestsPerMinute > rateLimiting.RequestsPerHour {
+		log.Printf("Invalid DEFAULT_RATE_LIMIT, requestsPerMinute and requestsPerHour must be positive and per minute cannot exceed per hour; using the built-in default")
+		return defaultRateLimit()
+	}
+	return rateLimiting
+}
+
+// Domain suffix of the local demo cluster, whose KServe ingress is reached through sslip.io
+const defaultKServeDomainSuffix = "127.0.0.1.sslip.io"
+
+// loadKServeDomainSuffix reads KSERVE_DOMAIN_SUFFIX, the cluster's KServe ingress domain. Values
+// that are not DNS names fall back to the default.
+func loadKServeDomainSuffix() string {
+	suffix := strings.ToLower(strings.Trim(strings.TrimSpace(getEnv("KSERVE_DOMAIN_SUFFIX", defaultKServeDomainSuffix)), "."))
+	if errs := validation.IsDNS1123Subdomain(suffix); len(errs) > 0 {
+		log.Printf("Invalid KSERVE_DOMAIN_SUFFIX %q, using %s: %s", suffix, defaultKServeDomainSuffix, strings.Join(errs, "; "))
+		return defaultKServeDomainSuffix
+	}
+	return suffix
 }
 
 type Framework struct {
@@ -18,30 +165,272 @@ type Framework struct {
 	Description string `json:"description"`
 }
 
+// Tenant namespaces accepted when VALID_TENANTS is not set
+const defaultValidTenants = "tenant-a,tenant-b,tenant-c"
+
+// loadValidTenants reads VALID_TENANTS, a comma-separated list of tenant namespaces. Names that
+// are not valid namespaces are logged and skipped, and an empty result falls back to the default.
+func loadValidTenants() []string {
+	var tenants []string
+	for _, tenant := range getEnvList("VALID_TENANTS", defaultValidTenants) {
+		if errs := validation.IsDNS1123Label(tenant); len(errs) > 0 {
+			log.Printf("Invalid tenant %q in VALID_TENANTS, skipping it: %s", tenant, strings.Join(errs, "; "))
+			continue
+		}
+		tenants = append(tenants, tenant)
+	}
+	if len(tenants) == 0 {
+		log.Printf("Invalid VALID_TENANTS, no valid tenant names; using %s", defaultValidTenants)
+		return strings.Split(defaultValidTenants, ",")
+	}
+	return tenants
+}
+
+// defaultSupportedFrameworks is the built-in list of model frameworks accepted when creating models
+func defaultSupportedFrameworks() []Framework {
+	return []Framework{
+		{Name: "sklearn", Description: "Scikit-learn models"},
+		{Name: "tensorflow", Description: "TensorFlow models"},
+		{Name: "pytorch", Description: "PyTorch models"},
+		{Name: "onnx", Description: "ONNX models"},
+		{Name: "xgboost", Description: "XGBoost models"},
+	}
+}
+
+// loadSupportedFrameworks reads SUPPORTED_FRAMEWORKS, a JSON list of frameworks with a name and
+// description that replaces the built-in list. Invalid values fall back to the built-in list.
+func loadSupportedFrameworks() []Framework {
+	value := getEnv("SUPPORTED_FRAMEWORKS", "")
+	if value == "" {
+		return defaultSupportedFrameworks()
+	}
+
+	var frameworks []Framework
+	if err := json.Unmarshal([]byte(value), &frameworks); err != nil {
+		log.Printf("Invalid SUPPORTED_FRAMEWORKS, using the built-in list: %v", err)
+		return defaultSupportedFrameworks()
+	}
+	seen := make(map[string]bool, len(frameworks))
+	for i := range frameworks {
+		frameworks[i].Name = strings.ToLower(strings.TrimSpace(frameworks[i].Name))
+		if frameworks[i].Name == "" || seen[frameworks[i].Name] {
+			log.Printf("Invalid SUPPORTED_FRAMEWORKS, names must be set and unique; using the built-in list")
+			return defaultSupportedFrameworks()
+		}
+		seen[frameworks[i].Name] = true
+	}
+	if len(frameworks) == 0 {
+		log.Printf("Invalid SUPPORTED_FRAMEWORKS, the list is empty; using the built-in list")
+		return defaultSupportedFrameworks()
+	}
+	return frameworks
+}
+
+// Settings in this ConfigMap, in the service's namespace, override the environment. Keys are
+// environment variable names. It is read at startup and on POST /api/admin/config/reload.
+const configOverridesConfigMap = "management-service-config"
+
+var (
+	activeConfig    atomic.Pointer[Config]
+	configOverrides atomic.Pointer[map[string]string]
+	configReloadMu  sync.Mutex
+)
+
+// Settings that are only applied when the service starts, such as the listen port, middleware
+// and background workers. A reload keeps their current values and reports them as needing a restart.
+var startupOnlyConfigFields = map[string]bool{
+	"Port":                           true,
+	"NodeEnv":                        true,
+	"ReconcileInterval":              true,
+	"ReconcileRecreate":              true,
+	"ReconcileOrphanGracePeriod":     true,
+	"LogSinkURL":                     true,
+	"LogSinkAuthToken":               true,
+	"LogBodyMaxBytes":                true,
+	"LogBodySampleRate":              true,
+	"LogBodyExcludePaths":            true,
+	"LogPredictionBodies":            true,
+	"LogPredictionRedactFields":      true,
+	"PredictionCacheMaxEntries":      true,
+	"PredictionCacheMaxBytes":        true,
+	"PredictMaxConcurrencyPerModel":  true,
+	"PredictMaxConcurrencyPerTenant": true,
+	"PredictMaxQueuedPerModel":       true,
+	"PredictQueueTimeout":            true,
+	"PermissionCheckStrict":          true,
+	"APIKeyEncryptionKey":            true, // Changing it at runtime would make stored keys unreadable
+	"ImpersonationSigningKey":        true, // Changing it at runtime would invalidate issued tokens
+	"ServerReadHeaderTimeout":        true,
+	"ServerReadTimeout":              true,
+	"ServerWriteTimeout":             true,
+	"ServerIdleTimeout":              true,
+	"ServerMaxHeaderBytes":           true,
+	"ServerH2C":                      true,
+}
+
+// ActiveConfig returns the current configuration snapshot. Callers that read several settings
+// should keep the returned pointer so they all come from the same snapshot.
+func ActiveConfig() *Config {
+	if config := activeConfig.Load(); config != nil {
+		return config
+	}
+	activeConfig.CompareAndSwap(nil, NewConfig())
+	return activeConfig.Load()
+}
+
+// LoadConfigOverrides applies the override ConfigMap at startup, before any service reads the config
+func LoadConfigOverrides(k8sClient *K8sClient) error {
+	configReloadMu.Lock()
+	defer configReloadMu.Unlock()
+
+	overrides, err := readConfigOverrides(k8sClient)
+	if err != nil {
+		return err
+	}
+	configOverrides.Store(&overrides)
+	activeConfig.Store(NewConfig())
+	return nil
+}
+
+// ReloadConfig re-reads the environment and the override ConfigMap and swaps in the new
+// configuration. Startup-only settings keep their current values; the ones that changed are
+// returned in restartRequired.
+func ReloadConfig(k8sClient *K8sClient) (changed, restartRequired []string, err error) {
+	configReloadMu.Lock()
+	defer configReloadMu.Unlock()
+
+	overrides, err := readConfigOverrides(k8sClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	previous := ActiveConfig()
+	configOverrides.Store(&overrides)
+	next := NewConfig()
+
+	previousValue := reflect.ValueOf(previous).Elem()
+	nextValue := reflect.ValueOf(next).Elem()
+	for i := 0; i < previousValue.NumField(); i++ {
+		name := previousValue.Type().Field(i).Name
+		if reflect.DeepEqual(previousValue.Field(i).Interface(), nextValue.Field(i).Interface()) {
+			continue
+		}
+		if startupOnlyConfigFields[name] {
+			nextValue.Field(i).Set(previousValue.Field(i))
+			restartRequired = append(restartRequired, name)
+			continue
+		}
+		changed = append(changed, name)
+	}
+
+	activeConfig.Store(next)
+	return changed, restartRequired, nil
+}
+
+// serviceNamespace returns the namespace the service runs in, which holds its own ConfigMaps
+func serviceNamespace() string {
+	if namespace := os.Getenv("POD_NAMESPACE"); namespace != "" {
+		return namespace
+	}
+	return "default"
+}
+
+// readConfigOverrides returns the data of the override ConfigMap, or no overrides when it does not exist
+func readConfigOverrides(k8sClient *K8sClient) (map[string]string, error) {
+	overrides, err := k8sClient.GetConfigMapData(serviceNamespace(), configOverridesConfigMap)
+	if err != nil {
+		if IsNotFound(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	return overrides, nil
+}
+
 func NewConfig() *Config {
 	return &Config{
 		Port:               getEnv("PORT", "8080"),
 		NodeEnv:            getEnv("NODE_ENV", "production"),
 		SuperAdminUsername: getEnv("SUPER_ADMIN_USERNAME", "admin"),
 		SuperAdminPassword: getEnv("SUPER_ADMIN_PASSWORD", "admin123"),
-		ValidTenants:       []string{"tenant-a", "tenant-b", "tenant-c"},
-		SupportedFrameworks: []Framework{
-			{Name: "sklearn", Description: "Scikit-learn models"},
-			{Name: "tensorflow", Description: "TensorFlow models"},
-			{Name: "pytorch", Description: "PyTorch models"},
-			{Name: "onnx", Description: "ONNX models"},
-			{Name: "xgboost", Description: "XGBoost models"},
-		},
+		ValidTenants:       loadValidTenants(),
+		SupportedFrameworks: loadSupportedFrameworks(),
+		ReconcileInterval: getEnv("RECONCILE_INTERVAL", "5m"),
+		ReconcileRecreate: getEnv("RECONCILE_RECREATE", "false") == "true",
+		ReconcileOrphanGracePeriod: getEnv("RECONCILE_ORPHAN_GRACE_PERIOD", ""),
+		LogSinkURL:        getEnv("LOG_SINK_URL", ""),
+		LogSinkAuthToken:  getEnv("LOG_SINK_AUTH_TOKEN", ""),
+		LogBodyMaxBytes:   getEnvInt("LOG_BODY_MAX_BYTES", 1000),
+		LogBodySampleRate: getEnvInt("LOG_BODY_SAMPLE_RATE", 1),
+		LogBodyExcludePaths: getEnvList("LOG_BODY_EXCLUDE_PATHS", "/predict"),
+		LogPredictionBodies: getEnv("LOG_PREDICTION_BODIES", "false") == "true",
+		LogPredictionRedactFields: getEnvList("LOG_PREDICTION_REDACT_FIELDS", ""),
+		PredictionCacheMaxEntries: getEnvInt("PREDICTION_CACHE_MAX_ENTRIES", 1000),
+		PredictionCacheMaxBytes:   getEnvInt("PREDICTION_CACHE_MAX_BYTES", 64*1024*1024),
+		PredictMaxConcurrencyPerModel:  getEnvInt("PREDICT_MAX_CONCURRENCY_PER_MODEL", 10),
+		PredictMaxConcurrencyPerTenant: getEnvInt("PREDICT_MAX_CONCURRENCY_PER_TENANT", 50),
+		PredictMaxQueuedPerModel:       getEnvInt("PREDICT_MAX_QUEUED_PER_MODEL", 0),
+		PredictQueueTimeout:            getEnv("PREDICT_QUEUE_TIMEOUT", "5s"),
+		PredictColdStartTimeout:        getEnv("PREDICT_COLD_START_TIMEOUT", "30s"),
+		ModelWarmupTimeout:             getEnv("MODEL_WARMUP_TIMEOUT", "10m"),
+		MaxModelVersions:               getEnvInt("MAX_MODEL_VERSIONS", 10),
+		LogRetentionDays:               getEnvInt("LOG_RETENTION_DAYS", 0),
+		PermissionCheckStrict: getEnv("PERMISSION_CHECK_STRICT", "false") == "true",
+		AllowedImageRegistries: getEnvList("ALLOWED_IMAGE_REGISTRIES", ""),
+		ModelTypeDetection: loadModelTypeDetectionRules(),
+		DefaultRateLimit:   loadDefaultRateLimit(),
+		MaxGatewayListeners: getEnvInt("MAX_GATEWAY_LISTENERS", 64),
+		KServeDomainSuffix:  loadKServeDomainSuffix(),
+		APIKeyEncryptionKey: getEnv("API_KEY_ENCRYPTION_KEY", ""),
+		APIKeyBytes:         loadAPIKeyBytes(),
+		APIKeyEncoding:      loadAPIKeyEncoding(),
+		ImpersonationSigningKey: getEnv("IMPERSONATION_SIGNING_KEY", ""),
+		ImpersonationTokenTTL:   getEnv("IMPERSONATION_TOKEN_TTL", "15m"),
+		AllowInsecureSkipVerify: getEnv("ALLOW_INSECURE_SKIP_VERIFY", "false") == "true",
+		SystemLogsConcurrency: getEnvInt("SYSTEM_LOGS_CONCURRENCY", 8),
+		SystemLogsTimeout:     getEnv("SYSTEM_LOGS_TIMEOUT", "15s"),
+		ServerReadHeaderTimeout: getEnv("SERVER_READ_HEADER_TIMEOUT", "10s"),
+		ServerReadTimeout:       getEnv("SERVER_READ_TIMEOUT", "60s"),
+		ServerWriteTimeout:      getEnv("SERVER_WRITE_TIMEOUT", "60s"),
+		ServerLongWriteTimeout:  getEnv("SERVER_LONG_WRITE_TIMEOUT", "10m"),
+		ServerIdleTimeout:       getEnv("SERVER_IDLE_TIMEOUT", "120s"),
+		ServerMaxHeaderBytes:    getEnvInt("SERVER_MAX_HEADER_BYTES", 1<<20),
+		ServerH2C:               getEnv("SERVER_H2C", "false") == "true",
 	}
 }
 
+// getEnv reads a setting from the override ConfigMap, then the environment
 func getEnv(key, defaultValue string) string {
+	if overrides := configOverrides.Load(); overrides != nil {
+		if value := (*overrides)[key]; value != "" {
+			return value
+		}
+	}
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
 }
 
+func getEnvInt(key string, defaultValue int) int {
+	if value := getEnv(key, ""); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+func getEnvList(key, defaultValue string) []string {
+	var values []string
+	for _, value := range strings.Split(getEnv(key, defaultValue), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func (c *Config) IsValidTenant(tenant string) bool {
 	for _, validTenant := range c.ValidTenants {
 		if validTenant == tenant {
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLoadValidTenants(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "unset", value: "", want: []string{"tenant-a", "tenant-b", "tenant-c"}},
+		{name: "list", value: "team-x, team-y", want: []string{"team-x", "team-y"}},
+		{name: "invalid names skipped", value: "team-x,Team_Y,-bad", want: []string{"team-x"}},
+		{name: "nothing valid", value: "Team_Y", want: []string{"tenant-a", "tenant-b", "tenant-c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("VALID_TENANTS", tt.value)
+			if got := loadValidTenants(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("loadValidTenants() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadSupportedFrameworks(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []Framework
+	}{
+		{name: "unset", value: "", want: defaultSupportedFrameworks()},
+		{
+			name:  "list",
+			value: `[{"name": " LightGBM ", "description": "LightGBM models"}, {"name": "sklearn"}]`,
+			want:  []Framework{{Name: "lightgbm", Description: "LightGBM models"}, {Name: "sklearn"}},
+		},
+		{name: "not JSON", value: "sklearn,onnx", want: defaultSupportedFrameworks()},
+		{name: "empty list", value: "[]", want: defaultSupportedFrameworks()},
+		{name: "missing name", value: `[{"description": "No name"}]`, want: defaultSupportedFrameworks()},
+		{name: "duplicate name", value: `[{"name": "onnx"}, {"name": "ONNX"}]`, want: defaultSupportedFrameworks()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SUPPORTED_FRAMEWORKS", tt.value)
+			if got := loadSupportedFrameworks(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("loadSupportedFrameworks() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
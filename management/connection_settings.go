@@ -0,0 +1,339 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// Hostnames that expose cloud instance metadata and are never valid prediction targets
+var blockedMetadataHosts = []string{
+	"metadata",
+	"metadata.google.internal",
+	"metadata.azure.internal",
+}
+
+// Ingress gateway services in order of preference
+var ingressGatewayServices = []struct {
+	namespace string
+	name      string
+}{
+	{"istio-system", "istio-ingressgateway"},
+	{"envoy-gateway-system", "envoy-gateway"},
+}
+
+// normalizeConnectionSettings trims and canonicalizes user supplied connection settings in place
+func normalizeConnectionSettings(settings *ConnectionSettings) {
+	settings.Protocol = strings.ToLower(strings.TrimSpace(settings.Protocol))
+	settings.Host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(settings.Host)), ".")
+	settings.Port = strings.TrimSpace(settings.Port)
+	settings.Path = strings.TrimSpace(settings.Path)
+	if settings.Path != "" && !strings.HasPrefix(settings.Path, "/") {
+		settings.Path = "/" + settings.Path
+	}
+
+	for i := range settings.DNSResolve {
+		settings.DNSResolve[i].Host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(settings.DNSResolve[i].Host)), ".")
+		settings.DNSResolve[i].Port = strings.TrimSpace(settings.DNSResolve[i].Port)
+		settings.DNSResolve[i].Address = strings.TrimSpace(settings.DNSResolve[i].Address)
+		for j := range settings.DNSResolve[i].Addresses {
+			settings.DNSResolve[i].Addresses[j] = strings.TrimSpace(settings.DNSResolve[i].Addresses[j])
+		}
+	}
+}
+
+// Most addresses a single DNS override may list
+const maxDNSResolveAddresses = 8
+
+// dnsResolveAddresses returns the addresses of a DNS override in dial order: Address first, then
+// Addresses, without blanks or duplicates
+func dnsResolveAddresses(dnsResolve DNSResolve) []string {
+	var addresses []string
+	seen := make(map[string]bool)
+	for _, address := range append([]string{dnsResolve.Address}, dnsResolve.Addresses...) {
+		if address == "" || seen[address] {
+			continue
+		}
+		seen[address] = true
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+// validateConnectionSettings guards the prediction proxy against SSRF. Everyone is blocked from
+// link-local and metadata targets; non-admins may only reach their tenant's service hostnames,
+// their published hostnames, and DNS overrides pointing at the ingress gateway.
+func (s *ModelService) validateConnectionSettings(u *User, settings *ConnectionSettings) error {
+	if settings.Port != "" && !isValidPort(settings.Port) {
+		return fmt.Errorf("invalid port %q", settings.Port)
+	}
+	if err := checkInsecureSkipVerify(ActiveConfig(), u, settings); err != nil {
+		return err
+	}
+
+	if settings.UseCustom {
+		if settings.Protocol != "" && settings.Protocol != "http" && settings.Protocol != "https" {
+			return fmt.Errorf("unsupported protocol %q, expected http or https", settings.Protocol)
+		}
+		if settings.Host == "" {
+			return fmt.Errorf("host is required for custom connection settings")
+		}
+		if strings.ContainsAny(settings.Host, "/@:?# ") {
+			return fmt.Errorf("invalid host %q", settings.Host)
+		}
+		if strings.Contains(settings.Path, "://") {
+			return fmt.Errorf("path must not contain a URL")
+		}
+		if err := checkBlockedHost(settings.Host); err != nil {
+			return err
+		}
+		if !u.IsAdmin {
+			if net.ParseIP(settings.Host) != nil {
+				return fmt.Errorf("IP address targets are not allowed, use a service hostname in namespace %s", u.Tenant)
+			}
+			if !s.isTenantHostname(u.Tenant, settings.Host) {
+				return fmt.Errorf("host %s is outside tenant %s", settings.Host, u.Tenant)
+			}
+		}
+	}
+
+	if len(settings.DNSResolve) == 0 {
+		return nil
+	}
+
+	var gatewayAddresses map[string]bool
+	if !u.IsAdmin {
+		gatewayAddresses = s.gatewayAddresses()
+	}
+
+	for _, dnsResolve := range settings.DNSResolve {
+		addresses := dnsResolveAddresses(dnsResolve)
+		if len(addresses) > maxDNSResolveAddresses {
+			return fmt.Errorf("DNS override for %s lists %d addresses, at most %d are allowed", dnsResolve.Host, len(addresses), maxDNSResolveAddresses)
+		}
+		if len(addresses) > 0 && dnsResolve.Port != "" && !isValidPort(dnsResolve.Port) {
+			return fmt.Errorf("invalid DNS override port %q", dnsResolve.Port)
+		}
+		for _, address := range addresses {
+			ip := net.ParseIP(address)
+			if ip == nil {
+				return fmt.Errorf("DNS override address %q must be an IP address", address)
+			}
+			if isBlockedIP(ip) {
+				return fmt.Errorf("DNS override address %s is not allowed", address)
+			}
+			if !u.IsAdmin && !gatewayAddresses[ip.String()] {
+				return fmt.Errorf("DNS override address %s is not an ingress gateway address", address)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkInsecureSkipVerify only lets admins skip TLS verification, unless the service is configured
+// to allow it for everyone (e.g. a local environment serving self-signed sslip.io certificates)
+func checkInsecureSkipVerify(config *Config, u *User, settings *ConnectionSettings) error {
+	if settings == nil || !settings.InsecureSkipVerify {
+		return nil
+	}
+	if !u.IsAdmin && !config.AllowInsecureSkipVerify {
+		return fmt.Errorf("insecureSkipVerify requires admin access or ALLOW_INSECURE_SKIP_VERIFY")
+	}
+	return nil
+}
+
+// proxyClientOptionsFor applies the TLS choice of the connection settings to base, logging
+// every call that skips certificate verification
+func proxyClientOptionsFor(base ProxyClientOptions, settings *ConnectionSettings, u *User, target string) ProxyClientOptions {
+	if settings != nil && settings.InsecureSkipVerify {
+		log.Printf("TLS verification disabled for request to %s by %s (tenant %s)", target, u.Name, u.Tenant)
+		base.InsecureSkipVerify = true
+	}
+	return base
+}
+
+// isTenantHostname reports whether host belongs to the tenant's namespace or published models
+func (s *ModelService) isTenantHostname(tenant, host string) bool {
+	if hasTenantHostSuffix(host, tenant, ActiveConfig().KServeDomainSuffix) {
+		return true
+	}
+
+	// Hostnames of the tenant's published models
+	if host == "api.router.inference-in-a-box" {
+		return true
+	}
+	if s.publishingService != nil {
+		if publishedModels, err := s.publishingService.listPublishedModelsByTenant(tenant); err == nil {
+			for _, publishedModel := range publishedModels {
+				if strings.EqualFold(publishedModel.PublicHostname, host) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// hasTenantHostSuffix reports whether host is a single label followed by one of the tenant's
+// suffixes: in-cluster service names (<svc>.<ns>, <svc>.<ns>.svc, <svc>.<ns>.svc.cluster.local)
+// and KServe hostnames under the configured domain ({name}.{namespace}.{domain} and
+// {name}-{namespace}.{domain}). Only whole suffixes match, so the tenant name appearing
+// elsewhere in a hostname, such as x.tenant-a.10.0.0.5.sslip.io, does not count.
+func hasTenantHostSuffix(host, tenant, kserveDomain string) bool {
+	suffixes := []string{
+		"." + tenant,
+		"." + tenant + ".svc",
+		"." + tenant + ".svc.cluster.local",
+	}
+	if kserveDomain != "" {
+		suffixes = append(suffixes, "."+tenant+"."+kserveDomain, "-"+tenant+"."+kserveDomain)
+	}
+	for _, suffix := range suffixes {
+		if name, ok := strings.CutSuffix(host, suffix); ok && name != "" && !strings.Contains(name, ".") {
+			return true
+		}
+	}
+	return false
+}
+
+// tenantDialOptions restricts the connections of a non-admin's custom target to the tenant's own
+// addresses. The check runs on the resolved address, so a hostname that passes validation still
+// cannot reach other workloads or private addresses.
+func (s *ModelService) tenantDialOptions(opts ProxyClientOptions, tenant string) ProxyClientOptions {
+	opts.AddressScope = "tenant/" + tenant
+	opts.AllowAddress = func(ip net.IP) bool {
+		return s.tenantAddresses(tenant)[ip.String()]
+	}
+	return opts
+}
+
+// tenantAddresses returns the pod and service IPs of the tenant's namespace and the addresses of
+// the gateways in front of its models
+func (s *ModelService) tenantAddresses(tenant string) map[string]bool {
+	addresses := s.gatewayAddresses()
+
+	// Knative predictor services are ExternalName services resolving to the local gateway
+	if service, err := s.k8sClient.GetService("istio-system", "knative-local-gateway"); err == nil {
+		for _, clusterIP := range service.Spec.ClusterIPs {
+			if ip := net.ParseIP(clusterIP); ip != nil {
+				addresses[ip.String()] = true
+			}
+		}
+	}
+
+	pods, err := s.k8sClient.GetPods(tenant)
+	if err != nil {
+		log.Printf("Failed to list pods of tenant %s for the dial check: %v", tenant, err)
+	}
+	for _, pod := range pods {
+		for _, podIP := range pod.Status.PodIPs {
+			if ip := net.ParseIP(podIP.IP); ip != nil {
+				addresses[ip.String()] = true
+			}
+		}
+	}
+
+	services, err := s.k8sClient.GetServices(tenant)
+	if err != nil {
+		log.Printf("Failed to list services of tenant %s for the dial check: %v", tenant, err)
+	}
+	for _, service := range services {
+		for _, clusterIP := range service.Spec.ClusterIPs {
+			if ip := net.ParseIP(clusterIP); ip != nil {
+				addresses[ip.String()] = true
+			}
+		}
+	}
+
+	return addresses
+}
+
+// gatewayAddresses returns the cluster and LoadBalancer IPs of the ingress gateway services
+func (s *ModelService) gatewayAddresses() map[string]bool {
+	addresses := make(map[string]bool)
+
+	for _, gateway := range ingressGatewayServices {
+		service, err := s.k8sClient.GetService(gateway.namespace, gateway.name)
+		if err != nil {
+			continue
+		}
+		for _, clusterIP := range service.Spec.ClusterIPs {
+			if ip := net.ParseIP(clusterIP); ip != nil {
+				addresses[ip.String()] = true
+			}
+		}
+		if ip := net.ParseIP(service.Spec.ClusterIP); ip != nil {
+			addresses[ip.String()] = true
+		}
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			if ip := net.ParseIP(ingress.IP); ip != nil {
+				addresses[ip.String()] = true
+			}
+		}
+	}
+
+	return addresses
+}
+
+// gatewayClusterIP returns the cluster IP of the ingress gateway, preferring istio-ingressgateway
+func (s *ModelService) gatewayClusterIP() string {
+	for _, gateway := range ingressGatewayServices {
+		service, err := s.k8sClient.GetService(gateway.namespace, gateway.name)
+		if err == nil && service.Spec.ClusterIP != "" && service.Spec.ClusterIP != "None" {
+			return service.Spec.ClusterIP
+		}
+	}
+	return ""
+}
+
+func checkBlockedHost(host string) error {
+	for _, blocked := range blockedMetadataHosts {
+		if host == blocked {
+			return fmt.Errorf("host %s is not allowed", host)
+		}
+	}
+	if ip := net.ParseIP(host); ip != nil && isBlockedIP(ip) {
+		return fmt.Errorf("host %s is not allowed", host)
+	}
+	return nil
+}
+
+// isBlockedIP reports link-local (including the 169.254.169.254 metadata endpoint) and unspecified addresses
+func isBlockedIP(ip net.IP) bool {
+	return ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
+}
+
+// allowAddressDial rejects connections whose resolved address is not accepted by allow
+func allowAddressDial(address string, allow func(net.IP) bool) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !allow(ip) {
+		return fmt.Errorf("connection to %s is outside the allowed addresses", host)
+	}
+	return nil
+}
+
+// blockLinkLocalDial rejects connections whose resolved address is blocked, covering hostnames
+// that resolve (or are rebound) to metadata addresses after validation
+func blockLinkLocalDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip != nil && isBlockedIP(ip) {
+		return fmt.Errorf("connection to %s is not allowed", host)
+	}
+	return nil
+}
+
+func isValidPort(port string) bool {
+	value, err := strconv.Atoi(port)
+	return err == nil && value > 0 && value <= 65535
+}
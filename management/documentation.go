@@ -1,7 +1,10 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 // DocumentationGenerator handles automatic API documentation generation
@@ -17,21 +20,27 @@ func NewDocumentationGenerator(config *Config) *DocumentationGenerator {
 }
 
 // GenerateAPIDocumentation generates comprehensive API documentation for a published model
-func (d *DocumentationGenerator) GenerateAPIDocumentation(namespace, modelName, modelType, externalURL, apiKey string) APIDocumentation {
+// inputNames lists the model's named inputs; when empty the examples use a plain instances array.
+// openai sets the served model name and sampling defaults of the OpenAI examples and may be nil.
+func (d *DocumentationGenerator) GenerateAPIDocumentation(namespace, modelName, modelType, externalURL, apiKey string, inputNames []string, openai *OpenAIConfig) APIDocumentation {
+	params := newOpenAIExampleParams(modelName, openai)
+
 	doc := APIDocumentation{
 		EndpointURL: externalURL,
+		// Either header is accepted; Authorization follows the OpenAI client convention
 		AuthHeaders: map[string]string{
-			"X-API-Key": apiKey,
+			"X-API-Key":     apiKey,
+			"Authorization": "Bearer " + apiKey,
 		},
-		ExampleRequests: d.generateExampleRequests(modelName, modelType, externalURL, apiKey),
-		SDKExamples:     d.generateSDKExamples(modelName, modelType, externalURL, apiKey),
+		ExampleRequests: d.generateExampleRequests(modelName, modelType, externalURL, apiKey, inputNames, params),
+		SDKExamples:     d.generateSDKExamples(modelName, modelType, externalURL, apiKey, params),
 	}
 	
 	return doc
 }
 
 // generateExampleRequests generates example API requests
-func (d *DocumentationGenerator) generateExampleRequests(modelName, modelType, externalURL, apiKey string) []ExampleRequest {
+func (d *DocumentationGenerator) generateExampleRequests(modelName, modelType, externalURL, apiKey string, inputNames []string, params openAIExampleParams) []ExampleRequest {
 	var examples []ExampleRequest
 	
 	if modelType == "openai" {
@@ -40,7 +49,7 @@ func (d *DocumentationGenerator) generateExampleRequests(modelName, modelType, e
 			Method:      "POST",
 			URL:         externalURL + "/chat/completions",
 			Headers:     map[string]string{"X-API-Key": apiKey, "Content-Type": "application/json"},
-			Body:        d.generateOpenAIChatExample(),
+			Body:        d.generateOpenAIChatExample(params),
 			Description: "Chat completion request (OpenAI compatible)",
 		})
 		
@@ -48,7 +57,7 @@ func (d *DocumentationGenerator) generateExampleRequests(modelName, modelType, e
 			Method:      "POST",
 			URL:         externalURL + "/embeddings",
 			Headers:     map[string]string{"X-API-Key": apiKey, "Content-Type": "application/json"},
-			Body:        d.generateOpenAIEmbeddingExample(),
+			Body:        d.generateOpenAIEmbeddingExample(params),
 			Description: "Text embedding request (OpenAI compatible)",
 		})
 		
@@ -59,6 +68,31 @@ func (d *DocumentationGenerator) generateExampleRequests(modelName, modelType, e
 			Body:        "",
 			Description: "List available models (OpenAI compatible)",
 		})
+	} else if len(inputNames) > 0 {
+		// Named-input examples built from the model's input signature
+		examples = append(examples, ExampleRequest{
+			Method:      "POST",
+			URL:         fmt.Sprintf("%s/v1/models/%s:predict", externalURL, modelName),
+			Headers:     map[string]string{"X-API-Key": apiKey, "Content-Type": "application/json"},
+			Body:        d.generateNamedInputsExample(inputNames),
+			Description: "KServe v1 prediction request with named inputs",
+		})
+
+		examples = append(examples, ExampleRequest{
+			Method:      "POST",
+			URL:         fmt.Sprintf("%s/v2/models/%s/infer", externalURL, modelName),
+			Headers:     map[string]string{"X-API-Key": apiKey, "Content-Type": "application/json"},
+			Body:        d.generateV2TensorExample(inputNames),
+			Description: "KServe v2 inference request with named tensors",
+		})
+
+		examples = append(examples, ExampleRequest{
+			Method:      "GET",
+			URL:         fmt.Sprintf("%s/v2/models/%s", externalURL, modelName),
+			Headers:     map[string]string{"X-API-Key": apiKey},
+			Body:        "",
+			Description: "Get model metadata",
+		})
 	} else {
 		// Traditional inference examples
 		examples = append(examples, ExampleRequest{
@@ -90,14 +124,14 @@ func (d *DocumentationGenerator) generateExampleRequests(modelName, modelType, e
 }
 
 // generateSDKExamples generates SDK examples for different programming languages
-func (d *DocumentationGenerator) generateSDKExamples(modelName, modelType, externalURL, apiKey string) map[string]string {
+func (d *DocumentationGenerator) generateSDKExamples(modelName, modelType, externalURL, apiKey string, params openAIExampleParams) map[string]string {
 	examples := make(map[string]string)
 	
 	if modelType == "openai" {
-		examples["curl"] = d.generateOpenAICurlExample(externalURL, apiKey)
-		examples["python"] = d.generateOpenAIPythonExample(externalURL, apiKey)
-		examples["javascript"] = d.generateOpenAIJavaScriptExample(externalURL, apiKey)
-		examples["go"] = d.generateOpenAIGoExample(externalURL, apiKey)
+		examples["curl"] = d.generateOpenAICurlExample(externalURL, apiKey, params)
+		examples["python"] = d.generateOpenAIPythonExample(externalURL, apiKey, params)
+		examples["javascript"] = d.generateOpenAIJavaScriptExample(externalURL, apiKey, params)
+		examples["go"] = d.generateOpenAIGoExample(externalURL, apiKey, params)
 	} else {
 		examples["curl"] = d.generateTraditionalCurlExample(modelName, externalURL, apiKey)
 		examples["python"] = d.generateTraditionalPythonExample(modelName, externalURL, apiKey)
@@ -110,55 +144,110 @@ func (d *DocumentationGenerator) generateSDKExamples(modelName, modelType, exter
 
 // OpenAI-compatible examples
 
-func (d *DocumentationGenerator) generateOpenAIChatExample() string {
-	return `{
-  "model": "gpt-3.5-turbo",
+// Sampling parameters used in the OpenAI examples when the publisher does not set them
+const (
+	defaultExampleMaxTokens   = 100
+	defaultExampleTemperature = 0.7
+)
+
+// openAIExampleParams holds the model identifier and sampling parameters shown in the OpenAI examples
+type openAIExampleParams struct {
+	model       string
+	maxTokens   int
+	minTokens   int
+	temperature float64
+}
+
+func newOpenAIExampleParams(modelName string, openai *OpenAIConfig) openAIExampleParams {
+	params := openAIExampleParams{
+		model:       servedModelName(modelName, openai),
+		maxTokens:   defaultExampleMaxTokens,
+		temperature: defaultExampleTemperature,
+	}
+	if openai != nil {
+		if openai.MaxTokens > 0 {
+			params.maxTokens = openai.MaxTokens
+		}
+		params.minTokens = openai.MinTokens
+		if openai.Temperature != nil {
+			params.temperature = *openai.Temperature
+		}
+	}
+	if params.minTokens > params.maxTokens {
+		params.maxTokens = params.minTokens
+	}
+	return params
+}
+
+// sampling renders the sampling parameters one per line, using field to format each name and value
+func (p openAIExampleParams) sampling(indent string, field func(name, value string) string) string {
+	lines := []string{field("max_tokens", strconv.Itoa(p.maxTokens))}
+	if p.minTokens > 0 {
+		lines = append(lines, field("min_tokens", strconv.Itoa(p.minTokens)))
+	}
+	lines = append(lines, field("temperature", strconv.FormatFloat(p.temperature, 'f', -1, 64)))
+	return strings.Join(lines, ",\n"+indent)
+}
+
+func jsonField(name, value string) string {
+	return fmt.Sprintf("%q: %s", name, value)
+}
+
+func (d *DocumentationGenerator) generateOpenAIChatExample(p openAIExampleParams) string {
+	return fmt.Sprintf(`{
+  "model": %q,
   "messages": [
     {
       "role": "user",
       "content": "Hello, how are you?"
     }
   ],
-  "max_tokens": 100,
-  "temperature": 0.7
-}`
+  %s
+}`, p.model, p.sampling("  ", jsonField))
 }
 
-func (d *DocumentationGenerator) generateOpenAIEmbeddingExample() string {
-	return `{
-  "model": "text-embedding-ada-002",
+func (d *DocumentationGenerator) generateOpenAIEmbeddingExample(p openAIExampleParams) string {
+	return fmt.Sprintf(`{
+  "model": %q,
   "input": "The quick brown fox jumps over the lazy dog"
-}`
+}`, p.model)
 }
 
-func (d *DocumentationGenerator) generateOpenAICurlExample(externalURL, apiKey string) string {
+func (d *DocumentationGenerator) generateOpenAICurlExample(externalURL, apiKey string, p openAIExampleParams) string {
 	return fmt.Sprintf(`# Chat Completion
 curl -X POST "%s/chat/completions" \
   -H "X-API-Key: %s" \
   -H "Content-Type: application/json" \
   -d '{
-    "model": "gpt-3.5-turbo",
+    "model": %q,
     "messages": [
       {
         "role": "user",
         "content": "Hello, how are you?"
       }
     ],
-    "max_tokens": 100,
-    "temperature": 0.7
+    %s
   }'
 
-# Text Embedding
+# Text Embedding, authenticating with the OpenAI-style Authorization header
 curl -X POST "%s/embeddings" \
-  -H "X-API-Key: %s" \
+  -H "Authorization: Bearer %s" \
   -H "Content-Type: application/json" \
   -d '{
-    "model": "text-embedding-ada-002",
+    "model": %q,
     "input": "The quick brown fox jumps over the lazy dog"
-  }'`, externalURL, apiKey, externalURL, apiKey)
+  }'`, externalURL, apiKey, p.model, p.sampling("    ", jsonField), externalURL, apiKey, p.model)
 }
 
-func (d *DocumentationGenerator) generateOpenAIPythonExample(externalURL, apiKey string) string {
+func (d *DocumentationGenerator) generateOpenAIPythonExample(externalURL, apiKey string, p openAIExampleParams) string {
+	// min_tokens is not an OpenAI parameter, so the client has to pass it through extra_body
+	clientField := func(name, value string) string {
+		if name == "min_tokens" {
+			return fmt.Sprintf(`extra_body={"min_tokens": %s}`, value)
+		}
+		return name + "=" + value
+	}
+
 	return fmt.Sprintf(`import openai
 import requests
 
@@ -170,19 +259,18 @@ client = openai.OpenAI(
 
 # Chat completion
 response = client.chat.completions.create(
-    model="gpt-3.5-turbo",
+    model=%q,
     messages=[
         {"role": "user", "content": "Hello, how are you?"}
     ],
-    max_tokens=100,
-    temperature=0.7
+    %s
 )
 
 print(response.choices[0].message.content)
 
 # Text embedding
 embedding_response = client.embeddings.create(
-    model="text-embedding-ada-002",
+    model=%q,
     input="The quick brown fox jumps over the lazy dog"
 )
 
@@ -195,12 +283,11 @@ headers = {
 }
 
 data = {
-    "model": "gpt-3.5-turbo",
+    "model": %q,
     "messages": [
         {"role": "user", "content": "Hello, how are you?"}
     ],
-    "max_tokens": 100,
-    "temperature": 0.7
+    %s
 }
 
 response = requests.post(
@@ -209,10 +296,14 @@ response = requests.post(
     json=data
 )
 
-print(response.json())`, apiKey, externalURL, apiKey, externalURL)
+print(response.json())`, apiKey, externalURL, p.model, p.sampling("    ", clientField), p.model, apiKey, p.model, p.sampling("    ", jsonField), externalURL)
 }
 
-func (d *DocumentationGenerator) generateOpenAIJavaScriptExample(externalURL, apiKey string) string {
+func (d *DocumentationGenerator) generateOpenAIJavaScriptExample(externalURL, apiKey string, p openAIExampleParams) string {
+	jsField := func(name, value string) string {
+		return name + ": " + value
+	}
+
 	return fmt.Sprintf(`// Using OpenAI JavaScript client
 import OpenAI from 'openai';
 
@@ -224,12 +315,11 @@ const client = new OpenAI({
 // Chat completion
 async function chatCompletion() {
   const response = await client.chat.completions.create({
-    model: 'gpt-3.5-turbo',
+    model: '%s',
     messages: [
       { role: 'user', content: 'Hello, how are you?' }
     ],
-    max_tokens: 100,
-    temperature: 0.7
+    %s
   });
   
   console.log(response.choices[0].message.content);
@@ -238,7 +328,7 @@ async function chatCompletion() {
 // Text embedding
 async function textEmbedding() {
   const response = await client.embeddings.create({
-    model: 'text-embedding-ada-002',
+    model: '%s',
     input: 'The quick brown fox jumps over the lazy dog'
   });
   
@@ -254,12 +344,11 @@ async function fetchExample() {
       'Content-Type': 'application/json'
     },
     body: JSON.stringify({
-      model: 'gpt-3.5-turbo',
+      model: '%s',
       messages: [
         { role: 'user', content: 'Hello, how are you?' }
       ],
-      max_tokens: 100,
-      temperature: 0.7
+      %s
     })
   });
   
@@ -269,10 +358,15 @@ async function fetchExample() {
 
 chatCompletion();
 textEmbedding();
-fetchExample();`, apiKey, externalURL, externalURL, apiKey)
+fetchExample();`, apiKey, externalURL, p.model, p.sampling("    ", jsField), p.model, externalURL, apiKey, p.model, p.sampling("      ", jsField))
 }
 
-func (d *DocumentationGenerator) generateOpenAIGoExample(externalURL, apiKey string) string {
+func (d *DocumentationGenerator) generateOpenAIGoExample(externalURL, apiKey string, p openAIExampleParams) string {
+	goFields := map[string]string{"max_tokens": "MaxTokens:", "min_tokens": "MinTokens:", "temperature": "Temperature:"}
+	goField := func(name, value string) string {
+		return fmt.Sprintf("%-12s %s", goFields[name], value)
+	}
+
 	return fmt.Sprintf(`package main
 
 import (
@@ -287,6 +381,7 @@ type ChatCompletionRequest struct {
 	Model       string    ` + "`json:\"model\"`" + `
 	Messages    []Message ` + "`json:\"messages\"`" + `
 	MaxTokens   int       ` + "`json:\"max_tokens\"`" + `
+	MinTokens   int       ` + "`json:\"min_tokens,omitempty\"`" + `
 	Temperature float64   ` + "`json:\"temperature\"`" + `
 }
 
@@ -309,12 +404,11 @@ func main() {
 	
 	// Chat completion request
 	reqData := ChatCompletionRequest{
-		Model: "gpt-3.5-turbo",
+		Model: %q,
 		Messages: []Message{
 			{Role: "user", Content: "Hello, how are you?"},
 		},
-		MaxTokens:   100,
-		Temperature: 0.7,
+		%s,
 	}
 	
 	jsonData, err := json.Marshal(reqData)
@@ -349,7 +443,7 @@ func main() {
 	}
 	
 	fmt.Println(response.Choices[0].Message.Content)
-}`, apiKey, externalURL)
+}`, apiKey, externalURL, p.model, p.sampling("\t\t", goField))
 }
 
 // Traditional inference examples
@@ -372,6 +466,31 @@ func (d *DocumentationGenerator) generateKServeExample() string {
 }`
 }
 
+func (d *DocumentationGenerator) generateNamedInputsExample(inputNames []string) string {
+	inputs := make(map[string]interface{})
+	for _, name := range inputNames {
+		inputs[name] = [][]float64{{1.0, 2.0, 3.0, 4.0}}
+	}
+
+	body, _ := json.MarshalIndent(map[string]interface{}{"inputs": inputs}, "", "  ")
+	return string(body)
+}
+
+func (d *DocumentationGenerator) generateV2TensorExample(inputNames []string) string {
+	var tensors []map[string]interface{}
+	for _, name := range inputNames {
+		tensors = append(tensors, map[string]interface{}{
+			"name":     name,
+			"shape":    []int{1, 4},
+			"datatype": "FP32",
+			"data":     []float64{1.0, 2.0, 3.0, 4.0},
+		})
+	}
+
+	body, _ := json.MarshalIndent(map[string]interface{}{"inputs": tensors}, "", "  ")
+	return string(body)
+}
+
 func (d *DocumentationGenerator) generateTraditionalCurlExample(modelName, externalURL, apiKey string) string {
 	return fmt.Sprintf(`# Standard prediction endpoint
 curl -X POST "%s/predict" \
@@ -395,9 +514,9 @@ curl -X POST "%s/v1/models/%s:predict" \
     ]
   }'
 
-# Get model metadata
+# Get model metadata, authenticating with the Authorization header instead of X-API-Key
 curl -X GET "%s/v1/models/%s" \
-  -H "X-API-Key: %s"`, externalURL, apiKey, externalURL, modelName, apiKey, externalURL, modelName, apiKey)
+  -H "Authorization: Bearer %s"`, externalURL, apiKey, externalURL, modelName, apiKey, externalURL, modelName, apiKey)
 }
 
 func (d *DocumentationGenerator) generateTraditionalPythonExample(modelName, externalURL, apiKey string) string {
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+// Requests a model needs today before its error rate is compared with its threshold, so a few
+// failures right after midnight do not mark it degraded
+const minErrorRateRequests = 20
+
+// currentErrorRate returns a published model's error rate today, read from its daily usage log
+func (s *PublishingService) currentErrorRate(model PublishedModel) (*ModelErrorRate, error) {
+	stats, err := NewUsageTracker(s.k8sClient).GetUsageStats(model.Namespace, model.ModelName, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	rate := &ModelErrorRate{
+		Window:        "today",
+		TotalRequests: stats.TotalRequests,
+		ErrorCount:    stats.ErrorCount,
+		ErrorRate:     stats.ErrorRate,
+		Threshold:     model.ErrorRateThreshold,
+	}
+	rate.Exceeded = model.ErrorRateThreshold > 0 && stats.TotalRequests >= minErrorRateRequests && stats.ErrorRate > model.ErrorRateThreshold
+	return rate, nil
+}
+
+// alertErrorRateExceeded logs an alert and forwards it to the log sink, if configured
+func alertErrorRateExceeded(model PublishedModel, rate *ModelErrorRate) {
+	log.Printf("ALERT: published model %s/%s error rate %.1f%% (%d of %d requests today) is above its threshold of %.1f%%",
+		model.Namespace, model.ModelName, rate.ErrorRate*100, rate.ErrorCount, rate.TotalRequests, rate.Threshold*100)
+
+	exportLogEntry("alert", model.Namespace, map[string]interface{}{
+		"timestamp":     time.Now().Format(time.RFC3339),
+		"alert":         "error_rate_exceeded",
+		"model":         model.ModelName,
+		"namespace":     model.Namespace,
+		"errorRate":     rate.ErrorRate,
+		"threshold":     rate.Threshold,
+		"errorCount":    rate.ErrorCount,
+		"totalRequests": rate.TotalRequests,
+	})
+}
@@ -3,9 +3,12 @@ package main
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // PublishingError represents a publishing-specific error with context
@@ -99,9 +102,9 @@ func (r *PublishingRollback) Execute() {
 
 // ValidationError represents validation errors during publishing
 type ValidationError struct {
-	Field   string
-	Value   interface{}
-	Message string
+	Field   string      `json:"field"`
+	Value   interface{} `json:"value,omitempty"`
+	Message string      `json:"message"`
 }
 
 func (e *ValidationError) Error() string {
@@ -167,6 +170,9 @@ func (v *PublishingValidator) ValidatePublishRequest(namespace, modelName string
 		})
 	}
 	
+	// Validate payload and connection limits
+	errors = append(errors, v.validateTrafficLimits(config.RateLimiting)...)
+	
 	// Validate model type
 	if config.ModelType != "" && config.ModelType != "traditional" && config.ModelType != "openai" {
 		errors = append(errors, ValidationError{
@@ -191,9 +197,45 @@ func (v *PublishingValidator) ValidatePublishRequest(namespace, modelName string
 	if config.PublicHostname != "" {
 		if validationErr := v.validateHostname(config.PublicHostname); validationErr != nil {
 			errors = append(errors, *validationErr)
+		} else if validationErr := v.validateHostnameReservation(namespace, config.PublicHostname); validationErr != nil {
+			errors = append(errors, *validationErr)
+		} else if validationErr := v.validateListenerCapacity(config.PublicHostname); validationErr != nil {
+			errors = append(errors, *validationErr)
 		}
 	}
 	
+	// Validate prediction cache TTL
+	if validationErr := v.validateCacheTTL(config.CacheTTL, config.ModelType); validationErr != nil {
+		errors = append(errors, *validationErr)
+	}
+	
+	// Validate additional path mappings
+	errors = append(errors, v.validatePathMappings(config.PathMappings, config.ModelType)...)
+	
+	// Validate mirror target
+	errors = append(errors, v.validateMirror(namespace, modelName, config.Mirror, config.ModelType)...)
+	
+	// Validate predict path methods
+	errors = append(errors, v.validatePredictMethods(config.PredictMethods, config.ModelType)...)
+	
+	// Validate CORS settings
+	errors = append(errors, v.validateCORS(config.CORS, config.ModelType, len(config.PathMappings))...)
+	
+	// Validate served model name and sampling defaults
+	errors = append(errors, v.validateOpenAIConfig(namespace, modelName, config.OpenAI, config.ModelType)...)
+	
+	// Validate request transformation
+	errors = append(errors, v.validateRequestTransform(config.RequestTransform)...)
+	
+	// Validate error rate alerting threshold
+	if config.ErrorRateThreshold < 0 || config.ErrorRateThreshold > 1 {
+		errors = append(errors, ValidationError{
+			Field:   "errorRateThreshold",
+			Value:   config.ErrorRateThreshold,
+			Message: "Error rate threshold must be between 0 and 1, where 0 disables it",
+		})
+	}
+	
 	// Validate authentication configuration
 	if !config.Authentication.RequireAPIKey {
 		errors = append(errors, ValidationError{
@@ -253,6 +295,9 @@ func (v *PublishingValidator) ValidateUpdateRequest(namespace, modelName string,
 		})
 	}
 	
+	// Validate payload and connection limits
+	errors = append(errors, v.validateTrafficLimits(config.RateLimiting)...)
+	
 	// Validate external path
 	if config.ExternalPath != "" {
 		if !strings.HasPrefix(config.ExternalPath, "/") {
@@ -268,9 +313,45 @@ func (v *PublishingValidator) ValidateUpdateRequest(namespace, modelName string,
 	if config.PublicHostname != "" {
 		if validationErr := v.validateHostname(config.PublicHostname); validationErr != nil {
 			errors = append(errors, *validationErr)
+		} else if validationErr := v.validateHostnameReservation(namespace, config.PublicHostname); validationErr != nil {
+			errors = append(errors, *validationErr)
+		} else if validationErr := v.validateListenerCapacity(config.PublicHostname); validationErr != nil {
+			errors = append(errors, *validationErr)
 		}
 	}
 	
+	// Validate prediction cache TTL
+	if validationErr := v.validateCacheTTL(config.CacheTTL, currentModel.ModelType); validationErr != nil {
+		errors = append(errors, *validationErr)
+	}
+	
+	// Validate additional path mappings
+	errors = append(errors, v.validatePathMappings(config.PathMappings, currentModel.ModelType)...)
+	
+	// Validate mirror target
+	errors = append(errors, v.validateMirror(namespace, modelName, config.Mirror, currentModel.ModelType)...)
+	
+	// Validate predict path methods
+	errors = append(errors, v.validatePredictMethods(config.PredictMethods, currentModel.ModelType)...)
+	
+	// Validate CORS settings
+	errors = append(errors, v.validateCORS(config.CORS, currentModel.ModelType, len(config.PathMappings))...)
+	
+	// Validate served model name and sampling defaults
+	errors = append(errors, v.validateOpenAIConfig(namespace, modelName, config.OpenAI, currentModel.ModelType)...)
+	
+	// Validate request transformation
+	errors = append(errors, v.validateRequestTransform(config.RequestTransform)...)
+	
+	// Validate error rate alerting threshold
+	if config.ErrorRateThreshold < 0 || config.ErrorRateThreshold > 1 {
+		errors = append(errors, ValidationError{
+			Field:   "errorRateThreshold",
+			Value:   config.ErrorRateThreshold,
+			Message: "Error rate threshold must be between 0 and 1, where 0 disables it",
+		})
+	}
+	
 	// Validate authentication configuration
 	if !config.Authentication.RequireAPIKey {
 		errors = append(errors, ValidationError{
@@ -283,6 +364,462 @@ func (v *PublishingValidator) ValidateUpdateRequest(namespace, modelName string,
 	return errors
 }
 
+// validateRequestTransform checks that a request transformation has exactly one form and that
+// its paths are well formed and its template compiles
+func (v *PublishingValidator) validateRequestTransform(transform *RequestTransformConfig) []ValidationError {
+	var errors []ValidationError
+	if transform == nil {
+		return errors
+	}
+	
+	if (transform.Template == "") == (len(transform.Remap) == 0) {
+		return append(errors, ValidationError{
+			Field:   "requestTransform",
+			Value:   transform,
+			Message: "Exactly one of remap or template must be set",
+		})
+	}
+	
+	if transform.Template != "" {
+		if len(transform.Template) > maxRequestTransformTemplateSize {
+			errors = append(errors, ValidationError{
+				Field:   "requestTransform.template",
+				Value:   len(transform.Template),
+				Message: fmt.Sprintf("Template cannot exceed %d bytes", maxRequestTransformTemplateSize),
+			})
+		} else if _, err := compileRequestTransformTemplate(transform.Template); err != nil {
+			errors = append(errors, ValidationError{
+				Field:   "requestTransform.template",
+				Value:   transform.Template,
+				Message: fmt.Sprintf("Template does not compile: %v", err),
+			})
+		}
+		return errors
+	}
+	
+	if len(transform.Remap) > maxRequestTransformRemaps {
+		errors = append(errors, ValidationError{
+			Field:   "requestTransform.remap",
+			Value:   len(transform.Remap),
+			Message: fmt.Sprintf("At most %d field remaps are allowed", maxRequestTransformRemaps),
+		})
+	}
+	for i, remap := range transform.Remap {
+		for _, field := range []struct{ name, path string }{{"from", remap.From}, {"to", remap.To}} {
+			if _, err := splitJSONPath(field.path); err != nil {
+				errors = append(errors, ValidationError{
+					Field:   fmt.Sprintf("requestTransform.remap[%d].%s", i, field.name),
+					Value:   field.path,
+					Message: "Must be a dot-separated field path such as inputs or data.features",
+				})
+			}
+		}
+	}
+	
+	return errors
+}
+
+// validateCacheTTL validates the optional prediction cache duration
+func (v *PublishingValidator) validateCacheTTL(cacheTTL, modelType string) *ValidationError {
+	if cacheTTL == "" {
+		return nil
+	}
+	
+	if modelType == "openai" {
+		return &ValidationError{
+			Field:   "cacheTTL",
+			Value:   cacheTTL,
+			Message: "Prediction caching is only supported for traditional models",
+		}
+	}
+	
+	if _, err := parseDuration(cacheTTL, minCacheTTL, maxCacheTTL); err != nil {
+		return &ValidationError{
+			Field:   "cacheTTL",
+			Value:   cacheTTL,
+			Message: fmt.Sprintf("Invalid cache TTL: %v", err),
+		}
+	}
+	
+	return nil
+}
+
+// Bounds for the optional payload and connection limits of a published model
+const (
+	minMaxRequestBytes          = 1024
+	maxMaxRequestBytes          = 100 * 1024 * 1024
+	maxMaxConcurrentConnections = 10000
+)
+
+// validateTrafficLimits validates the request body and connection limits, where 0 means no limit
+func (v *PublishingValidator) validateTrafficLimits(rateLimiting RateLimitConfig) []ValidationError {
+	var errors []ValidationError
+	
+	if rateLimiting.MaxRequestBytes != 0 &&
+		(rateLimiting.MaxRequestBytes < minMaxRequestBytes || rateLimiting.MaxRequestBytes > maxMaxRequestBytes) {
+		errors = append(errors, ValidationError{
+			Field:   "rateLimiting.maxRequestBytes",
+			Value:   rateLimiting.MaxRequestBytes,
+			Message: fmt.Sprintf("Max request bytes must be 0 or between %d and %d", minMaxRequestBytes, maxMaxRequestBytes),
+		})
+	}
+	
+	if rateLimiting.MaxConcurrentConnections < 0 || rateLimiting.MaxConcurrentConnections > maxMaxConcurrentConnections {
+		errors = append(errors, ValidationError{
+			Field:   "rateLimiting.maxConcurrentConnections",
+			Value:   rateLimiting.MaxConcurrentConnections,
+			Message: fmt.Sprintf("Max concurrent connections must be between 0 and %d", maxMaxConcurrentConnections),
+		})
+	}
+	
+	return errors
+}
+
+// HTTP methods allowed in path mappings
+var pathMappingMethods = map[string]bool{
+	"GET": true, "HEAD": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true, "OPTIONS": true,
+}
+
+// An HTTPRoute holds at most 16 rules; the base predict rule and the 405 rule use two of them
+const maxPathMappings = 14
+
+// validatePathMappings validates the optional additional paths of a traditional model route
+func (v *PublishingValidator) validatePathMappings(mappings []PathMapping, modelType string) []ValidationError {
+	var errors []ValidationError
+	if len(mappings) == 0 {
+		return errors
+	}
+	
+	if modelType == "openai" {
+		return append(errors, ValidationError{
+			Field:   "pathMappings",
+			Value:   len(mappings),
+			Message: "Path mappings are only supported for traditional models",
+		})
+	}
+	
+	if len(mappings) > maxPathMappings {
+		return append(errors, ValidationError{
+			Field:   "pathMappings",
+			Value:   len(mappings),
+			Message: fmt.Sprintf("At most %d path mappings are allowed", maxPathMappings),
+		})
+	}
+	
+	externalPaths := make(map[string]bool)
+	for i, mapping := range mappings {
+		field := fmt.Sprintf("pathMappings[%d]", i)
+		
+		if err := validateRoutePath(mapping.ExternalPath); err != nil || mapping.ExternalPath == "/" {
+			message := "External path must be a sub-path such as /explain"
+			if err != nil {
+				message = fmt.Sprintf("Invalid external path: %v", err)
+			}
+			errors = append(errors, ValidationError{
+				Field:   field + ".externalPath",
+				Value:   mapping.ExternalPath,
+				Message: message,
+			})
+		} else if externalPaths[mapping.ExternalPath] {
+			errors = append(errors, ValidationError{
+				Field:   field + ".externalPath",
+				Value:   mapping.ExternalPath,
+				Message: "Duplicate external path",
+			})
+		}
+		externalPaths[mapping.ExternalPath] = true
+		
+		if err := validateRoutePath(mapping.InternalPath); err != nil {
+			errors = append(errors, ValidationError{
+				Field:   field + ".internalPath",
+				Value:   mapping.InternalPath,
+				Message: fmt.Sprintf("Invalid internal path: %v", err),
+			})
+		}
+		
+		for _, method := range mapping.Methods {
+			if !pathMappingMethods[method] {
+				errors = append(errors, ValidationError{
+					Field:   field + ".methods",
+					Value:   method,
+					Message: "Method must be one of GET, HEAD, POST, PUT, PATCH, DELETE or OPTIONS",
+				})
+			}
+		}
+	}
+	
+	return errors
+}
+
+// Longest served model name accepted, the header value limit of the gateway's route matches
+const maxServedModelNameLength = 253
+
+// validateOpenAIConfig validates the served model name and sampling defaults of an OpenAI model.
+// A served model name must be one the runtime actually serves when its args declare any, since
+// the route only matches requests whose "model" is that name.
+func (v *PublishingValidator) validateOpenAIConfig(namespace, modelName string, openai *OpenAIConfig, modelType string) []ValidationError {
+	var errors []ValidationError
+	if openai == nil {
+		return errors
+	}
+	
+	// An empty type is detected later, so only an explicit traditional type is rejected here
+	if modelType == "traditional" {
+		return append(errors, ValidationError{
+			Field:   "openai",
+			Value:   openai.ServedModelName,
+			Message: "OpenAI settings are only supported for OpenAI models",
+		})
+	}
+	
+	if name := openai.ServedModelName; name != "" {
+		if len(name) > maxServedModelNameLength || strings.TrimSpace(name) != name || strings.ContainsAny(name, " \t\r\n\"") {
+			errors = append(errors, ValidationError{
+				Field:   "openai.servedModelName",
+				Value:   name,
+				Message: fmt.Sprintf("Served model name must be at most %d characters without whitespace or quotes", maxServedModelNameLength),
+			})
+		} else if inferenceService, err := v.service.k8sClient.GetInferenceService(namespace, modelName); err == nil {
+			declared := declaredServedModelNames(inferenceService)
+			served := len(declared) == 0
+			for _, declaredName := range declared {
+				served = served || declaredName == name
+			}
+			if !served {
+				errors = append(errors, ValidationError{
+					Field:   "openai.servedModelName",
+					Value:   name,
+					Message: fmt.Sprintf("The model's runtime serves %s; the served model name must be one of them", strings.Join(declared, ", ")),
+				})
+			}
+		}
+	}
+	
+	if openai.Temperature != nil && (*openai.Temperature < 0 || *openai.Temperature > 2) {
+		errors = append(errors, ValidationError{
+			Field:   "openai.temperature",
+			Value:   *openai.Temperature,
+			Message: "Temperature must be between 0 and 2",
+		})
+	}
+	if openai.MaxTokens < 0 {
+		errors = append(errors, ValidationError{
+			Field:   "openai.maxTokens",
+			Value:   openai.MaxTokens,
+			Message: "Max tokens must not be negative",
+		})
+	}
+	if openai.MinTokens < 0 {
+		errors = append(errors, ValidationError{
+			Field:   "openai.minTokens",
+			Value:   openai.MinTokens,
+			Message: "Min tokens must not be negative",
+		})
+	} else if openai.MaxTokens > 0 && openai.MinTokens > openai.MaxTokens {
+		errors = append(errors, ValidationError{
+			Field:   "openai.minTokens",
+			Value:   openai.MinTokens,
+			Message: "Min tokens must not exceed max tokens",
+		})
+	}
+	
+	return errors
+}
+
+// validatePredictMethods validates the methods accepted on a traditional model's predict path
+func (v *PublishingValidator) validatePredictMethods(methods []string, modelType string) []ValidationError {
+	var errors []ValidationError
+	if len(methods) == 0 {
+		return errors
+	}
+	
+	if modelType == "openai" {
+		return append(errors, ValidationError{
+			Field:   "predictMethods",
+			Value:   methods,
+			Message: "Predict methods are only supported for traditional models",
+		})
+	}
+	
+	seen := make(map[string]bool)
+	for _, method := range methods {
+		if !pathMappingMethods[method] {
+			errors = append(errors, ValidationError{
+				Field:   "predictMethods",
+				Value:   method,
+				Message: "Method must be one of GET, HEAD, POST, PUT, PATCH, DELETE or OPTIONS",
+			})
+		} else if seen[method] {
+			errors = append(errors, ValidationError{
+				Field:   "predictMethods",
+				Value:   method,
+				Message: "Duplicate method",
+			})
+		}
+		seen[method] = true
+	}
+	
+	return errors
+}
+
+// Longest preflight cache duration accepted in CORS settings
+const maxCORSMaxAge = 24 * time.Hour
+
+// validateCORS validates the optional cross-origin settings of a traditional model route
+func (v *PublishingValidator) validateCORS(cors *CORSConfig, modelType string, pathMappings int) []ValidationError {
+	var errors []ValidationError
+	if cors == nil {
+		return errors
+	}
+	
+	if modelType == "openai" {
+		return append(errors, ValidationError{
+			Field:   "cors",
+			Value:   cors.AllowOrigins,
+			Message: "CORS is only supported for traditional models",
+		})
+	}
+	
+	// The preflight rule takes one of the route's rules
+	if pathMappings > maxPathMappings-1 {
+		errors = append(errors, ValidationError{
+			Field:   "pathMappings",
+			Value:   pathMappings,
+			Message: fmt.Sprintf("At most %d path mappings are allowed when CORS is enabled", maxPathMappings-1),
+		})
+	}
+	
+	if len(cors.AllowOrigins) == 0 {
+		errors = append(errors, ValidationError{
+			Field:   "cors.allowOrigins",
+			Message: "At least one allowed origin is required",
+		})
+	}
+	for _, origin := range cors.AllowOrigins {
+		if origin == "*" {
+			if cors.AllowCredentials {
+				errors = append(errors, ValidationError{
+					Field:   "cors.allowOrigins",
+					Value:   origin,
+					Message: "The * origin cannot be combined with allowCredentials",
+				})
+			}
+			continue
+		}
+		parsed, err := url.Parse(origin)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" ||
+			(parsed.Path != "" && parsed.Path != "/") || parsed.RawQuery != "" || parsed.Fragment != "" {
+			errors = append(errors, ValidationError{
+				Field:   "cors.allowOrigins",
+				Value:   origin,
+				Message: "Origin must be * or a scheme and host such as https://app.example.com or https://*.example.com",
+			})
+		}
+	}
+	
+	for _, method := range cors.AllowMethods {
+		if !pathMappingMethods[method] {
+			errors = append(errors, ValidationError{
+				Field:   "cors.allowMethods",
+				Value:   method,
+				Message: "Method must be one of GET, HEAD, POST, PUT, PATCH, DELETE or OPTIONS",
+			})
+		}
+	}
+	
+	for _, headers := range []struct {
+		field string
+		names []string
+	}{{"cors.allowHeaders", cors.AllowHeaders}, {"cors.exposeHeaders", cors.ExposeHeaders}} {
+		for _, name := range headers.names {
+			if errs := validation.IsHTTPHeaderName(name); len(errs) > 0 {
+				errors = append(errors, ValidationError{
+					Field:   headers.field,
+					Value:   name,
+					Message: strings.Join(errs, "; "),
+				})
+			}
+		}
+	}
+	
+	if cors.MaxAge != "" {
+		if maxAge, err := time.ParseDuration(cors.MaxAge); err != nil || maxAge <= 0 || maxAge > maxCORSMaxAge {
+			errors = append(errors, ValidationError{
+				Field:   "cors.maxAge",
+				Value:   cors.MaxAge,
+				Message: fmt.Sprintf("Max age must be a duration with a unit, such as 10m, up to %s", maxCORSMaxAge),
+			})
+		}
+	}
+	
+	return errors
+}
+
+// validateMirror validates the optional shadow model that receives a copy of predict traffic
+func (v *PublishingValidator) validateMirror(namespace, modelName string, mirror *MirrorConfig, modelType string) []ValidationError {
+	var errors []ValidationError
+	if mirror == nil {
+		return errors
+	}
+	
+	if modelType == "openai" {
+		return append(errors, ValidationError{
+			Field:   "mirror",
+			Value:   mirror.ModelName,
+			Message: "Request mirroring is only supported for traditional models",
+		})
+	}
+	
+	if mirror.Percent < 1 || mirror.Percent > 100 {
+		errors = append(errors, ValidationError{
+			Field:   "mirror.percent",
+			Value:   mirror.Percent,
+			Message: "Mirror percent must be between 1 and 100",
+		})
+	}
+	
+	switch {
+	case mirror.ModelName == "":
+		errors = append(errors, ValidationError{
+			Field:   "mirror.modelName",
+			Value:   mirror.ModelName,
+			Message: "Mirror model name is required",
+		})
+	case mirror.ModelName == modelName:
+		errors = append(errors, ValidationError{
+			Field:   "mirror.modelName",
+			Value:   mirror.ModelName,
+			Message: "A model cannot mirror traffic to itself",
+		})
+	default:
+		if err := v.service.validateModelExists(namespace, mirror.ModelName); err != nil {
+			errors = append(errors, ValidationError{
+				Field:   "mirror.modelName",
+				Value:   mirror.ModelName,
+				Message: fmt.Sprintf("Mirror model must exist and be ready: %v", err),
+			})
+		}
+	}
+	
+	return errors
+}
+
+// validateRoutePath checks that a path is absolute and free of traversal, query and fragment parts
+func validateRoutePath(path string) error {
+	if !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("must start with '/'")
+	}
+	if strings.ContainsAny(path, "?# \t\n\\") {
+		return fmt.Errorf("must not contain query, fragment or whitespace characters")
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "." || segment == ".." {
+			return fmt.Errorf("must not contain '.' or '..' segments")
+		}
+	}
+	return nil
+}
+
 // validateHostname validates hostname format and patterns
 func (v *PublishingValidator) validateHostname(hostname string) *ValidationError {
 	// Check for protocol inclusion
@@ -359,6 +896,55 @@ func (v *PublishingValidator) validateHostname(hostname string) *ValidationError
 }
 
 // validateHostnamePattern validates specific hostname patterns
+// validateHostnameReservation rejects hostnames reserved by a tenant other than namespace. When the
+// reservations cannot be read the hostname is rejected, since the check guards tenant isolation.
+func (v *PublishingValidator) validateHostnameReservation(namespace, hostname string) *ValidationError {
+	reservations, _, err := v.service.k8sClient.GetHostnameReservations(serviceNamespace())
+	if err != nil {
+		return &ValidationError{
+			Field:   "publicHostname",
+			Value:   hostname,
+			Message: fmt.Sprintf("Failed to check hostname reservations: %v", err),
+		}
+	}
+	
+	if reservation := reservationForHostname(reservations, hostname); reservation != nil && reservation.Tenant != namespace {
+		return &ValidationError{
+			Field:   "publicHostname",
+			Value:   hostname,
+			Message: fmt.Sprintf("Hostname is reserved for another tenant (%s)", reservation.Hostname),
+		}
+	}
+	return nil
+}
+
+// validateListenerCapacity rejects a custom hostname whose new listeners would take the shared
+// gateway past MAX_GATEWAY_LISTENERS. Hostnames covered by the wildcard listeners add none.
+func (v *PublishingValidator) validateListenerCapacity(hostname string) *ValidationError {
+	max := ActiveConfig().MaxGatewayListeners
+	if max <= 0 || v.service.isHostnameCoveredByWildcard(hostname) {
+		return nil
+	}
+	
+	count, exists, err := v.service.gatewayListenerUsage(hostname)
+	if err != nil {
+		return &ValidationError{
+			Field:   "publicHostname",
+			Value:   hostname,
+			Message: fmt.Sprintf("Failed to check gateway listener capacity: %v", err),
+		}
+	}
+	
+	if !exists && count+listenersPerCustomHostname > max {
+		return &ValidationError{
+			Field:   "publicHostname",
+			Value:   hostname,
+			Message: fmt.Sprintf("The shared gateway has %d of at most %d listeners and a new custom hostname adds %d; use a hostname under *.inference-in-a-box, which the existing wildcard listeners serve", count, max, listenersPerCustomHostname),
+		}
+	}
+	return nil
+}
+
 func (v *PublishingValidator) validateHostnamePattern(hostname string) *ValidationError {
 	// Default hostname - always valid
 	if hostname == "api.router.inference-in-a-box" {
@@ -463,24 +1049,17 @@ func (r *ErrorReporter) ReportError(user *User, namespace, modelName, operation
 		"error":     err.Error(),
 		"level":     "error",
 	}
+	if publishingErr, ok := err.(*PublishingError); ok {
+		errorEntry["code"] = publishingErr.Code
+		errorEntry["step"] = publishingErr.Step
+		errorEntry["message"] = publishingErr.Message
+		errorEntry["details"] = publishingErr.Details
+	}
 	
 	// Store error in audit log
 	errorLogName := fmt.Sprintf("publishing-errors-%s", time.Now().Format("2006-01-02"))
 	
-	// Try to get existing error log for today
-	existingLog, logErr := r.service.k8sClient.GetConfigMap(namespace, errorLogName)
-	if logErr != nil {
-		// Create new error log
-		errorData := map[string]interface{}{
-			"entries": []interface{}{errorEntry},
-		}
-		r.service.k8sClient.CreateConfigMap(namespace, errorLogName, errorData)
-	} else {
-		// Append to existing error log
-		if entries, ok := existingLog["entries"].([]interface{}); ok {
-			entries = append(entries, errorEntry)
-			existingLog["entries"] = entries
-			r.service.k8sClient.UpdateConfigMap(namespace, errorLogName, existingLog)
-		}
+	if logErr := appendLogEntry(r.service.k8sClient, namespace, errorLogName, errorEntry, newEntriesLog, nil); logErr != nil {
+		log.Printf("Failed to write error log %s/%s: %v", namespace, errorLogName, logErr)
 	}
 }
\ No newline at end of file
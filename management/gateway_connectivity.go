@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Time allowed for each DNS lookup, connect and HTTP request of a connectivity check
+const gatewayConnectivityTimeout = 3 * time.Second
+
+// GetGatewayConnectivity handles GET /api/admin/gateway/connectivity-check
+// It resolves the gateway service found by GetAIGatewayService, connects to each of its ports and
+// sends an HTTP request to plain HTTP ports. With ?hostname= it also checks that a published
+// hostname, such as an sslip.io test hostname, resolves and answers from inside the cluster.
+func (s *AdminService) GetGatewayConnectivity(c *gin.Context) {
+	hostname := c.Query("hostname")
+	if hostname != "" {
+		if errs := validation.IsDNS1123Subdomain(hostname); len(errs) > 0 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid hostname",
+				Details: strings.Join(errs, "; "),
+			})
+			return
+		}
+	}
+
+	gatewayService, gateway, err := s.findGatewayService()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to get gateway services",
+			Details: err.Error(),
+		})
+		return
+	}
+	if gatewayService == nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "No gateway service found (tried istio-ingressgateway and envoy-gateway)",
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+	serviceHost := fmt.Sprintf("%s.%s.svc.cluster.local", gatewayService.Name, gatewayService.Namespace)
+	response := GatewayConnectivityResponse{
+		Gateway:   gateway,
+		Service:   gatewayService.Name,
+		Namespace: gatewayService.Namespace,
+		ClusterIP: gatewayService.Spec.ClusterIP,
+		DNS:       checkDNS(ctx, serviceHost),
+		Ports:     make([]GatewayPortConnectivity, len(gatewayService.Spec.Ports)),
+	}
+
+	// Headless services have no cluster IP, so their ports are reached through the service name
+	dialHost := gatewayService.Spec.ClusterIP
+	if dialHost == "" || dialHost == corev1.ClusterIPNone {
+		dialHost = serviceHost
+	}
+
+	var wg sync.WaitGroup
+	for i, port := range gatewayService.Spec.Ports {
+		response.Ports[i] = GatewayPortConnectivity{Name: port.Name, Port: port.Port}
+		wg.Add(1)
+		go func(i int, port corev1.ServicePort) {
+			defer wg.Done()
+			address := net.JoinHostPort(dialHost, strconv.Itoa(int(port.Port)))
+			result := &response.Ports[i]
+			if port.Protocol != "" && port.Protocol != corev1.ProtocolTCP {
+				result.TCP = ConnectivityCheck{Target: address, Error: fmt.Sprintf("%s ports are not checked", port.Protocol)}
+				return
+			}
+			result.TCP = checkTCP(ctx, address)
+			if result.TCP.Success && isPlainHTTPPort(port) {
+				check := checkHTTP(ctx, "http://"+address+"/")
+				result.HTTP = &check
+			}
+		}(i, port)
+	}
+	if hostname != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			check := checkHostname(ctx, hostname)
+			response.Hostname = &check
+		}()
+	}
+	wg.Wait()
+
+	// Only TCP ports count towards reachability, since other protocols are not checked
+	checked := 0
+	response.Reachable = response.DNS.Success
+	for i, port := range gatewayService.Spec.Ports {
+		if port.Protocol != "" && port.Protocol != corev1.ProtocolTCP {
+			continue
+		}
+		checked++
+		response.Reachable = response.Reachable && response.Ports[i].TCP.Success
+	}
+	response.Reachable = response.Reachable && checked > 0
+	response.CheckedAt = time.Now()
+
+	c.JSON(http.StatusOK, response)
+}
+
+// isPlainHTTPPort reports whether a service port serves plain HTTP, going by its app protocol,
+// its name prefix as Istio uses it, or port 80
+func isPlainHTTPPort(port corev1.ServicePort) bool {
+	if port.AppProtocol != nil {
+		return strings.EqualFold(*port.AppProtocol, "http")
+	}
+	name := strings.ToLower(port.Name)
+	if strings.HasPrefix(name, "https") {
+		return false
+	}
+	return strings.HasPrefix(name, "http") || port.Port == 80
+}
+
+// checkDNS resolves host with the cluster resolver
+func checkDNS(ctx context.Context, host string) ConnectivityCheck {
+	ctx, cancel := context.WithTimeout(ctx, gatewayConnectivityTimeout)
+	defer cancel()
+
+	start := time.Now()
+	addresses, err := net.DefaultResolver.LookupHost(ctx, host)
+	check := ConnectivityCheck{Target: host, LatencyMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	check.Success = true
+	check.Addresses = addresses
+	return check
+}
+
+// checkTCP opens and closes a TCP connection to address
+func checkTCP(ctx context.Context, address string) ConnectivityCheck {
+	dialer := net.Dialer{Timeout: gatewayConnectivityTimeout}
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	check := ConnectivityCheck{Target: address, LatencyMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	conn.Close()
+	check.Success = true
+	return check
+}
+
+// checkHTTP sends a GET request to url. Any response, including an error status such as the 404
+// of a gateway without a matching route, shows the gateway is serving.
+func checkHTTP(ctx context.Context, url string) ConnectivityCheck {
+	check := ConnectivityCheck{Target: url}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	client := &http.Client{
+		Timeout: gatewayConnectivityTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	check.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	resp.Body.Close()
+	check.Success = true
+	check.StatusCode = resp.StatusCode
+	return check
+}
+
+// checkHostname resolves a published hostname and sends an HTTP request to it. A hostname
+// resolving to a loopback address, as 127.0.0.1.sslip.io hostnames do, points back at the
+// management pod itself, so it is reported as a failure without a request.
+func checkHostname(ctx context.Context, hostname string) ConnectivityCheck {
+	dns := checkDNS(ctx, hostname)
+	if !dns.Success {
+		return dns
+	}
+	for _, address := range dns.Addresses {
+		if ip := net.ParseIP(address); ip != nil && ip.IsLoopback() {
+			dns.Success = false
+			dns.Error = fmt.Sprintf("%s resolves to loopback address %s, which is not the gateway from inside the cluster", hostname, address)
+			return dns
+		}
+	}
+
+	check := checkHTTP(ctx, "http://"+hostname+"/")
+	check.Addresses = dns.Addresses
+	check.LatencyMs += dns.LatencyMs
+	return check
+}
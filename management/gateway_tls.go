@@ -0,0 +1,252 @@
+package main
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Days before expiry at which a gateway certificate is reported as expiring, unless ?warnDays= is given
+const (
+	defaultTLSExpiryWarnDays = 30
+	maxTLSExpiryWarnDays     = 365
+)
+
+// Certificate states, from best to worst. A secret reports the worst state of its certificates.
+var tlsCertificateStates = []string{"valid", "expiring", "not-yet-valid", "expired", "error"}
+
+// GetGatewayTLSStatus handles GET /api/admin/gateway/tls-status
+// It reads the TLS secrets referenced by the gateway's listeners and reports the expiry of each
+// certificate, so an expiring certificate is caught before every published model stops working.
+func (s *PublishingService) GetGatewayTLSStatus(c *gin.Context) {
+	warnDays, err := strconv.Atoi(c.DefaultQuery("warnDays", strconv.Itoa(defaultTLSExpiryWarnDays)))
+	if err != nil || warnDays < 1 || warnDays > maxTLSExpiryWarnDays {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: fmt.Sprintf("warnDays must be between 1 and %d", maxTLSExpiryWarnDays),
+		})
+		return
+	}
+
+	gatewayNamespace := "envoy-gateway-system"
+	gatewayName := "ai-inference-gateway"
+
+	gateway, err := s.k8sClient.GetGateway(gatewayNamespace, gatewayName)
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to get gateway",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	now := time.Now()
+	response := GatewayTLSStatusResponse{
+		Gateway:   gatewayNamespace + "/" + gatewayName,
+		WarnDays:  warnDays,
+		Secrets:   []GatewayTLSSecret{},
+		CheckedAt: now,
+	}
+	for _, secret := range gatewayTLSSecretRefs(gateway, gatewayNamespace) {
+		s.inspectGatewayTLSSecret(secret, now, warnDays)
+		switch secret.Status {
+		case "expiring":
+			response.Expiring++
+		case "expired":
+			response.Expired++
+		case "error":
+			response.Errors++
+		}
+		response.Secrets = append(response.Secrets, *secret)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// gatewayTLSSecretRefs groups the HTTPS listeners of a gateway by the certificate Secrets they
+// reference, keeping the order the Secrets first appear in. References without a namespace
+// point at the gateway's namespace.
+func gatewayTLSSecretRefs(gateway map[string]interface{}, gatewayNamespace string) []*GatewayTLSSecret {
+	spec, _ := gateway["spec"].(map[string]interface{})
+	listeners, _ := spec["listeners"].([]interface{})
+
+	var secrets []*GatewayTLSSecret
+	byRef := make(map[string]*GatewayTLSSecret)
+	seenHostnames := make(map[string]bool)
+	for _, item := range listeners {
+		listener, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		tls, _ := listener["tls"].(map[string]interface{})
+		refs, _ := tls["certificateRefs"].([]interface{})
+		listenerName, _ := listener["name"].(string)
+		hostname, _ := listener["hostname"].(string)
+
+		for _, item := range refs {
+			ref, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := ref["name"].(string)
+			if name == "" {
+				continue
+			}
+			namespace, _ := ref["namespace"].(string)
+			if namespace == "" {
+				namespace = gatewayNamespace
+			}
+			kind, _ := ref["kind"].(string)
+			group, _ := ref["group"].(string)
+
+			key := namespace + "/" + name
+			secret, exists := byRef[key]
+			if !exists {
+				secret = &GatewayTLSSecret{
+					Namespace:    namespace,
+					Name:         name,
+					Listeners:    []string{},
+					Hostnames:    []string{},
+					Certificates: []TLSCertificateInfo{},
+				}
+				// Only core Secrets can be read; other kinds are reported rather than skipped
+				if (kind != "" && kind != "Secret") || group != "" {
+					secret.Status = "error"
+					secret.Error = fmt.Sprintf("certificate reference kind %q in group %q is not supported", kind, group)
+				}
+				byRef[key] = secret
+				secrets = append(secrets, secret)
+			}
+			secret.Listeners = append(secret.Listeners, listenerName)
+			if hostname != "" && !seenHostnames[key+" "+hostname] {
+				seenHostnames[key+" "+hostname] = true
+				secret.Hostnames = append(secret.Hostnames, hostname)
+			}
+		}
+	}
+	return secrets
+}
+
+// inspectGatewayTLSSecret reads a TLS Secret and fills in its certificates, its status and the
+// listener hostnames its leaf certificate does not cover
+func (s *PublishingService) inspectGatewayTLSSecret(secret *GatewayTLSSecret, now time.Time, warnDays int) {
+	if secret.Status == "error" {
+		return
+	}
+
+	object, err := s.k8sClient.GetSecret(secret.Namespace, secret.Name)
+	if err != nil {
+		secret.Status = "error"
+		secret.Error = err.Error()
+		return
+	}
+	certificates, err := parseTLSSecretCertificates(object)
+	if err != nil {
+		secret.Status = "error"
+		secret.Error = err.Error()
+		return
+	}
+
+	secret.Status = "valid"
+	for _, cert := range certificates {
+		info := tlsCertificateInfo(cert, now, warnDays)
+		secret.Certificates = append(secret.Certificates, info)
+		if tlsCertificateStateRank(info.Status) > tlsCertificateStateRank(secret.Status) {
+			secret.Status = info.Status
+		}
+	}
+
+	// The first certificate is the one served; the rest of the chain does not name hosts
+	secret.UncoveredHostnames = []string{}
+	for _, hostname := range secret.Hostnames {
+		if !certificateCoversHostname(certificates[0], hostname) {
+			secret.UncoveredHostnames = append(secret.UncoveredHostnames, hostname)
+		}
+	}
+}
+
+// parseTLSSecretCertificates decodes the PEM certificate chain stored under tls.crt
+func parseTLSSecretCertificates(secret *corev1.Secret) ([]*x509.Certificate, error) {
+	data, ok := secret.Data[corev1.TLSCertKey]
+	if !ok || len(data) == 0 {
+		return nil, fmt.Errorf("secret %s/%s has no %s", secret.Namespace, secret.Name, corev1.TLSCertKey)
+	}
+
+	var certificates []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate in %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		certificates = append(certificates, cert)
+	}
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("secret %s/%s has no PEM certificates in %s", secret.Namespace, secret.Name, corev1.TLSCertKey)
+	}
+	return certificates, nil
+}
+
+// tlsCertificateInfo summarizes a certificate and where it stands against the warning window
+func tlsCertificateInfo(cert *x509.Certificate, now time.Time, warnDays int) TLSCertificateInfo {
+	info := TLSCertificateInfo{
+		Subject:       cert.Subject.String(),
+		Issuer:        cert.Issuer.String(),
+		DNSNames:      append([]string{}, cert.DNSNames...),
+		SerialNumber:  cert.SerialNumber.Text(16),
+		NotBefore:     cert.NotBefore,
+		NotAfter:      cert.NotAfter,
+		DaysRemaining: int(cert.NotAfter.Sub(now).Hours() / 24),
+		Status:        "valid",
+	}
+	for _, ip := range cert.IPAddresses {
+		info.DNSNames = append(info.DNSNames, ip.String())
+	}
+
+	switch {
+	case now.After(cert.NotAfter):
+		info.Status = "expired"
+	case now.Before(cert.NotBefore):
+		info.Status = "not-yet-valid"
+	case cert.NotAfter.Sub(now) < time.Duration(warnDays)*24*time.Hour:
+		info.Status = "expiring"
+	}
+	return info
+}
+
+func tlsCertificateStateRank(status string) int {
+	for i, state := range tlsCertificateStates {
+		if state == status {
+			return i
+		}
+	}
+	return 0
+}
+
+// certificateCoversHostname reports whether a certificate is valid for a listener hostname. A
+// wildcard listener is only covered by the same wildcard name, since no single name can stand
+// for every host it serves.
+func certificateCoversHostname(cert *x509.Certificate, hostname string) bool {
+	if strings.HasPrefix(hostname, "*.") {
+		for _, name := range cert.DNSNames {
+			if strings.EqualFold(name, hostname) {
+				return true
+			}
+		}
+		return false
+	}
+	return cert.VerifyHostname(hostname) == nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Tenant hostname reservations are kept in this ConfigMap in the service's namespace
+const hostnameReservationsConfigMap = "management-service-hostname-reservations"
+
+// GetHostnameReservations handles GET /api/admin/hostname-reservations
+func (s *AdminService) GetHostnameReservations(c *gin.Context) {
+	reservations, updatedAt, err := s.k8sClient.GetHostnameReservations(serviceNamespace())
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to get hostname reservations",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, HostnameReservationsResponse{
+		Reservations: reservations,
+		UpdatedAt:    updatedAt,
+	})
+}
+
+// UpdateHostnameReservations handles PUT /api/admin/hostname-reservations
+// The request replaces every reservation. Already-published models are not affected.
+func (s *AdminService) UpdateHostnameReservations(c *gin.Context) {
+	var req HostnameReservationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	reservations := make([]HostnameReservation, len(req.Reservations))
+	for i, reservation := range req.Reservations {
+		reservations[i] = HostnameReservation{
+			Tenant:   strings.TrimSpace(reservation.Tenant),
+			Hostname: strings.ToLower(strings.TrimSpace(reservation.Hostname)),
+		}
+	}
+
+	if validationErrors := validateHostnameReservations(reservations); len(validationErrors) > 0 {
+		var errorMessages []string
+		for _, err := range validationErrors {
+			errorMessages = append(errorMessages, err.Error())
+		}
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Validation failed",
+			Details: strings.Join(errorMessages, "; "),
+		})
+		return
+	}
+
+	updatedAt := time.Now().UTC().Format(time.RFC3339)
+	if err := s.k8sClient.SaveHostnameReservations(serviceNamespace(), reservations, updatedAt); err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to save hostname reservations",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	log.Printf("Hostname reservations updated: %d reservation(s)", len(reservations))
+	c.JSON(http.StatusOK, HostnameReservationsResponse{
+		Reservations: reservations,
+		UpdatedAt:    updatedAt,
+	})
+}
+
+// validateHostnameReservations checks each reservation and that no hostname is claimed by two tenants
+func validateHostnameReservations(reservations []HostnameReservation) []ValidationError {
+	var errors []ValidationError
+	for i, reservation := range reservations {
+		field := fmt.Sprintf("reservations[%d]", i)
+		if reservation.Tenant == "" {
+			errors = append(errors, ValidationError{Field: field + ".tenant", Message: "Tenant is required"})
+		}
+		if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(reservation.Hostname, "*.")); len(errs) > 0 {
+			errors = append(errors, ValidationError{Field: field + ".hostname", Value: reservation.Hostname, Message: strings.Join(errs, "; ")})
+			continue
+		}
+
+		for j := 0; j < i; j++ {
+			other := reservations[j]
+			if other.Tenant == reservation.Tenant {
+				continue
+			}
+			if hostnameReservationCovers(other.Hostname, reservation.Hostname) || hostnameReservationCovers(reservation.Hostname, other.Hostname) {
+				errors = append(errors, ValidationError{
+					Field:   field + ".hostname",
+					Value:   reservation.Hostname,
+					Message: fmt.Sprintf("Overlaps %s reserved for tenant %s", other.Hostname, other.Tenant),
+				})
+			}
+		}
+	}
+	return errors
+}
+
+// reservationForHostname returns the most specific reservation covering hostname, if any
+func reservationForHostname(reservations []HostnameReservation, hostname string) *HostnameReservation {
+	hostname = strings.ToLower(hostname)
+
+	var best *HostnameReservation
+	for i := range reservations {
+		reservation := &reservations[i]
+		if !hostnameReservationCovers(reservation.Hostname, hostname) {
+			continue
+		}
+		if best == nil || len(reservation.Hostname) > len(best.Hostname) {
+			best = reservation
+		}
+	}
+	return best
+}
+
+// hostnameReservationCovers reports whether reserved covers hostname. A "*.example.com"
+// reservation covers example.com itself and every subdomain of it; a wildcard hostname is
+// covered when its domain is.
+func hostnameReservationCovers(reserved, hostname string) bool {
+	hostname = strings.TrimPrefix(hostname, "*.")
+	if !strings.HasPrefix(reserved, "*.") {
+		return reserved == hostname
+	}
+	domain := reserved[2:]
+	return hostname == domain || strings.HasSuffix(hostname, "."+domain)
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// ProxyClientOptions controls the behaviour of clients built by NewProxyHTTPClient
+type ProxyClientOptions struct {
+	Timeout            time.Duration // Whole-request timeout, 30s when zero
+	BlockLinkLocal     bool          // Refuse connections to link-local and metadata addresses after resolution
+	FollowRedirects    bool          // Follow redirects instead of returning them to the caller
+	InsecureSkipVerify bool          // Accept self-signed certificates, such as those of sslip.io test hostnames
+
+	// AllowAddress, when set, refuses connections to resolved addresses it rejects. AddressScope
+	// names what it allows, so clients with different scopes never share a transport.
+	AllowAddress func(ip net.IP) bool
+	AddressScope string
+}
+
+// Transports are cached so clients for the same settings share a connection pool.
+// Cache entries are keyed by the DNS overrides and the dial/TLS options.
+const maxProxyTransports = 64
+
+var (
+	proxyTransportsMu sync.Mutex
+	proxyTransports   = make(map[string]*http.Transport)
+)
+
+// NewProxyHTTPClient returns an HTTP client for calls to model endpoints. The DNS overrides of
+// settings, if any, are applied at dial time. Clients with the same overrides and options reuse
+// one pooled transport, so creating a client per request does not cost a new connection.
+func NewProxyHTTPClient(settings *ConnectionSettings, opts ProxyClientOptions) *http.Client {
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+
+	client := &http.Client{
+		Transport: proxyTransport(dnsResolveMap(settings), opts),
+		Timeout:   timeout,
+	}
+	if !opts.FollowRedirects {
+		// Redirects are returned to the caller rather than followed, so a target
+		// cannot bounce the proxy to a host that was never validated
+		client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	return client
+}
+
+// dnsResolveMap maps host:port to the overriding address:port list from the connection settings
+func dnsResolveMap(settings *ConnectionSettings) map[string][]string {
+	resolveMap := make(map[string][]string)
+	if settings == nil {
+		return resolveMap
+	}
+	for _, dnsResolve := range settings.DNSResolve {
+		addresses := dnsResolveAddresses(dnsResolve)
+		if dnsResolve.Host == "" || dnsResolve.Port == "" || len(addresses) == 0 {
+			continue
+		}
+		addressKey := net.JoinHostPort(dnsResolve.Host, dnsResolve.Port)
+		for _, address := range addresses {
+			resolveMap[addressKey] = append(resolveMap[addressKey], net.JoinHostPort(address, dnsResolve.Port))
+		}
+	}
+	return resolveMap
+}
+
+// proxyTransport returns the cached transport for the overrides and options, creating it if needed
+func proxyTransport(resolveMap map[string][]string, opts ProxyClientOptions) *http.Transport {
+	key := proxyTransportKey(resolveMap, opts)
+
+	proxyTransportsMu.Lock()
+	defer proxyTransportsMu.Unlock()
+
+	if transport, ok := proxyTransports[key]; ok {
+		return transport
+	}
+
+	// Overrides are user supplied, so drop idle pools rather than grow without bound
+	if len(proxyTransports) >= maxProxyTransports {
+		for cachedKey, transport := range proxyTransports {
+			transport.CloseIdleConnections()
+			delete(proxyTransports, cachedKey)
+		}
+	}
+
+	transport := newProxyTransport(resolveMap, opts)
+	proxyTransports[key] = transport
+	return transport
+}
+
+func proxyTransportKey(resolveMap map[string][]string, opts ProxyClientOptions) string {
+	entries := make([]string, 0, len(resolveMap))
+	for addr, override := range resolveMap {
+		entries = append(entries, addr+"="+strings.Join(override, "|"))
+	}
+	sort.Strings(entries)
+	return fmt.Sprintf("%t|%t|%s|%s", opts.BlockLinkLocal, opts.InsecureSkipVerify, opts.AddressScope, strings.Join(entries, ","))
+}
+
+func newProxyTransport(resolveMap map[string][]string, opts ProxyClientOptions) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if opts.BlockLinkLocal || opts.AllowAddress != nil {
+		dialer.Control = func(network, address string, conn syscall.RawConn) error {
+			if opts.BlockLinkLocal {
+				if err := blockLinkLocalDial(network, address, conn); err != nil {
+					return err
+				}
+			}
+			if opts.AllowAddress != nil {
+				return allowAddressDial(address, opts.AllowAddress)
+			}
+			return nil
+		}
+	}
+
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			// Check if this address needs DNS override
+			overrides, exists := resolveMap[addr]
+			if !exists {
+				return dialer.DialContext(ctx, network, addr)
+			}
+
+			// Try the override addresses in order, failing over when one cannot be reached
+			var lastErr error
+			for i, override := range overrides {
+				conn, err := dialer.DialContext(ctx, network, override)
+				if err == nil {
+					if i > 0 {
+						log.Printf("Connected to %s via %s after %d failed address(es)", addr, override, i)
+					}
+					return conn, nil
+				}
+				lastErr = err
+				if ctx.Err() != nil {
+					break
+				}
+			}
+			return nil, fmt.Errorf("all %d address(es) for %s failed, last error: %w", len(overrides), addr, lastErr)
+		},
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   10,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	if opts.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	return transport
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Issuer of impersonation tokens. Tokens with this issuer are signature-checked; other tokens
+// are still parsed without verification.
+const impersonationTokenIssuer = "management-service/impersonation"
+
+// Longest lifetime an admin can request for an impersonation token
+const maxImpersonationTokenTTL = time.Hour
+
+var (
+	generatedImpersonationKey     []byte
+	generatedImpersonationKeyOnce sync.Once
+)
+
+// impersonationSigningKey returns the HMAC key impersonation tokens are signed with. Without
+// IMPERSONATION_SIGNING_KEY a random key is generated, so issued tokens stop working when the
+// service restarts and are not accepted by other replicas.
+func impersonationSigningKey(config *Config) []byte {
+	if config.ImpersonationSigningKey != "" {
+		return []byte(config.ImpersonationSigningKey)
+	}
+	generatedImpersonationKeyOnce.Do(func() {
+		generatedImpersonationKey = make([]byte, 32)
+		if _, err := rand.Read(generatedImpersonationKey); err != nil {
+			log.Fatalf("Failed to generate impersonation signing key: %v", err)
+		}
+	})
+	return generatedImpersonationKey
+}
+
+// userIdentity names a user in audit entries, preferring the display name over the subject
+func userIdentity(u *User) string {
+	if u.Name != "" {
+		return u.Name
+	}
+	return u.Subject
+}
+
+// issueImpersonationToken signs a tenant-scoped, non-admin token on behalf of an admin
+func issueImpersonationToken(config *Config, tenant, admin, tokenID string, expiresAt time.Time) (string, error) {
+	claims := jwt.MapClaims{
+		"iss":            impersonationTokenIssuer,
+		"sub":            "impersonation:" + tokenID,
+		"jti":            tokenID,
+		"tenant":         tenant,
+		"name":           admin,
+		"impersonatedBy": admin,
+		"iat":            time.Now().Unix(),
+		"exp":            expiresAt.Unix(),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(impersonationSigningKey(config))
+}
+
+// verifyImpersonationToken checks the signature and expiry of an impersonation token and returns
+// the tenant user it acts as
+func verifyImpersonationToken(tokenString string) (*User, error) {
+	config := ActiveConfig()
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return impersonationSigningKey(config), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(impersonationTokenIssuer))
+	if err != nil {
+		return nil, fmt.Errorf("invalid impersonation token: %w", err)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+	// The parser only checks exp when it is present, and these tokens must always expire
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("impersonation token has no expiry")
+	}
+	tenant, _ := claims["tenant"].(string)
+	admin, _ := claims["impersonatedBy"].(string)
+	if tenant == "" || admin == "" {
+		return nil, fmt.Errorf("invalid impersonation token claims")
+	}
+
+	user := &User{
+		Tenant:         tenant,
+		Issuer:         impersonationTokenIssuer,
+		IsAdmin:        false,
+		ExpiresAt:      int64(exp),
+		ImpersonatedBy: admin,
+	}
+	user.Name, _ = claims["name"].(string)
+	user.Subject, _ = claims["sub"].(string)
+	return user, nil
+}
+
+// Impersonate issues a short-lived token scoped to a tenant, so an admin can call the tenant
+// endpoints exactly as that tenant would
+func (s *AuthService) Impersonate(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	var req ImpersonateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	config := ActiveConfig()
+	if !config.IsValidTenant(req.Tenant) {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid tenant",
+			Details: fmt.Sprintf("Tenant must be one of %v", config.ValidTenants),
+		})
+		return
+	}
+
+	ttl, err := time.ParseDuration(config.ImpersonationTokenTTL)
+	if err != nil || ttl <= 0 {
+		ttl = 15 * time.Minute
+	} else if ttl > maxImpersonationTokenTTL {
+		ttl = maxImpersonationTokenTTL
+	}
+	if req.TTL != "" {
+		ttl, err = time.ParseDuration(req.TTL)
+		if err != nil || ttl <= 0 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid ttl",
+				Details: "ttl must be a positive duration such as 10m",
+			})
+			return
+		}
+	}
+	if ttl > maxImpersonationTokenTTL {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid ttl",
+			Details: fmt.Sprintf("ttl cannot exceed %s", maxImpersonationTokenTTL),
+		})
+		return
+	}
+
+	admin := userIdentity(u)
+	tokenID := uuid.New().String()
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+
+	// The audit entry is written before the token is handed out, so no impersonation goes unrecorded
+	if err := s.logImpersonationEvent(c, admin, req.Tenant, req.Reason, tokenID, expiresAt); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to record impersonation audit event",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	token, err := issueImpersonationToken(config, req.Tenant, admin, tokenID, expiresAt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to sign impersonation token",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ImpersonateResponse{
+		Token:     token,
+		TokenID:   tokenID,
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+		User: User{
+			Tenant:         req.Tenant,
+			Name:           admin,
+			Subject:        "impersonation:" + tokenID,
+			Issuer:         impersonationTokenIssuer,
+			IsAdmin:        false,
+			ExpiresAt:      expiresAt.Unix(),
+			ImpersonatedBy: admin,
+		},
+	})
+}
+
+// logImpersonationEvent records an impersonation as a high-severity audit entry in the
+// impersonated tenant's audit log
+func (s *AuthService) logImpersonationEvent(c *gin.Context, admin, tenant, reason, tokenID string, expiresAt time.Time) error {
+	now := time.Now()
+	logEntry := map[string]interface{}{
+		"timestamp": now.Format(time.RFC3339),
+		"eventType": "impersonation",
+		"severity":  "high",
+		"user":      admin,
+		"tenant":    tenant,
+		"action":    "impersonate",
+		"namespace": tenant,
+		"tokenId":   tokenID,
+		"expiresAt": expiresAt.UTC().Format(time.RFC3339),
+		"clientIP":  c.ClientIP(),
+		"userAgent": c.Request.UserAgent(),
+	}
+	if reason != "" {
+		logEntry["reason"] = reason
+	}
+	log.Printf("AUDIT [high] %s is impersonating tenant %s until %s (token %s)", admin, tenant, expiresAt.UTC().Format(time.RFC3339), tokenID)
+
+	// Forward to the external sink, if configured
+	exportLogEntry("audit", tenant, logEntry)
+
+	auditLogName := fmt.Sprintf("publishing-audit-%s", now.Format("2006-01-02"))
+	if err := appendLogEntry(s.k8sClient, tenant, auditLogName, logEntry, newEntriesLog, nil); err != nil {
+		return fmt.Errorf("failed to write audit log %s/%s: %w", tenant, auditLogName, err)
+	}
+	return nil
+}
@@ -6,13 +6,19 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -84,6 +90,30 @@ var EnvoyExtensionPolicyGVR = schema.GroupVersionResource{
 	Resource: "envoyextensionpolicies",
 }
 
+var HTTPRouteFilterGVR = schema.GroupVersionResource{
+	Group:    "gateway.envoyproxy.io",
+	Version:  "v1alpha1",
+	Resource: "httproutefilters",
+}
+
+var SecurityPolicyGVR = schema.GroupVersionResource{
+	Group:    "gateway.envoyproxy.io",
+	Version:  "v1alpha1",
+	Resource: "securitypolicies",
+}
+
+var ServingRuntimeGVR = schema.GroupVersionResource{
+	Group:    "serving.kserve.io",
+	Version:  "v1alpha1",
+	Resource: "servingruntimes",
+}
+
+var ClusterServingRuntimeGVR = schema.GroupVersionResource{
+	Group:    "serving.kserve.io",
+	Version:  "v1alpha1",
+	Resource: "clusterservingruntimes",
+}
+
 func NewK8sClient() (*K8sClient, error) {
 	config, err := getK8sConfig()
 	if err != nil {
@@ -131,35 +161,79 @@ func getK8sConfig() (*rest.Config, error) {
 	return config, nil
 }
 
-// GetInferenceServices retrieves inference services
-func (k *K8sClient) GetInferenceServices(namespace string) ([]map[string]interface{}, error) {
-	ctx := context.Background()
-	
-	var result []map[string]interface{}
-	
-	if namespace == "" {
-		// Get all inference services across all namespaces
-		list, err := k.dynamicClient.Resource(InferenceServiceGVR).List(ctx, metav1.ListOptions{})
+// ListPage selects one page of a list call. A zero Limit returns all remaining items in one call.
+type ListPage struct {
+	Limit    int64
+	Continue string
+}
+
+// defaultListPageSize bounds how many items a single API call returns when a method walks every page
+const defaultListPageSize = 500
+
+func (p ListPage) listOptions(labelSelector string) metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: labelSelector,
+		Limit:         p.Limit,
+		Continue:      p.Continue,
+	}
+}
+
+// listAllPages calls list with successive continue tokens until the last page has been read
+func listAllPages(list func(page ListPage) (string, error)) error {
+	page := ListPage{Limit: defaultListPageSize}
+	for {
+		continueToken, err := list(page)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list inference services: %w", err)
-		}
-		
-		for _, item := range list.Items {
-			result = append(result, item.Object)
+			return err
 		}
-	} else {
-		// Get inference services in specific namespace
-		list, err := k.dynamicClient.Resource(InferenceServiceGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list inference services in namespace %s: %w", namespace, err)
+		if continueToken == "" {
+			return nil
 		}
-		
-		for _, item := range list.Items {
-			result = append(result, item.Object)
+		page.Continue = continueToken
+	}
+}
+
+// ListDynamicPage lists one page of a dynamic resource. An empty namespace lists across all
+// namespaces (or cluster-scoped resources). It returns the continue token for the next page.
+func (k *K8sClient) ListDynamicPage(gvr schema.GroupVersionResource, namespace, labelSelector string, page ListPage) ([]unstructured.Unstructured, string, error) {
+	list, err := k.dynamicClient.Resource(gvr).Namespace(namespace).List(context.Background(), page.listOptions(labelSelector))
+	if err != nil {
+		return nil, "", err
+	}
+	return list.Items, list.GetContinue(), nil
+}
+
+// listAllDynamic lists every item of a dynamic resource, one page at a time
+func (k *K8sClient) listAllDynamic(gvr schema.GroupVersionResource, namespace, labelSelector string) ([]unstructured.Unstructured, error) {
+	var items []unstructured.Unstructured
+	err := listAllPages(func(page ListPage) (string, error) {
+		pageItems, continueToken, err := k.ListDynamicPage(gvr, namespace, labelSelector, page)
+		items = append(items, pageItems...)
+		return continueToken, err
+	})
+	return items, err
+}
+
+// unstructuredObjects returns the object maps of unstructured items
+func unstructuredObjects(items []unstructured.Unstructured) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, item := range items {
+		result = append(result, item.Object)
+	}
+	return result
+}
+
+// GetInferenceServices retrieves inference services
+func (k *K8sClient) GetInferenceServices(namespace string) ([]map[string]interface{}, error) {
+	items, err := k.listAllDynamic(InferenceServiceGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
+			return nil, fmt.Errorf("failed to list inference services: %w", err)
 		}
+		return nil, fmt.Errorf("failed to list inference services in namespace %s: %w", namespace, err)
 	}
 	
-	return result, nil
+	return unstructuredObjects(items), nil
 }
 
 // GetInferenceService retrieves a specific inference service
@@ -216,45 +290,53 @@ func (k *K8sClient) DeleteInferenceService(namespace, name string) error {
 
 // GetPods retrieves pods
 func (k *K8sClient) GetPods(namespace string) ([]corev1.Pod, error) {
-	ctx := context.Background()
-	
-	opts := metav1.ListOptions{}
-	var pods *corev1.PodList
-	var err error
-	
-	if namespace == "" {
-		pods, err = k.clientset.CoreV1().Pods("").List(ctx, opts)
-	} else {
-		pods, err = k.clientset.CoreV1().Pods(namespace).List(ctx, opts)
+	var pods []corev1.Pod
+	err := listAllPages(func(page ListPage) (string, error) {
+		pagePods, continueToken, err := k.ListPodsPage(namespace, "", page)
+		pods = append(pods, pagePods...)
+		return continueToken, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
 	
+	return pods, nil
+}
+
+// ListPodsPage retrieves one page of pods matching an optional label selector and returns the
+// continue token for the next page
+func (k *K8sClient) ListPodsPage(namespace, selector string, page ListPage) ([]corev1.Pod, string, error) {
+	pods, err := k.clientset.CoreV1().Pods(namespace).List(context.Background(), page.listOptions(selector))
 	if err != nil {
-		return nil, fmt.Errorf("failed to list pods: %w", err)
+		return nil, "", err
 	}
 	
-	return pods.Items, nil
+	return pods.Items, pods.Continue, nil
 }
 
 // GetPodsWithSelector retrieves pods with label selector
 func (k *K8sClient) GetPodsWithSelector(namespace, selector string) ([]corev1.Pod, error) {
-	ctx := context.Background()
-	
-	opts := metav1.ListOptions{LabelSelector: selector}
-	pods, err := k.clientset.CoreV1().Pods(namespace).List(ctx, opts)
+	var pods []corev1.Pod
+	err := listAllPages(func(page ListPage) (string, error) {
+		pagePods, continueToken, err := k.ListPodsPage(namespace, selector, page)
+		pods = append(pods, pagePods...)
+		return continueToken, err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pods with selector %s: %w", selector, err)
 	}
 	
-	return pods.Items, nil
+	return pods, nil
 }
 
 // GetPodLogs retrieves pod logs
-func (k *K8sClient) GetPodLogs(namespace, podName string, lines int) (string, error) {
+func (k *K8sClient) GetPodLogs(namespace, podName, container string, lines int) (string, error) {
 	ctx := context.Background()
 	
 	tailLines := int64(lines)
 	opts := &corev1.PodLogOptions{
 		TailLines: &tailLines,
+		Container: container,
 	}
 	
 	req := k.clientset.CoreV1().Pods(namespace).GetLogs(podName, opts)
@@ -270,64 +352,85 @@ func (k *K8sClient) GetPodLogs(namespace, podName string, lines int) (string, er
 func (k *K8sClient) GetNodes() ([]corev1.Node, error) {
 	ctx := context.Background()
 	
-	nodes, err := k.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	var nodes []corev1.Node
+	err := listAllPages(func(page ListPage) (string, error) {
+		list, err := k.clientset.CoreV1().Nodes().List(ctx, page.listOptions(""))
+		if err != nil {
+			return "", err
+		}
+		nodes = append(nodes, list.Items...)
+		return list.Continue, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list nodes: %w", err)
 	}
 	
-	return nodes.Items, nil
+	return nodes, nil
 }
 
 // GetNamespaces retrieves namespaces
 func (k *K8sClient) GetNamespaces() ([]corev1.Namespace, error) {
 	ctx := context.Background()
 	
-	namespaces, err := k.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	var namespaces []corev1.Namespace
+	err := listAllPages(func(page ListPage) (string, error) {
+		list, err := k.clientset.CoreV1().Namespaces().List(ctx, page.listOptions(""))
+		if err != nil {
+			return "", err
+		}
+		namespaces = append(namespaces, list.Items...)
+		return list.Continue, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list namespaces: %w", err)
 	}
 	
-	return namespaces.Items, nil
+	return namespaces, nil
 }
 
 // GetDeployments retrieves deployments
 func (k *K8sClient) GetDeployments(namespace string) ([]appsv1.Deployment, error) {
+	return k.GetDeploymentsWithSelector(namespace, "")
+}
+
+// GetDeploymentsWithSelector retrieves deployments matching a label selector
+func (k *K8sClient) GetDeploymentsWithSelector(namespace, selector string) ([]appsv1.Deployment, error) {
 	ctx := context.Background()
 	
-	var deployments *appsv1.DeploymentList
-	var err error
-	
-	if namespace == "" {
-		deployments, err = k.clientset.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
-	} else {
-		deployments, err = k.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
-	}
-	
+	var deployments []appsv1.Deployment
+	err := listAllPages(func(page ListPage) (string, error) {
+		list, err := k.clientset.AppsV1().Deployments(namespace).List(ctx, page.listOptions(selector))
+		if err != nil {
+			return "", err
+		}
+		deployments = append(deployments, list.Items...)
+		return list.Continue, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list deployments: %w", err)
 	}
 	
-	return deployments.Items, nil
+	return deployments, nil
 }
 
 // GetServices retrieves services
 func (k *K8sClient) GetServices(namespace string) ([]corev1.Service, error) {
 	ctx := context.Background()
 	
-	var services *corev1.ServiceList
-	var err error
-	
-	if namespace == "" {
-		services, err = k.clientset.CoreV1().Services("").List(ctx, metav1.ListOptions{})
-	} else {
-		services, err = k.clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
-	}
-	
+	var services []corev1.Service
+	err := listAllPages(func(page ListPage) (string, error) {
+		list, err := k.clientset.CoreV1().Services(namespace).List(ctx, page.listOptions(""))
+		if err != nil {
+			return "", err
+		}
+		services = append(services, list.Items...)
+		return list.Continue, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list services: %w", err)
 	}
 	
-	return services.Items, nil
+	return services, nil
 }
 
 // GetService retrieves a specific service
@@ -346,29 +449,15 @@ func (k *K8sClient) GetService(namespace, name string) (*corev1.Service, error)
 
 // GetGateways retrieves Gateway API gateways
 func (k *K8sClient) GetGateways(namespace string) ([]map[string]interface{}, error) {
-	ctx := context.Background()
-	
-	var result []map[string]interface{}
-	
-	if namespace == "" {
-		list, err := k.dynamicClient.Resource(GatewayGVR).List(ctx, metav1.ListOptions{})
-		if err != nil {
+	items, err := k.listAllDynamic(GatewayGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
 			return nil, fmt.Errorf("failed to list gateways: %w", err)
 		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
-	} else {
-		list, err := k.dynamicClient.Resource(GatewayGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list gateways in namespace %s: %w", namespace, err)
-		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
+		return nil, fmt.Errorf("failed to list gateways in namespace %s: %w", namespace, err)
 	}
 	
-	return result, nil
+	return unstructuredObjects(items), nil
 }
 
 // GetGateway retrieves a specific Gateway resource
@@ -401,8 +490,6 @@ func (k *K8sClient) UpdateGateway(namespace string, gateway map[string]interface
 
 // GetHTTPRoutes retrieves Gateway API HTTPRoutes
 func (k *K8sClient) GetHTTPRoutes(namespace string) ([]map[string]interface{}, error) {
-	ctx := context.Background()
-	
 	// Gateway API HTTPRoute GVR
 	httpRouteGVR := schema.GroupVersionResource{
 		Group:    "gateway.networking.k8s.io",
@@ -410,33 +497,19 @@ func (k *K8sClient) GetHTTPRoutes(namespace string) ([]map[string]interface{}, e
 		Resource: "httproutes",
 	}
 	
-	var result []map[string]interface{}
-	
-	if namespace == "" {
-		list, err := k.dynamicClient.Resource(httpRouteGVR).List(ctx, metav1.ListOptions{})
-		if err != nil {
+	items, err := k.listAllDynamic(httpRouteGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
 			return nil, fmt.Errorf("failed to list httproutes: %w", err)
 		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
-	} else {
-		list, err := k.dynamicClient.Resource(httpRouteGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list httproutes in namespace %s: %w", namespace, err)
-		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
+		return nil, fmt.Errorf("failed to list httproutes in namespace %s: %w", namespace, err)
 	}
 	
-	return result, nil
+	return unstructuredObjects(items), nil
 }
 
 // GetVirtualServices retrieves Istio VirtualServices
 func (k *K8sClient) GetVirtualServices(namespace string) ([]map[string]interface{}, error) {
-	ctx := context.Background()
-	
 	// Istio VirtualService GVR
 	virtualServiceGVR := schema.GroupVersionResource{
 		Group:    "networking.istio.io",
@@ -444,33 +517,19 @@ func (k *K8sClient) GetVirtualServices(namespace string) ([]map[string]interface
 		Resource: "virtualservices",
 	}
 	
-	var result []map[string]interface{}
-	
-	if namespace == "" {
-		list, err := k.dynamicClient.Resource(virtualServiceGVR).List(ctx, metav1.ListOptions{})
-		if err != nil {
+	items, err := k.listAllDynamic(virtualServiceGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
 			return nil, fmt.Errorf("failed to list virtualservices: %w", err)
 		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
-	} else {
-		list, err := k.dynamicClient.Resource(virtualServiceGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list virtualservices in namespace %s: %w", namespace, err)
-		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
+		return nil, fmt.Errorf("failed to list virtualservices in namespace %s: %w", namespace, err)
 	}
 	
-	return result, nil
+	return unstructuredObjects(items), nil
 }
 
 // GetIstioGateways retrieves Istio Gateways
 func (k *K8sClient) GetIstioGateways(namespace string) ([]map[string]interface{}, error) {
-	ctx := context.Background()
-	
 	// Istio Gateway GVR
 	istioGatewayGVR := schema.GroupVersionResource{
 		Group:    "networking.istio.io",
@@ -478,27 +537,15 @@ func (k *K8sClient) GetIstioGateways(namespace string) ([]map[string]interface{}
 		Resource: "gateways",
 	}
 	
-	var result []map[string]interface{}
-	
-	if namespace == "" {
-		list, err := k.dynamicClient.Resource(istioGatewayGVR).List(ctx, metav1.ListOptions{})
-		if err != nil {
+	items, err := k.listAllDynamic(istioGatewayGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
 			return nil, fmt.Errorf("failed to list istio gateways: %w", err)
 		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
-	} else {
-		list, err := k.dynamicClient.Resource(istioGatewayGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list istio gateways in namespace %s: %w", namespace, err)
-		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
+		return nil, fmt.Errorf("failed to list istio gateways in namespace %s: %w", namespace, err)
 	}
 	
-	return result, nil
+	return unstructuredObjects(items), nil
 }
 
 
@@ -534,7 +581,7 @@ func (k *K8sClient) ExecuteKubectlCommand(command string) (string, error) {
 }
 
 // GetModelLogs retrieves logs for a specific model
-func (k *K8sClient) GetModelLogs(namespace, modelName string, lines int) ([]string, error) {
+func (k *K8sClient) GetModelLogs(namespace, modelName, container string, lines int) ([]string, error) {
 	// Get pods for the inference service
 	selector := fmt.Sprintf("serving.kserve.io/inferenceservice=%s", modelName)
 	pods, err := k.GetPodsWithSelector(namespace, selector)
@@ -546,8 +593,32 @@ func (k *K8sClient) GetModelLogs(namespace, modelName string, lines int) ([]stri
 		return []string{}, nil
 	}
 	
+	// Resolve the container, defaulting to the model server container
+	pod := pods[0]
+	containers := podContainerNames(pod)
+	if container == "" {
+		container = containers[0]
+		for _, name := range containers {
+			if name == "kserve-container" {
+				container = name
+				break
+			}
+		}
+	} else {
+		found := false
+		for _, name := range containers {
+			if name == container {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, &ContainerNotFoundError{Container: container, Pod: pod.Name, Available: containers}
+		}
+	}
+	
 	// Get logs from the first pod (can be extended to aggregate from all pods)
-	logs, err := k.GetPodLogs(namespace, pods[0].Name, lines)
+	logs, err := k.GetPodLogs(namespace, pod.Name, container, lines)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get logs for pod %s: %w", pods[0].Name, err)
 	}
@@ -566,86 +637,138 @@ func (k *K8sClient) GetModelLogs(namespace, modelName string, lines int) ([]stri
 	return result, nil
 }
 
-// GetSystemLogs retrieves system logs
-func (k *K8sClient) GetSystemLogs(namespace, component string, lines int) ([]string, error) {
-	ctx := context.Background()
-	var allLogs []string
+// Name of the KServe init container that downloads the model from its storage URI
+const storageInitializerContainer = "storage-initializer"
+
+// ContainerNotFoundError is returned when a requested container does not exist on a pod
+type ContainerNotFoundError struct {
+	Container string
+	Pod       string
+	Available []string
+}
+
+func (e *ContainerNotFoundError) Error() string {
+	return fmt.Sprintf("container %s not found on pod %s, available containers: %s", e.Container, e.Pod, strings.Join(e.Available, ", "))
+}
+
+// podContainerNames lists the init and regular containers of a pod, regular containers first
+func podContainerNames(pod corev1.Pod) []string {
+	var names []string
+	for _, container := range pod.Spec.Containers {
+		names = append(names, container.Name)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		names = append(names, container.Name)
+	}
+	return names
+}
+
+// GetSystemLogs retrieves system logs. Containers are read concurrently, at most concurrency at a
+// time, and collection stops when timeout elapses. Every container gets an equal share of lines,
+// at least one, and the combined result is capped at lines.
+func (k *K8sClient) GetSystemLogs(namespace, component string, lines, concurrency int, timeout time.Duration) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 	
 	// Get all namespaces if namespace is empty
 	namespaces := []string{namespace}
 	if namespace == "" {
-		nsList, err := k.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		nsList, err := k.GetNamespaces()
 		if err != nil {
-			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+			return nil, err
 		}
-		namespaces = make([]string, len(nsList.Items))
-		for i, ns := range nsList.Items {
+		namespaces = make([]string, len(nsList))
+		for i, ns := range nsList {
 			namespaces[i] = ns.Name
 		}
 	}
 	
-	// For each namespace, get pods and their logs
+	// Filter by label selector when a component is given
+	labelSelector := ""
+	if component != "" {
+		labelSelector = fmt.Sprintf("app=%s", component)
+	}
+	
+	type logTarget struct {
+		namespace string
+		pod       string
+		container string
+	}
+	var targets []logTarget
 	for _, ns := range namespaces {
-		var pods *corev1.PodList
-		var err error
-		
-		if component != "" {
-			// Filter by label selector
-			labelSelector := fmt.Sprintf("app=%s", component)
-			pods, err = k.clientset.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
-				LabelSelector: labelSelector,
-			})
-		} else {
-			// Get all pods
-			pods, err = k.clientset.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
-		}
-		
+		pods, err := k.GetPodsWithSelector(ns, labelSelector)
 		if err != nil {
 			continue // Skip this namespace if we can't list pods
 		}
-		
-		// Get logs from each pod
-		for _, pod := range pods.Items {
+		for _, pod := range pods {
 			if pod.Status.Phase != corev1.PodRunning && pod.Status.Phase != corev1.PodSucceeded {
 				continue // Skip pods that aren't running
 			}
-			
-			// Get logs from each container in the pod
 			for _, container := range pod.Spec.Containers {
-				logOptions := &corev1.PodLogOptions{
-					Container: container.Name,
-					TailLines: func(i int64) *int64 { return &i }(int64(lines / len(pods.Items))), // Distribute lines across pods
-				}
-				
-				logStream, err := k.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
-				if err != nil {
-					// Add error info but continue with other pods
-					allLogs = append(allLogs, fmt.Sprintf("[ERROR] Failed to get logs from %s/%s/%s: %v", pod.Namespace, pod.Name, container.Name, err))
-					continue
-				}
-				
-				logBytes, err := io.ReadAll(logStream)
-				logStream.Close()
-				if err != nil {
-					allLogs = append(allLogs, fmt.Sprintf("[ERROR] Failed to read logs from %s/%s/%s: %v", pod.Namespace, pod.Name, container.Name, err))
-					continue
-				}
-				
-				// Process log lines
-				logContent := string(logBytes)
-				if strings.TrimSpace(logContent) != "" {
-					logLines := strings.Split(logContent, "\n")
-					for _, line := range logLines {
-						if strings.TrimSpace(line) != "" {
-							// Prefix with pod info for clarity
-							prefixedLine := fmt.Sprintf("[%s/%s/%s] %s", pod.Namespace, pod.Name, container.Name, line)
-							allLogs = append(allLogs, prefixedLine)
-						}
-					}
-				}
+				targets = append(targets, logTarget{namespace: pod.Namespace, pod: pod.Name, container: container.Name})
 			}
 		}
 	}
+	if len(targets) == 0 {
+		return []string{}, nil
+	}
+	
+	// Round up so small budgets spread over many containers still return lines from each
+	tailLines := int64((lines + len(targets) - 1) / len(targets))
+	if tailLines < 1 {
+		tailLines = 1
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	
+	results := make([][]string, len(targets))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target logTarget) {
+			defer wg.Done()
+			
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				results[i] = []string{fmt.Sprintf("[ERROR] Skipped %s/%s/%s: log collection timed out", target.namespace, target.pod, target.container)}
+				return
+			}
+			
+			logOptions := &corev1.PodLogOptions{
+				Container: target.container,
+				TailLines: &tailLines,
+			}
+			logStream, err := k.clientset.CoreV1().Pods(target.namespace).GetLogs(target.pod, logOptions).Stream(ctx)
+			if err != nil {
+				// Add error info but continue with other pods
+				results[i] = []string{fmt.Sprintf("[ERROR] Failed to get logs from %s/%s/%s: %v", target.namespace, target.pod, target.container, err)}
+				return
+			}
+			logBytes, err := io.ReadAll(logStream)
+			logStream.Close()
+			if err != nil {
+				results[i] = []string{fmt.Sprintf("[ERROR] Failed to read logs from %s/%s/%s: %v", target.namespace, target.pod, target.container, err)}
+				return
+			}
+			
+			// Prefix with pod info for clarity
+			for _, line := range strings.Split(string(logBytes), "\n") {
+				if strings.TrimSpace(line) != "" {
+					results[i] = append(results[i], fmt.Sprintf("[%s/%s/%s] %s", target.namespace, target.pod, target.container, line))
+				}
+			}
+		}(i, target)
+	}
+	wg.Wait()
+	
+	allLogs := []string{}
+	for _, result := range results {
+		allLogs = append(allLogs, result...)
+	}
 	
 	// Limit total number of log lines returned
 	if len(allLogs) > lines {
@@ -707,6 +830,45 @@ func (k *K8sClient) GetBackendTrafficPolicy(namespace, name string) (map[string]
 	return obj.Object, nil
 }
 
+func (k *K8sClient) GetBackend(namespace, name string) (map[string]interface{}, error) {
+	ctx := context.Background()
+	
+	// Get the Backend
+	obj, err := k.dynamicClient.Resource(BackendGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		k.logError("GetBackend", err)
+		return nil, fmt.Errorf("failed to get Backend: %w", err)
+	}
+	
+	return obj.Object, nil
+}
+
+func (k *K8sClient) GetAIServiceBackend(namespace, name string) (map[string]interface{}, error) {
+	ctx := context.Background()
+	
+	// Get the AIServiceBackend
+	obj, err := k.dynamicClient.Resource(AIServiceBackendGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		k.logError("GetAIServiceBackend", err)
+		return nil, fmt.Errorf("failed to get AIServiceBackend: %w", err)
+	}
+	
+	return obj.Object, nil
+}
+
+func (k *K8sClient) GetReferenceGrant(namespace, name string) (map[string]interface{}, error) {
+	ctx := context.Background()
+	
+	// Get the ReferenceGrant
+	obj, err := k.dynamicClient.Resource(ReferenceGrantGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		k.logError("GetReferenceGrant", err)
+		return nil, fmt.Errorf("failed to get ReferenceGrant: %w", err)
+	}
+	
+	return obj.Object, nil
+}
+
 // Removed duplicate API Key Secret Management methods - using comprehensive versions later in file
 
 // Published Model Metadata Management
@@ -746,6 +908,14 @@ func (k *K8sClient) CreatePublishedModelMetadata(namespace, modelName string, me
 }
 
 func (k *K8sClient) UpdatePublishedModelMetadata(namespace, modelName string, metadata map[string]interface{}) error {
+	_, err := k.UpdatePublishedModelMetadataIfMatch(namespace, modelName, metadata, "")
+	return err
+}
+
+// UpdatePublishedModelMetadataIfMatch updates the metadata only if its ConfigMap is still at
+// resourceVersion, returning a Conflict error otherwise. An empty resourceVersion updates
+// unconditionally. The new resourceVersion is returned.
+func (k *K8sClient) UpdatePublishedModelMetadataIfMatch(namespace, modelName string, metadata map[string]interface{}, resourceVersion string) (string, error) {
 	ctx := context.Background()
 	
 	configMapName := fmt.Sprintf("published-model-metadata-%s", modelName)
@@ -753,26 +923,46 @@ func (k *K8sClient) UpdatePublishedModelMetadata(namespace, modelName string, me
 	// Convert metadata to JSON string
 	metadataJSON, err := json.Marshal(metadata)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return "", fmt.Errorf("failed to marshal metadata: %w", err)
 	}
 	
 	// Get existing configmap
 	configMap, err := k.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, configMapName, metav1.GetOptions{})
 	if err != nil {
 		k.logError("GetPublishedModelMetadata", err)
-		return fmt.Errorf("failed to get published model metadata: %w", err)
+		return "", fmt.Errorf("failed to get published model metadata: %w", err)
+	}
+	if resourceVersion != "" && configMap.ResourceVersion != resourceVersion {
+		return "", apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, configMapName,
+			fmt.Errorf("resourceVersion is %s, expected %s", configMap.ResourceVersion, resourceVersion))
 	}
 	
-	// Update the metadata
+	// Update the metadata. The fetched resourceVersion is sent back, so a write that lands
+	// between the Get and the Update is rejected with a Conflict as well.
 	configMap.Data["metadata.json"] = string(metadataJSON)
 	
-	_, err = k.clientset.CoreV1().ConfigMaps(namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+	updated, err := k.clientset.CoreV1().ConfigMaps(namespace).Update(ctx, configMap, metav1.UpdateOptions{})
 	if err != nil {
 		k.logError("UpdatePublishedModelMetadata", err)
-		return fmt.Errorf("failed to update published model metadata: %w", err)
+		return "", fmt.Errorf("failed to update published model metadata: %w", err)
 	}
 	
-	return nil
+	return updated.ResourceVersion, nil
+}
+
+// GetPublishedModelMetadataVersion returns the resourceVersion of a published model's metadata ConfigMap
+func (k *K8sClient) GetPublishedModelMetadataVersion(namespace, modelName string) (string, error) {
+	ctx := context.Background()
+	
+	configMapName := fmt.Sprintf("published-model-metadata-%s", modelName)
+	
+	configMap, err := k.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, configMapName, metav1.GetOptions{})
+	if err != nil {
+		k.logError("GetPublishedModelMetadataVersion", err)
+		return "", fmt.Errorf("failed to get published model metadata: %w", err)
+	}
+	
+	return configMap.ResourceVersion, nil
 }
 
 func (k *K8sClient) GetPublishedModelMetadata(namespace, modelName string) (map[string]interface{}, error) {
@@ -815,29 +1005,31 @@ func (k *K8sClient) DeletePublishedModelMetadata(namespace, modelName string) er
 
 // List all published models across namespaces
 func (k *K8sClient) ListPublishedModels(namespace string) ([]map[string]interface{}, error) {
+	var publishedModels []map[string]interface{}
+	err := listAllPages(func(page ListPage) (string, error) {
+		pageModels, continueToken, err := k.ListPublishedModelsPage(namespace, page)
+		publishedModels = append(publishedModels, pageModels...)
+		return continueToken, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	
+	return publishedModels, nil
+}
+
+// ListPublishedModelsPage retrieves one page of published model metadata and returns the
+// continue token for the next page
+func (k *K8sClient) ListPublishedModelsPage(namespace string, page ListPage) ([]map[string]interface{}, string, error) {
 	ctx := context.Background()
 	
 	// List all configmaps with published model metadata label
 	labelSelector := "app=published-model,type=metadata"
 	
-	var configMaps *corev1.ConfigMapList
-	var err error
-	
-	if namespace == "" {
-		// List across all namespaces
-		configMaps, err = k.clientset.CoreV1().ConfigMaps("").List(ctx, metav1.ListOptions{
-			LabelSelector: labelSelector,
-		})
-	} else {
-		// List in specific namespace
-		configMaps, err = k.clientset.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{
-			LabelSelector: labelSelector,
-		})
-	}
-	
+	configMaps, err := k.clientset.CoreV1().ConfigMaps(namespace).List(ctx, page.listOptions(labelSelector))
 	if err != nil {
 		k.logError("ListPublishedModels", err)
-		return nil, fmt.Errorf("failed to list published models: %w", err)
+		return nil, "", fmt.Errorf("failed to list published models: %w", err)
 	}
 	
 	var publishedModels []map[string]interface{}
@@ -856,7 +1048,7 @@ func (k *K8sClient) ListPublishedModels(namespace string) ([]map[string]interfac
 		publishedModels = append(publishedModels, metadata)
 	}
 	
-	return publishedModels, nil
+	return publishedModels, configMaps.Continue, nil
 }
 
 // API Key Secret Management
@@ -915,17 +1107,29 @@ func (k *K8sClient) GetAPIKeySecret(namespace, secretName string) (map[string]in
 	return result, nil
 }
 
-func (k *K8sClient) UpdateAPIKeySecret(namespace, secretName string, secretData map[string]interface{}) error {
+// GetSecret returns a Secret, used to read the gateway's TLS certificates
+func (k *K8sClient) GetSecret(namespace, name string) (*corev1.Secret, error) {
 	ctx := context.Background()
 	
-	// Get existing secret
-	secret, err := k.clientset.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	secret, err := k.clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		k.logError("GetAPIKeySecret", err)
-		return fmt.Errorf("failed to get API key secret: %w", err)
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", namespace, name, err)
 	}
 	
-	// Update data
+	return secret, nil
+}
+
+func (k *K8sClient) UpdateAPIKeySecret(namespace, secretName string, secretData map[string]interface{}) error {
+	ctx := context.Background()
+	
+	// Get existing secret
+	secret, err := k.clientset.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		k.logError("GetAPIKeySecret", err)
+		return fmt.Errorf("failed to get API key secret: %w", err)
+	}
+	
+	// Update data
 	for key, value := range secretData {
 		if str, ok := value.(string); ok {
 			secret.Data[key] = []byte(str)
@@ -1125,6 +1329,88 @@ func (k *K8sClient) GetConfigMap(namespace, configMapName string) (map[string]in
 	return data, nil
 }
 
+// GetConfigMapData returns the raw key/value data of a ConfigMap
+func (k *K8sClient) GetConfigMapData(namespace, configMapName string) (map[string]string, error) {
+	configMap, err := k.clientset.CoreV1().ConfigMaps(namespace).Get(context.Background(), configMapName, metav1.GetOptions{})
+	if err != nil {
+		k.logError("GetConfigMapData", err)
+		return nil, fmt.Errorf("failed to get ConfigMap: %w", err)
+	}
+	return configMap.Data, nil
+}
+
+// GetHostnameReservations returns the tenant hostname reservations, or none when they have never been saved
+func (k *K8sClient) GetHostnameReservations(namespace string) ([]HostnameReservation, string, error) {
+	configMap, err := k.clientset.CoreV1().ConfigMaps(namespace).Get(context.Background(), hostnameReservationsConfigMap, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return []HostnameReservation{}, "", nil
+		}
+		k.logError("GetHostnameReservations", err)
+		return nil, "", fmt.Errorf("failed to get hostname reservations: %w", err)
+	}
+	
+	reservations := []HostnameReservation{}
+	if data, ok := configMap.Data["reservations.json"]; ok {
+		if err := json.Unmarshal([]byte(data), &reservations); err != nil {
+			return nil, "", fmt.Errorf("failed to unmarshal hostname reservations: %w", err)
+		}
+	}
+	
+	updatedAt := configMap.Annotations["updated-at"]
+	return reservations, updatedAt, nil
+}
+
+// SaveHostnameReservations replaces the tenant hostname reservations, creating their ConfigMap if needed
+func (k *K8sClient) SaveHostnameReservations(namespace string, reservations []HostnameReservation, updatedAt string) error {
+	ctx := context.Background()
+	
+	data, err := json.Marshal(reservations)
+	if err != nil {
+		return fmt.Errorf("failed to marshal hostname reservations: %w", err)
+	}
+	
+	configMap, err := k.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, hostnameReservationsConfigMap, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      hostnameReservationsConfigMap,
+				Namespace: namespace,
+				Labels: map[string]string{
+					"app":  "management-service",
+					"type": "hostname-reservations",
+				},
+				Annotations: map[string]string{"updated-at": updatedAt},
+			},
+			Data: map[string]string{"reservations.json": string(data)},
+		}
+		if _, err := k.clientset.CoreV1().ConfigMaps(namespace).Create(ctx, configMap, metav1.CreateOptions{}); err != nil {
+			k.logError("SaveHostnameReservations", err)
+			return fmt.Errorf("failed to create hostname reservations: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		k.logError("SaveHostnameReservations", err)
+		return fmt.Errorf("failed to get hostname reservations: %w", err)
+	}
+	
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	if configMap.Annotations == nil {
+		configMap.Annotations = map[string]string{}
+	}
+	configMap.Data["reservations.json"] = string(data)
+	configMap.Annotations["updated-at"] = updatedAt
+	
+	if _, err := k.clientset.CoreV1().ConfigMaps(namespace).Update(ctx, configMap, metav1.UpdateOptions{}); err != nil {
+		k.logError("SaveHostnameReservations", err)
+		return fmt.Errorf("failed to update hostname reservations: %w", err)
+	}
+	return nil
+}
+
 func (k *K8sClient) UpdateConfigMap(namespace, configMapName string, data map[string]interface{}) error {
 	ctx := context.Background()
 	
@@ -1155,12 +1441,43 @@ func (k *K8sClient) UpdateConfigMap(namespace, configMapName string, data map[st
 // Missing Gateway API operations
 
 
-// Helper function to check if error is resource not found
-func IsResourceNotFoundError(err error) bool {
-	if err == nil {
-		return false
+// K8sClient methods wrap API errors with %w, so the *StatusError is preserved and can be
+// inspected with the helpers below instead of matching on error strings.
+
+// IsNotFound reports whether err is a Kubernetes NotFound status error
+func IsNotFound(err error) bool {
+	return apierrors.IsNotFound(err)
+}
+
+// IsConflict reports whether err is a Kubernetes Conflict status error, such as a stale resourceVersion
+func IsConflict(err error) bool {
+	return apierrors.IsConflict(err)
+}
+
+// IsForbidden reports whether err is a Kubernetes Forbidden status error, usually missing RBAC
+func IsForbidden(err error) bool {
+	return apierrors.IsForbidden(err)
+}
+
+// IsAlreadyExists reports whether err is a Kubernetes AlreadyExists status error
+func IsAlreadyExists(err error) bool {
+	return apierrors.IsAlreadyExists(err)
+}
+
+// HTTPStatusForK8sError maps a Kubernetes API error to the HTTP status a handler should return
+func HTTPStatusForK8sError(err error) int {
+	switch {
+	case IsNotFound(err):
+		return http.StatusNotFound
+	case IsConflict(err), IsAlreadyExists(err):
+		return http.StatusConflict
+	case IsForbidden(err):
+		return http.StatusForbidden
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
 	}
-	return strings.Contains(err.Error(), "not found")
 }
 
 func (k *K8sClient) CreateBackend(namespace string, backend map[string]interface{}) error {
@@ -1249,9 +1566,9 @@ func (k *K8sClient) GetTenantNamespaces() ([]string, error) {
 	
 	// If no labeled namespaces found, fallback to prefix-based discovery
 	if len(tenantNamespaces) == 0 {
-		allNamespaces, err := k.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		allNamespaces, err := k.GetNamespaces()
 		if err == nil {
-			for _, ns := range allNamespaces.Items {
+			for _, ns := range allNamespaces {
 				if len(ns.Name) > 7 && ns.Name[:7] == "tenant-" {
 					tenantNamespaces = append(tenantNamespaces, ns.Name)
 				}
@@ -1316,44 +1633,319 @@ func (k *K8sClient) DeleteEnvoyExtensionPolicy(namespace, policyName string) err
 	return nil
 }
 
-// GetDestinationRules retrieves Istio DestinationRules
-func (k *K8sClient) GetDestinationRules(namespace string) ([]map[string]interface{}, error) {
+// HTTPRouteFilter Management
+func (k *K8sClient) CreateHTTPRouteFilter(namespace string, filter map[string]interface{}) error {
 	ctx := context.Background()
 	
-	// Istio DestinationRule GVR
-	destinationRuleGVR := schema.GroupVersionResource{
-		Group:    "networking.istio.io",
-		Version:  "v1beta1",
-		Resource: "destinationrules",
+	// Convert to unstructured for dynamic client
+	unstructuredFilter := &unstructured.Unstructured{
+		Object: filter,
 	}
 	
-	var result []map[string]interface{}
+	_, err := k.dynamicClient.Resource(HTTPRouteFilterGVR).Namespace(namespace).Create(ctx, unstructuredFilter, metav1.CreateOptions{})
+	if err != nil {
+		k.logError("CreateHTTPRouteFilter", err)
+		return fmt.Errorf("failed to create HTTPRouteFilter: %w", err)
+	}
 	
-	if namespace == "" {
-		list, err := k.dynamicClient.Resource(destinationRuleGVR).List(ctx, metav1.ListOptions{})
+	return nil
+}
+
+func (k *K8sClient) GetHTTPRouteFilter(namespace, name string) (map[string]interface{}, error) {
+	ctx := context.Background()
+	
+	obj, err := k.dynamicClient.Resource(HTTPRouteFilterGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		k.logError("GetHTTPRouteFilter", err)
+		return nil, fmt.Errorf("failed to get HTTPRouteFilter: %w", err)
+	}
+	
+	return obj.Object, nil
+}
+
+func (k *K8sClient) DeleteHTTPRouteFilter(namespace, filterName string) error {
+	ctx := context.Background()
+	
+	err := k.dynamicClient.Resource(HTTPRouteFilterGVR).Namespace(namespace).Delete(ctx, filterName, metav1.DeleteOptions{})
+	if err != nil {
+		k.logError("DeleteHTTPRouteFilter", err)
+		return fmt.Errorf("failed to delete HTTPRouteFilter: %w", err)
+	}
+	
+	return nil
+}
+
+// SecurityPolicy Management
+func (k *K8sClient) CreateSecurityPolicy(namespace string, policy map[string]interface{}) error {
+	ctx := context.Background()
+	
+	// Convert to unstructured for dynamic client
+	unstructuredPolicy := &unstructured.Unstructured{
+		Object: policy,
+	}
+	
+	_, err := k.dynamicClient.Resource(SecurityPolicyGVR).Namespace(namespace).Create(ctx, unstructuredPolicy, metav1.CreateOptions{})
+	if err != nil {
+		k.logError("CreateSecurityPolicy", err)
+		return fmt.Errorf("failed to create SecurityPolicy: %w", err)
+	}
+	
+	return nil
+}
+
+func (k *K8sClient) GetSecurityPolicy(namespace, name string) (map[string]interface{}, error) {
+	ctx := context.Background()
+	
+	obj, err := k.dynamicClient.Resource(SecurityPolicyGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		k.logError("GetSecurityPolicy", err)
+		return nil, fmt.Errorf("failed to get SecurityPolicy: %w", err)
+	}
+	
+	return obj.Object, nil
+}
+
+func (k *K8sClient) DeleteSecurityPolicy(namespace, policyName string) error {
+	ctx := context.Background()
+	
+	err := k.dynamicClient.Resource(SecurityPolicyGVR).Namespace(namespace).Delete(ctx, policyName, metav1.DeleteOptions{})
+	if err != nil {
+		k.logError("DeleteSecurityPolicy", err)
+		return fmt.Errorf("failed to delete SecurityPolicy: %w", err)
+	}
+	
+	return nil
+}
+
+// ListResourcesByLabel lists resources of the given kind in all namespaces matching a label selector
+func (k *K8sClient) ListResourcesByLabel(gvr schema.GroupVersionResource, labelSelector string) ([]unstructured.Unstructured, error) {
+	items, err := k.listAllDynamic(gvr, metav1.NamespaceAll, labelSelector)
+	if err != nil {
+		k.logError("ListResourcesByLabel", err)
+		return nil, fmt.Errorf("failed to list %s: %w", gvr.Resource, err)
+	}
+	
+	return items, nil
+}
+
+// DeleteResource deletes a namespaced resource of the given kind
+func (k *K8sClient) DeleteResource(gvr schema.GroupVersionResource, namespace, name string) error {
+	ctx := context.Background()
+	
+	err := k.dynamicClient.Resource(gvr).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		k.logError("DeleteResource", err)
+		return fmt.Errorf("failed to delete %s %s/%s: %w", gvr.Resource, namespace, name, err)
+	}
+	
+	return nil
+}
+
+// DeleteConfigMap deletes a ConfigMap
+func (k *K8sClient) DeleteConfigMap(namespace, configMapName string) error {
+	err := k.clientset.CoreV1().ConfigMaps(namespace).Delete(context.Background(), configMapName, metav1.DeleteOptions{})
+	if err != nil {
+		k.logError("DeleteConfigMap", err)
+		return fmt.Errorf("failed to delete ConfigMap %s/%s: %w", namespace, configMapName, err)
+	}
+	return nil
+}
+
+// ListConfigMapsByLabel lists ConfigMaps in all namespaces matching a label selector
+func (k *K8sClient) ListConfigMapsByLabel(labelSelector string) ([]corev1.ConfigMap, error) {
+	ctx := context.Background()
+	
+	var configMaps []corev1.ConfigMap
+	err := listAllPages(func(page ListPage) (string, error) {
+		list, err := k.clientset.CoreV1().ConfigMaps(metav1.NamespaceAll).List(ctx, page.listOptions(labelSelector))
 		if err != nil {
-			return nil, fmt.Errorf("failed to list destinationrules: %w", err)
+			return "", err
 		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
+		configMaps = append(configMaps, list.Items...)
+		return list.Continue, nil
+	})
+	if err != nil {
+		k.logError("ListConfigMapsByLabel", err)
+		return nil, fmt.Errorf("failed to list configmaps: %w", err)
+	}
+	
+	return configMaps, nil
+}
+
+// ListSecretsByLabel lists Secrets in all namespaces matching a label selector
+func (k *K8sClient) ListSecretsByLabel(labelSelector string) ([]corev1.Secret, error) {
+	ctx := context.Background()
+	
+	var secrets []corev1.Secret
+	err := listAllPages(func(page ListPage) (string, error) {
+		list, err := k.clientset.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, page.listOptions(labelSelector))
+		if err != nil {
+			return "", err
 		}
-	} else {
-		list, err := k.dynamicClient.Resource(destinationRuleGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		secrets = append(secrets, list.Items...)
+		return list.Continue, nil
+	})
+	if err != nil {
+		k.logError("ListSecretsByLabel", err)
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+	
+	return secrets, nil
+}
+
+// FindPublishedModelByHostnamePath reverse-maps a public hostname and request path to the
+// metadata of the published model serving it. The longest matching external path wins, and
+// additional path mappings are matched like the main path.
+func (k *K8sClient) FindPublishedModelByHostnamePath(host, path string) (map[string]interface{}, error) {
+	host = strings.ToLower(host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	
+	publishedModels, err := k.ListPublishedModels("")
+	if err != nil {
+		return nil, err
+	}
+	
+	var match map[string]interface{}
+	matchLength := -1
+	for _, metadata := range publishedModels {
+		externalURL, _ := metadata["externalUrl"].(string)
+		parsed, err := url.Parse(externalURL)
+		if err != nil || strings.ToLower(parsed.Hostname()) != host {
+			continue
+		}
+		
+		prefixes := []string{parsed.Path}
+		if mappings, ok := metadata["pathMappings"].([]interface{}); ok {
+			for _, item := range mappings {
+				if mapping, ok := item.(map[string]interface{}); ok {
+					if externalPath, ok := mapping["externalPath"].(string); ok {
+						prefixes = append(prefixes, externalPath)
+					}
+				}
+			}
+		}
+		
+		for _, prefix := range prefixes {
+			if pathHasPrefix(path, prefix) && len(prefix) > matchLength {
+				match = metadata
+				matchLength = len(prefix)
+			}
+		}
+	}
+	
+	if match == nil {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "publishedmodels"}, host+path)
+	}
+	return match, nil
+}
+
+// pathHasPrefix reports whether path matches prefix the way a Gateway API PathPrefix match does,
+// on whole path segments
+func pathHasPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return true
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// Model Version History
+func modelVersionConfigMapName(modelName string, id int) string {
+	return fmt.Sprintf("model-version-%s-%d", modelName, id)
+}
+
+// CreateModelVersion stores a snapshot of a model configuration as a numbered version
+func (k *K8sClient) CreateModelVersion(namespace, modelName string, id int, version map[string]interface{}) error {
+	ctx := context.Background()
+	
+	versionJSON, err := json.Marshal(version)
+	if err != nil {
+		return fmt.Errorf("failed to marshal model version: %w", err)
+	}
+	
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      modelVersionConfigMapName(modelName, id),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app":        "model-version",
+				"model-name": modelName,
+				"version":    strconv.Itoa(id),
+			},
+		},
+		Data: map[string]string{
+			"version.json": string(versionJSON),
+		},
+	}
+	
+	_, err = k.clientset.CoreV1().ConfigMaps(namespace).Create(ctx, configMap, metav1.CreateOptions{})
+	if err != nil {
+		k.logError("CreateModelVersion", err)
+		return fmt.Errorf("failed to create model version: %w", err)
+	}
+	
+	return nil
+}
+
+// ListModelVersions lists the stored version ConfigMaps of a model
+func (k *K8sClient) ListModelVersions(namespace, modelName string) ([]corev1.ConfigMap, error) {
+	ctx := context.Background()
+	selector := fmt.Sprintf("app=model-version,model-name=%s", modelName)
+	
+	var configMaps []corev1.ConfigMap
+	err := listAllPages(func(page ListPage) (string, error) {
+		list, err := k.clientset.CoreV1().ConfigMaps(namespace).List(ctx, page.listOptions(selector))
 		if err != nil {
-			return nil, fmt.Errorf("failed to list destinationrules in namespace %s: %w", namespace, err)
+			return "", err
 		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
+		configMaps = append(configMaps, list.Items...)
+		return list.Continue, nil
+	})
+	if err != nil {
+		k.logError("ListModelVersions", err)
+		return nil, fmt.Errorf("failed to list model versions: %w", err)
+	}
+	
+	return configMaps, nil
+}
+
+// DeleteModelVersion deletes a stored model version
+func (k *K8sClient) DeleteModelVersion(namespace, modelName string, id int) error {
+	ctx := context.Background()
+	
+	err := k.clientset.CoreV1().ConfigMaps(namespace).Delete(ctx, modelVersionConfigMapName(modelName, id), metav1.DeleteOptions{})
+	if err != nil {
+		k.logError("DeleteModelVersion", err)
+		return fmt.Errorf("failed to delete model version: %w", err)
+	}
+	
+	return nil
+}
+
+// GetDestinationRules retrieves Istio DestinationRules
+func (k *K8sClient) GetDestinationRules(namespace string) ([]map[string]interface{}, error) {
+	// Istio DestinationRule GVR
+	destinationRuleGVR := schema.GroupVersionResource{
+		Group:    "networking.istio.io",
+		Version:  "v1beta1",
+		Resource: "destinationrules",
+	}
+	
+	items, err := k.listAllDynamic(destinationRuleGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
+			return nil, fmt.Errorf("failed to list destinationrules: %w", err)
 		}
+		return nil, fmt.Errorf("failed to list destinationrules in namespace %s: %w", namespace, err)
 	}
 	
-	return result, nil
+	return unstructuredObjects(items), nil
 }
 
 // GetServiceEntries retrieves Istio ServiceEntries
 func (k *K8sClient) GetServiceEntries(namespace string) ([]map[string]interface{}, error) {
-	ctx := context.Background()
-	
 	// Istio ServiceEntry GVR
 	serviceEntryGVR := schema.GroupVersionResource{
 		Group:    "networking.istio.io",
@@ -1361,33 +1953,19 @@ func (k *K8sClient) GetServiceEntries(namespace string) ([]map[string]interface{
 		Resource: "serviceentries",
 	}
 	
-	var result []map[string]interface{}
-	
-	if namespace == "" {
-		list, err := k.dynamicClient.Resource(serviceEntryGVR).List(ctx, metav1.ListOptions{})
-		if err != nil {
+	items, err := k.listAllDynamic(serviceEntryGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
 			return nil, fmt.Errorf("failed to list serviceentries: %w", err)
 		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
-	} else {
-		list, err := k.dynamicClient.Resource(serviceEntryGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list serviceentries in namespace %s: %w", namespace, err)
-		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
+		return nil, fmt.Errorf("failed to list serviceentries in namespace %s: %w", namespace, err)
 	}
 	
-	return result, nil
+	return unstructuredObjects(items), nil
 }
 
 // GetAuthorizationPolicies retrieves Istio AuthorizationPolicies
 func (k *K8sClient) GetAuthorizationPolicies(namespace string) ([]map[string]interface{}, error) {
-	ctx := context.Background()
-	
 	// Istio AuthorizationPolicy GVR
 	authorizationPolicyGVR := schema.GroupVersionResource{
 		Group:    "security.istio.io",
@@ -1395,33 +1973,19 @@ func (k *K8sClient) GetAuthorizationPolicies(namespace string) ([]map[string]int
 		Resource: "authorizationpolicies",
 	}
 	
-	var result []map[string]interface{}
-	
-	if namespace == "" {
-		list, err := k.dynamicClient.Resource(authorizationPolicyGVR).List(ctx, metav1.ListOptions{})
-		if err != nil {
+	items, err := k.listAllDynamic(authorizationPolicyGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
 			return nil, fmt.Errorf("failed to list authorizationpolicies: %w", err)
 		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
-	} else {
-		list, err := k.dynamicClient.Resource(authorizationPolicyGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list authorizationpolicies in namespace %s: %w", namespace, err)
-		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
+		return nil, fmt.Errorf("failed to list authorizationpolicies in namespace %s: %w", namespace, err)
 	}
 	
-	return result, nil
+	return unstructuredObjects(items), nil
 }
 
 // GetPeerAuthentications retrieves Istio PeerAuthentications
 func (k *K8sClient) GetPeerAuthentications(namespace string) ([]map[string]interface{}, error) {
-	ctx := context.Background()
-	
 	// Istio PeerAuthentication GVR
 	peerAuthenticationGVR := schema.GroupVersionResource{
 		Group:    "security.istio.io",
@@ -1429,83 +1993,99 @@ func (k *K8sClient) GetPeerAuthentications(namespace string) ([]map[string]inter
 		Resource: "peerauthentications",
 	}
 	
-	var result []map[string]interface{}
-	
-	if namespace == "" {
-		list, err := k.dynamicClient.Resource(peerAuthenticationGVR).List(ctx, metav1.ListOptions{})
-		if err != nil {
+	items, err := k.listAllDynamic(peerAuthenticationGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
 			return nil, fmt.Errorf("failed to list peerauthentications: %w", err)
 		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
-	} else {
-		list, err := k.dynamicClient.Resource(peerAuthenticationGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list peerauthentications in namespace %s: %w", namespace, err)
-		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
+		return nil, fmt.Errorf("failed to list peerauthentications in namespace %s: %w", namespace, err)
 	}
 	
-	return result, nil
+	return unstructuredObjects(items), nil
 }
 
 // GetServingRuntimes retrieves KServe ServingRuntimes
 func (k *K8sClient) GetServingRuntimes(namespace string) ([]map[string]interface{}, error) {
+	items, err := k.listAllDynamic(ServingRuntimeGVR, namespace, "")
+	if err != nil {
+		if namespace == "" {
+			return nil, fmt.Errorf("failed to list servingruntimes: %w", err)
+		}
+		return nil, fmt.Errorf("failed to list servingruntimes in namespace %s: %w", namespace, err)
+	}
+	
+	return unstructuredObjects(items), nil
+}
+
+// GetServingRuntime retrieves a KServe ServingRuntime
+func (k *K8sClient) GetServingRuntime(namespace, name string) (map[string]interface{}, error) {
 	ctx := context.Background()
 	
-	// KServe ServingRuntime GVR
-	servingRuntimeGVR := schema.GroupVersionResource{
-		Group:    "serving.kserve.io",
-		Version:  "v1alpha1",
-		Resource: "servingruntimes",
+	obj, err := k.dynamicClient.Resource(ServingRuntimeGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get servingruntime %s/%s: %w", namespace, name, err)
 	}
 	
-	var result []map[string]interface{}
+	return obj.Object, nil
+}
+
+// CreateServingRuntime creates a KServe ServingRuntime and returns the stored object
+func (k *K8sClient) CreateServingRuntime(namespace string, runtime map[string]interface{}) (map[string]interface{}, error) {
+	ctx := context.Background()
 	
-	if namespace == "" {
-		list, err := k.dynamicClient.Resource(servingRuntimeGVR).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list servingruntimes: %w", err)
-		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
-	} else {
-		list, err := k.dynamicClient.Resource(servingRuntimeGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list servingruntimes in namespace %s: %w", namespace, err)
-		}
-		for _, item := range list.Items {
-			result = append(result, item.Object)
-		}
+	obj, err := k.dynamicClient.Resource(ServingRuntimeGVR).Namespace(namespace).Create(ctx, &unstructured.Unstructured{Object: runtime}, metav1.CreateOptions{})
+	if err != nil {
+		k.logError("CreateServingRuntime", err)
+		return nil, fmt.Errorf("failed to create servingruntime: %w", err)
 	}
 	
-	return result, nil
+	return obj.Object, nil
 }
 
-// GetClusterServingRuntimes retrieves KServe ClusterServingRuntimes
-func (k *K8sClient) GetClusterServingRuntimes() ([]map[string]interface{}, error) {
+// UpdateServingRuntime replaces a KServe ServingRuntime and returns the stored object
+func (k *K8sClient) UpdateServingRuntime(namespace string, runtime map[string]interface{}) (map[string]interface{}, error) {
 	ctx := context.Background()
 	
-	// KServe ClusterServingRuntime GVR
-	clusterServingRuntimeGVR := schema.GroupVersionResource{
-		Group:    "serving.kserve.io",
-		Version:  "v1alpha1",
-		Resource: "clusterservingruntimes",
+	obj, err := k.dynamicClient.Resource(ServingRuntimeGVR).Namespace(namespace).Update(ctx, &unstructured.Unstructured{Object: runtime}, metav1.UpdateOptions{})
+	if err != nil {
+		k.logError("UpdateServingRuntime", err)
+		return nil, fmt.Errorf("failed to update servingruntime: %w", err)
 	}
 	
-	var result []map[string]interface{}
+	return obj.Object, nil
+}
+
+// DeleteServingRuntime deletes a KServe ServingRuntime
+func (k *K8sClient) DeleteServingRuntime(namespace, name string) error {
+	ctx := context.Background()
+	
+	err := k.dynamicClient.Resource(ServingRuntimeGVR).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		k.logError("DeleteServingRuntime", err)
+		return fmt.Errorf("failed to delete servingruntime %s/%s: %w", namespace, name, err)
+	}
 	
-	list, err := k.dynamicClient.Resource(clusterServingRuntimeGVR).List(ctx, metav1.ListOptions{})
+	return nil
+}
+
+// GetClusterServingRuntimes retrieves KServe ClusterServingRuntimes
+func (k *K8sClient) GetClusterServingRuntimes() ([]map[string]interface{}, error) {
+	items, err := k.listAllDynamic(ClusterServingRuntimeGVR, "", "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list clusterservingruntimes: %w", err)
 	}
-	for _, item := range list.Items {
-		result = append(result, item.Object)
+	
+	return unstructuredObjects(items), nil
+}
+
+// GetClusterServingRuntime retrieves a KServe ClusterServingRuntime
+func (k *K8sClient) GetClusterServingRuntime(name string) (map[string]interface{}, error) {
+	ctx := context.Background()
+	
+	obj, err := k.dynamicClient.Resource(ClusterServingRuntimeGVR).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clusterservingruntime %s: %w", name, err)
 	}
 	
-	return result, nil
+	return obj.Object, nil
 }
\ No newline at end of file
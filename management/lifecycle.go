@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+)
+
+// Lifecycle owns the root context of the process. It is cancelled on SIGINT or SIGTERM, and
+// background components started with Go are waited for on shutdown, so pod termination does not
+// cut them off in the middle of a write.
+type Lifecycle struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	wg       sync.WaitGroup
+	running  map[string]int
+	stopping bool
+}
+
+// NewLifecycle creates a lifecycle whose context is cancelled when the process is asked to stop
+func NewLifecycle() *Lifecycle {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	return &Lifecycle{
+		ctx:     ctx,
+		cancel:  cancel,
+		running: make(map[string]int),
+	}
+}
+
+// Context returns the root context, cancelled when shutdown starts
+func (l *Lifecycle) Context() context.Context {
+	return l.ctx
+}
+
+// Done is closed when shutdown starts
+func (l *Lifecycle) Done() <-chan struct{} {
+	return l.ctx.Done()
+}
+
+// Go runs a background component in its own goroutine. The component must return soon after
+// its context is cancelled. Components started after Wait has begun are not run.
+func (l *Lifecycle) Go(name string, run func(ctx context.Context)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.stopping {
+		log.Printf("Not starting %s: service is shutting down", name)
+		return
+	}
+
+	l.running[name]++
+	l.wg.Add(1)
+	go func() {
+		defer func() {
+			l.mu.Lock()
+			if l.running[name]--; l.running[name] == 0 {
+				delete(l.running, name)
+			}
+			l.mu.Unlock()
+			l.wg.Done()
+		}()
+		run(l.ctx)
+	}()
+}
+
+// Wait cancels the root context and waits for the background components to return. When ctx
+// expires first, it logs the components that are still running and returns ctx's error.
+func (l *Lifecycle) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	l.stopping = true
+	l.mu.Unlock()
+	l.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		l.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Println("All background components stopped")
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		var names []string
+		for name := range l.running {
+			names = append(names, name)
+		}
+		l.mu.Unlock()
+		sort.Strings(names)
+		log.Printf("Background components still running at shutdown timeout: %v", names)
+		return ctx.Err()
+	}
+}
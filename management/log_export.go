@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// LogExporter ships audit and usage entries to an external webhook sink.
+// The daily ConfigMaps remain the local buffer; exporting is best effort and asynchronous.
+type LogExporter struct {
+	sinkURL    string
+	authToken  string
+	maxRetries int
+	client     *http.Client
+	queue      chan LogExportRecord
+	pending    sync.WaitGroup // Queued entries not yet delivered or dropped
+}
+
+// LogExportRecord represents a single entry forwarded to the external sink
+type LogExportRecord struct {
+	Kind      string                 `json:"kind"` // "audit", "usage" or "alert"
+	Namespace string                 `json:"namespace"`
+	Timestamp time.Time              `json:"timestamp"`
+	Entry     map[string]interface{} `json:"entry"`
+}
+
+var (
+	logExporter     *LogExporter
+	logExporterOnce sync.Once
+)
+
+// GetLogExporter returns the process-wide exporter, or nil when no sink is configured
+func GetLogExporter() *LogExporter {
+	logExporterOnce.Do(func() {
+		config := ActiveConfig()
+		if config.LogSinkURL == "" {
+			return
+		}
+		logExporter = NewLogExporter(config)
+		go logExporter.run()
+		log.Printf("Exporting audit and usage logs to %s", config.LogSinkURL)
+	})
+	return logExporter
+}
+
+// NewLogExporter creates a new exporter for the configured sink
+func NewLogExporter(config *Config) *LogExporter {
+	return &LogExporter{
+		sinkURL:    config.LogSinkURL,
+		authToken:  config.LogSinkAuthToken,
+		maxRetries: 3,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		queue:      make(chan LogExportRecord, 1000),
+	}
+}
+
+// Export queues an entry for delivery without blocking the caller. The entry is copied, so the
+// caller may keep using its map while the record waits in the queue.
+func (e *LogExporter) Export(kind, namespace string, entry map[string]interface{}) {
+	queued := make(map[string]interface{}, len(entry))
+	for key, value := range entry {
+		queued[key] = value
+	}
+	record := LogExportRecord{
+		Kind:      kind,
+		Namespace: namespace,
+		Timestamp: time.Now(),
+		Entry:     queued,
+	}
+
+	e.pending.Add(1)
+	select {
+	case e.queue <- record:
+	default:
+		e.pending.Done()
+		log.Printf("Log export queue full, dropping %s entry for namespace %s", kind, namespace)
+	}
+}
+
+func (e *LogExporter) run() {
+	for record := range e.queue {
+		var err error
+		for attempt := 0; attempt <= e.maxRetries; attempt++ {
+			if attempt > 0 {
+				time.Sleep(time.Duration(1<<(attempt-1)) * time.Second)
+			}
+			if err = e.send(record); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			log.Printf("Failed to export %s entry for namespace %s after %d attempts: %v", record.Kind, record.Namespace, e.maxRetries+1, err)
+		}
+		e.pending.Done()
+	}
+}
+
+// Flush waits until every queued entry has been delivered or dropped, or until ctx expires
+func (e *LogExporter) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		e.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		log.Printf("Log export queue not drained at shutdown, %d entries still queued", len(e.queue))
+		return ctx.Err()
+	}
+}
+
+func (e *LogExporter) send(record LogExportRecord) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal log record: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", e.sinkURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create sink request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+e.authToken)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach log sink: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("log sink returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// exportLogEntry forwards an entry to the external sink when one is configured
+func exportLogEntry(kind, namespace string, entry map[string]interface{}) {
+	if exporter := GetLogExporter(); exporter != nil {
+		exporter.Export(kind, namespace, entry)
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// How often the background pruner deletes daily logs past LOG_RETENTION_DAYS
+const logPruneInterval = 6 * time.Hour
+
+// Label selector of the ConfigMaps holding daily usage, audit and error logs
+const dailyLogLabelSelector = "app=published-model,type=audit-log"
+
+// Names of daily log parts: the log kind prefix, the day, and an optional rollover part suffix
+var dailyLogNamePattern = regexp.MustCompile(`^(model-usage-.+|publishing-audit|publishing-errors)-(\d{4}-\d{2}-\d{2})(-part\d+)?$`)
+
+// LogPruner deletes daily usage, audit and error log ConfigMaps older than a retention window
+type LogPruner struct {
+	k8sClient *K8sClient
+
+	// Held for a whole run so a manual prune and the background loop never overlap
+	mu sync.Mutex
+}
+
+// NewLogPruner creates a new pruner for the daily log ConfigMaps
+func NewLogPruner(k8sClient *K8sClient) *LogPruner {
+	return &LogPruner{k8sClient: k8sClient}
+}
+
+// Start prunes logs past LOG_RETENTION_DAYS on every interval until ctx is cancelled. The
+// retention is read from the active configuration each time, so a reload applies to the next run.
+func (p *LogPruner) Start(ctx context.Context) {
+	log.Printf("Starting daily log pruner (interval: %s)", logPruneInterval)
+
+	ticker := time.NewTicker(logPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		if days := ActiveConfig().LogRetentionDays; days > 0 {
+			if _, err := p.Prune(logRetentionCutoff(days), false); err != nil {
+				log.Printf("Failed to prune daily logs: %v", err)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			log.Println("Stopping daily log pruner")
+			return
+		}
+	}
+}
+
+// logRetentionCutoff returns the first day kept when logs are retained for the given number of
+// days, counting today, at local midnight like the log names
+func logRetentionCutoff(days int) time.Time {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return today.AddDate(0, 0, 1-days)
+}
+
+// Prune deletes the daily log parts of days before cutoff in every namespace. With dryRun set it
+// only reports what would be deleted.
+func (p *LogPruner) Prune(cutoff time.Time, dryRun bool) (*LogPruneResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	configMaps, err := p.k8sClient.ListConfigMapsByLabel(dailyLogLabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LogPruneResult{
+		Cutoff: cutoff,
+		DryRun: dryRun,
+		Pruned: []PrunedLog{},
+		Failed: []PrunedLog{},
+	}
+	for _, configMap := range configMaps {
+		match := dailyLogNamePattern.FindStringSubmatch(configMap.Name)
+		if match == nil {
+			continue
+		}
+		day, err := time.ParseInLocation("2006-01-02", match[2], cutoff.Location())
+		if err != nil || !day.Before(cutoff) {
+			continue
+		}
+
+		entry := PrunedLog{
+			Namespace: configMap.Namespace,
+			Name:      configMap.Name,
+			Kind:      dailyLogKind(match[1]),
+			Date:      match[2],
+		}
+		if !dryRun {
+			if err := p.k8sClient.DeleteConfigMap(configMap.Namespace, configMap.Name); err != nil && !IsNotFound(err) {
+				entry.Error = err.Error()
+				result.Failed = append(result.Failed, entry)
+				continue
+			}
+		}
+		result.Pruned = append(result.Pruned, entry)
+	}
+
+	sort.Slice(result.Pruned, func(i, j int) bool {
+		if result.Pruned[i].Namespace != result.Pruned[j].Namespace {
+			return result.Pruned[i].Namespace < result.Pruned[j].Namespace
+		}
+		return result.Pruned[i].Name < result.Pruned[j].Name
+	})
+
+	if !dryRun {
+		for _, entry := range result.Pruned {
+			log.Printf("Pruned %s log %s/%s from %s", entry.Kind, entry.Namespace, entry.Name, entry.Date)
+		}
+		if len(result.Pruned) > 0 || len(result.Failed) > 0 {
+			log.Printf("Pruned %d daily log(s) before %s, %d failed", len(result.Pruned), cutoff.Format("2006-01-02"), len(result.Failed))
+		}
+	}
+	return result, nil
+}
+
+// dailyLogKind maps the name prefix of a daily log to its kind
+func dailyLogKind(prefix string) string {
+	switch prefix {
+	case "publishing-audit":
+		return "audit"
+	case "publishing-errors":
+		return "errors"
+	default:
+		return "usage"
+	}
+}
+
+// PruneLogs handles POST /api/admin/logs/prune
+// It deletes daily logs older than ?olderThan= days, or LOG_RETENTION_DAYS when not given.
+func (p *LogPruner) PruneLogs(c *gin.Context) {
+	days := ActiveConfig().LogRetentionDays
+	if value := c.Query("olderThan"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "OlderThan must be a positive number of days",
+			})
+			return
+		}
+		days = parsed
+	}
+	if days <= 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "OlderThan is required when LOG_RETENTION_DAYS is not configured",
+		})
+		return
+	}
+
+	result, err := p.Prune(logRetentionCutoff(days), c.Query("dryRun") == "true")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to prune logs",
+			Details: err.Error(),
+		})
+		return
+	}
+	result.OlderThanDays = days
+
+	c.JSON(http.StatusOK, result)
+}
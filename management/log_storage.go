@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Daily usage, audit and error logs are stored as ConfigMaps, which etcd caps at 1MB.
+// A day's log is split into parts: "<name>", "<name>-part2", "<name>-part3", ...
+const (
+	maxLogConfigMapBytes = 900 * 1024 // Roll over before reaching the 1MB object limit
+	maxLogFieldBytes     = 1024       // Longer string fields in an entry are trimmed
+)
+
+// logPartName returns the ConfigMap name for a part of a daily log
+func logPartName(baseName string, part int) string {
+	if part <= 1 {
+		return baseName
+	}
+	return fmt.Sprintf("%s-part%d", baseName, part)
+}
+
+// appendLogEntry appends an entry to the latest part of a daily log, rolling over to a new
+// part when the current one would grow past maxLogConfigMapBytes. newLog builds the contents
+// of a fresh part holding only the entry; update, if set, adjusts an existing part (such as
+// its summary) after the entry has been appended.
+func appendLogEntry(k8sClient *K8sClient, namespace, baseName string, entry map[string]interface{}, newLog func(entry map[string]interface{}) map[string]interface{}, update func(log map[string]interface{})) error {
+	entry = trimLogEntry(entry)
+
+	// Find the latest existing part
+	part := 1
+	var current map[string]interface{}
+	for {
+		data, err := k8sClient.GetConfigMap(namespace, logPartName(baseName, part))
+		if err != nil {
+			break
+		}
+		current = data
+		part++
+	}
+
+	if current != nil {
+		if entries, ok := current["entries"].([]interface{}); ok {
+			current["entries"] = append(entries, entry)
+			if update != nil {
+				update(current)
+			}
+
+			if logSize(current) <= maxLogConfigMapBytes {
+				return k8sClient.UpdateConfigMap(namespace, logPartName(baseName, part-1), current)
+			}
+		}
+	}
+
+	// Start a new part
+	return k8sClient.CreateConfigMap(namespace, logPartName(baseName, part), newLog(entry))
+}
+
+// newEntriesLog builds a log part that holds entries only, as used by the audit and error logs
+func newEntriesLog(entry map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"entries": []interface{}{entry},
+	}
+}
+
+// readDailyLog reads every part of a daily log and merges them into a single log.
+// Entries are concatenated in order and numeric summary fields are combined.
+func readDailyLog(k8sClient *K8sClient, namespace, baseName string) (map[string]interface{}, error) {
+	var merged map[string]interface{}
+
+	for part := 1; ; part++ {
+		data, err := k8sClient.GetConfigMap(namespace, logPartName(baseName, part))
+		if err != nil {
+			if part == 1 {
+				return nil, err
+			}
+			break
+		}
+
+		if merged == nil {
+			merged = data
+			continue
+		}
+
+		if entries, ok := data["entries"].([]interface{}); ok {
+			mergedEntries, _ := merged["entries"].([]interface{})
+			merged["entries"] = append(mergedEntries, entries...)
+		}
+
+		if summary, ok := data["summary"].(map[string]interface{}); ok {
+			mergedSummary, ok := merged["summary"].(map[string]interface{})
+			if !ok {
+				merged["summary"] = summary
+				continue
+			}
+			mergeLogSummary(mergedSummary, summary)
+		}
+	}
+
+	return merged, nil
+}
+
+// summaryAverageCounts maps each average in a log summary to the counter it is averaged over
+var summaryAverageCounts = map[string]string{
+	"avgResponseTime": "totalRequests",
+	"avgColdStartMs":  "coldStartCount",
+}
+
+// mergeLogSummary adds the counters of one part's summary into another.
+// Averages are weighted by each part's count from summaryAverageCounts.
+func mergeLogSummary(into, from map[string]interface{}) {
+	// Read the counts before the loop below adds them up
+	intoCounts := make(map[string]float64, len(summaryAverageCounts))
+	fromCounts := make(map[string]float64, len(summaryAverageCounts))
+	for key, countKey := range summaryAverageCounts {
+		intoCounts[key], _ = into[countKey].(float64)
+		fromCounts[key], _ = from[countKey].(float64)
+	}
+
+	for key, value := range from {
+		fromValue, ok := value.(float64)
+		if !ok {
+			continue
+		}
+		intoValue, _ := into[key].(float64)
+
+		if _, ok := summaryAverageCounts[key]; ok {
+			if total := intoCounts[key] + fromCounts[key]; total > 0 {
+				into[key] = (intoValue*intoCounts[key] + fromValue*fromCounts[key]) / total
+			}
+			continue
+		}
+		into[key] = intoValue + fromValue
+	}
+}
+
+// trimLogEntry returns a copy of entry with string fields capped, so a single entry cannot fill
+// a part. The caller's map is left untouched, since it may already be queued for export.
+func trimLogEntry(entry map[string]interface{}) map[string]interface{} {
+	trimmed := make(map[string]interface{}, len(entry))
+	for key, value := range entry {
+		if str, ok := value.(string); ok && len(str) > maxLogFieldBytes {
+			value = str[:maxLogFieldBytes] + "...(truncated)"
+		}
+		trimmed[key] = value
+	}
+	return trimmed
+}
+
+func logSize(data map[string]interface{}) int {
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return 0
+	}
+	return len(dataJSON)
+}
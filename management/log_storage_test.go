@@ -0,0 +1,65 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeLogSummary(t *testing.T) {
+	into := map[string]interface{}{
+		"totalRequests":   float64(3),
+		"totalTokens":     float64(300),
+		"avgResponseTime": float64(100),
+		"errorCount":      float64(1),
+		"coldStartCount":  float64(1),
+		"avgColdStartMs":  float64(4000),
+	}
+	from := map[string]interface{}{
+		"totalRequests":   float64(1),
+		"totalTokens":     float64(50),
+		"avgResponseTime": float64(500),
+		"errorCount":      float64(0),
+		"coldStartCount":  float64(3),
+		"avgColdStartMs":  float64(2000),
+	}
+
+	mergeLogSummary(into, from)
+
+	want := map[string]interface{}{
+		"totalRequests":   float64(4),
+		"totalTokens":     float64(350),
+		"avgResponseTime": float64(200), // (100*3 + 500*1) / 4
+		"errorCount":      float64(1),
+		"coldStartCount":  float64(4),
+		"avgColdStartMs":  float64(2500), // (4000*1 + 2000*3) / 4
+	}
+	if !reflect.DeepEqual(into, want) {
+		t.Errorf("merged summary = %v, want %v", into, want)
+	}
+}
+
+func TestMergeLogSummaryWithoutColdStarts(t *testing.T) {
+	// Parts written before cold-start tracking have no cold-start fields
+	into := map[string]interface{}{
+		"totalRequests":   float64(2),
+		"avgResponseTime": float64(100),
+	}
+	from := map[string]interface{}{
+		"totalRequests":   float64(2),
+		"avgResponseTime": float64(300),
+		"coldStartCount":  float64(2),
+		"avgColdStartMs":  float64(1500),
+	}
+
+	mergeLogSummary(into, from)
+
+	want := map[string]interface{}{
+		"totalRequests":   float64(4),
+		"avgResponseTime": float64(200),
+		"coldStartCount":  float64(2),
+		"avgColdStartMs":  float64(1500),
+	}
+	if !reflect.DeepEqual(into, want) {
+		t.Errorf("merged summary = %v, want %v", into, want)
+	}
+}
@@ -2,11 +2,13 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -41,8 +43,15 @@ func RequestResponseLogger() gin.HandlerFunc {
 	})
 }
 
-// DetailedRequestResponseLogger creates a middleware that logs full request and response details
-func DetailedRequestResponseLogger() gin.HandlerFunc {
+// DetailedRequestResponseLogger creates a middleware that logs full request and response details.
+// Bodies are only captured for sampled requests outside the configured exclude paths.
+func DetailedRequestResponseLogger(config *Config) gin.HandlerFunc {
+	sampleRate := uint64(config.LogBodySampleRate)
+	if sampleRate == 0 {
+		sampleRate = 1
+	}
+	var requestCount uint64
+
 	return func(c *gin.Context) {
 		// Generate request ID for tracing
 		requestID := uuid.New().String()[:8]
@@ -50,8 +59,25 @@ func DetailedRequestResponseLogger() gin.HandlerFunc {
 		
 		start := time.Now()
 		
+		logBody := atomic.AddUint64(&requestCount, 1)%sampleRate == 0 &&
+			!isBodyLoggingExcluded(c.Request.URL.Path, config.LogBodyExcludePaths)
+		
+		// Prediction payloads may carry PII, so they are only logged when explicitly enabled
+		// and then with the configured fields redacted
+		var redactFields []string
+		if isPredictionPath(c.Request.URL.Path) {
+			logBody = logBody && config.LogPredictionBodies
+			redactFields = config.LogPredictionRedactFields
+		}
+		
 		// Log request details
-		logRequestDetails(c, requestID)
+		logRequestDetails(c, requestID, logBody, config.LogBodyMaxBytes, redactFields)
+		
+		if !logBody {
+			c.Next()
+			logResponseDetails(c, nil, requestID, start, config.LogBodyMaxBytes, redactFields)
+			return
+		}
 		
 		// Create response writer wrapper to capture response body
 		writer := &responseWriter{
@@ -64,11 +90,11 @@ func DetailedRequestResponseLogger() gin.HandlerFunc {
 		c.Next()
 		
 		// Log response details
-		logResponseDetails(c, writer, requestID, start)
+		logResponseDetails(c, writer, requestID, start, config.LogBodyMaxBytes, redactFields)
 	}
 }
 
-func logRequestDetails(c *gin.Context, requestID string) {
+func logRequestDetails(c *gin.Context, requestID string, logBody bool, maxBodySize int, redactFields []string) {
 	// Skip logging for health checks and static files to reduce noise
 	if shouldSkipLogging(c.Request.URL.Path) {
 		return
@@ -92,7 +118,7 @@ func logRequestDetails(c *gin.Context, requestID string) {
 	}
 	
 	// Log request body for POST/PUT requests
-	if c.Request.Method == "POST" || c.Request.Method == "PUT" || c.Request.Method == "PATCH" {
+	if logBody && (c.Request.Method == "POST" || c.Request.Method == "PUT" || c.Request.Method == "PATCH") {
 		if c.Request.Body != nil {
 			bodyBytes, err := io.ReadAll(c.Request.Body)
 			if err == nil {
@@ -103,14 +129,14 @@ func logRequestDetails(c *gin.Context, requestID string) {
 				bodyStr := string(bodyBytes)
 				if len(bodyStr) > 0 {
 					log.Printf("📦 [REQ-%s] Body (%d bytes):", requestID, len(bodyStr))
-					logSafeBody(bodyStr, requestID, "REQ")
+					logSafeBody(bodyStr, requestID, "REQ", maxBodySize, redactFields)
 				}
 			}
 		}
 	}
 }
 
-func logResponseDetails(c *gin.Context, writer *responseWriter, requestID string, start time.Time) {
+func logResponseDetails(c *gin.Context, writer *responseWriter, requestID string, start time.Time, maxBodySize int, redactFields []string) {
 	// Skip logging for health checks and static files
 	if shouldSkipLogging(c.Request.URL.Path) {
 		return
@@ -139,21 +165,27 @@ func logResponseDetails(c *gin.Context, writer *responseWriter, requestID string
 		}
 	}
 	
-	// Log response body
-	responseBody := writer.body.String()
-	if len(responseBody) > 0 {
-		log.Printf("📦 [RES-%s] Body (%d bytes):", requestID, len(responseBody))
-		logSafeBody(responseBody, requestID, "RES")
+	// Log response body when it was captured
+	if writer != nil {
+		responseBody := writer.body.String()
+		if len(responseBody) > 0 {
+			log.Printf("📦 [RES-%s] Body (%d bytes):", requestID, len(responseBody))
+			logSafeBody(responseBody, requestID, "RES", maxBodySize, redactFields)
+		}
 	}
 	
 	log.Printf("⏱️  [REQ-%s] Total Duration: %v", requestID, duration)
 	log.Printf("🔚 [REQ-%s] Request Complete\n", requestID)
 }
 
-func logSafeBody(body, requestID, prefix string) {
-	// Limit body size for logging (max 1000 characters)
-	maxLogSize := 1000
-	if len(body) > maxLogSize {
+func logSafeBody(body, requestID, prefix string, maxLogSize int, redactFields []string) {
+	// Redact configured fields before truncation, while the body is still valid JSON
+	if len(redactFields) > 0 {
+		body = redactJSONFields(body, redactFields)
+	}
+	
+	// Limit body size for logging
+	if maxLogSize > 0 && len(body) > maxLogSize {
 		body = body[:maxLogSize] + "... [TRUNCATED]"
 	}
 	
@@ -201,6 +233,79 @@ func redactSensitiveData(body string) string {
 	return result
 }
 
+// redactJSONFields replaces the values of the given fields in a JSON body with "[REDACTED]".
+// A dotted path such as "inputData.instances" is matched from the top level, descending through
+// arrays; a bare field name such as "content" is matched at any depth. Bodies that are not JSON
+// cannot be inspected and are withheld entirely.
+func redactJSONFields(body string, fields []string) string {
+	var data interface{}
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		return "[REDACTED: body is not JSON]"
+	}
+	
+	for _, field := range fields {
+		if strings.Contains(field, ".") {
+			redactJSONPath(data, strings.Split(field, "."))
+		} else {
+			redactJSONKey(data, field)
+		}
+	}
+	
+	redacted, err := json.Marshal(data)
+	if err != nil {
+		return "[REDACTED: body could not be re-encoded]"
+	}
+	return string(redacted)
+}
+
+// redactJSONPath redacts the value at path, applying the remaining path to every array element
+func redactJSONPath(value interface{}, path []string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		child, ok := v[path[0]]
+		if !ok {
+			return
+		}
+		if len(path) == 1 {
+			v[path[0]] = "[REDACTED]"
+			return
+		}
+		redactJSONPath(child, path[1:])
+	case []interface{}:
+		for _, item := range v {
+			redactJSONPath(item, path)
+		}
+	}
+}
+
+// redactJSONKey redacts every value stored under key, at any depth
+func redactJSONKey(value interface{}, key string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, child := range v {
+			if name == key {
+				v[name] = "[REDACTED]"
+				continue
+			}
+			redactJSONKey(child, key)
+		}
+	case []interface{}:
+		for _, item := range v {
+			redactJSONKey(item, key)
+		}
+	}
+}
+
+// isPredictionPath reports whether a path carries inference payloads
+func isPredictionPath(path string) bool {
+	for _, suffix := range []string{"/predict", "/explain", "/chat/completions", "/completions", "/embeddings", "/infer"} {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return strings.Contains(path, ":predict")
+}
+
 func prettyPrintJSON(jsonStr string) string {
 	// Simple JSON formatting for logging
 	// Replace commas and braces with newlines for better readability
@@ -230,6 +335,17 @@ func shouldSkipLogging(path string) bool {
 	return false
 }
 
+func isBodyLoggingExcluded(path string, excludePaths []string) bool {
+	// Bodies on these paths may carry user data (e.g. prediction payloads)
+	for _, excludePath := range excludePaths {
+		if strings.Contains(path, excludePath) {
+			return true
+		}
+	}
+	
+	return false
+}
+
 func isSensitiveHeader(headerName string) bool {
 	// Headers that should be redacted in logs
 	sensitiveHeaders := []string{
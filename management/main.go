@@ -5,8 +5,6 @@ import (
 	"log"
 	"net/http"
 	"os"
-	"os/signal"
-	"syscall"
 	"time"
 )
 
@@ -19,32 +17,47 @@ func main() {
 		return
 	}
 	
-	// Initialize configuration
-	config := NewConfig()
-	
 	// Initialize services
 	k8sClient, err := NewK8sClient()
 	if err != nil {
 		log.Fatalf("Failed to initialize Kubernetes client: %v", err)
 	}
 	
-	authService := NewAuthService(config, k8sClient)
-	modelService := NewModelService(k8sClient)
-	adminService := NewAdminService(k8sClient)
+	// Initialize configuration, applying the override ConfigMap when present
+	if err := LoadConfigOverrides(k8sClient); err != nil {
+		log.Printf("Failed to read config overrides, using environment only: %v", err)
+	}
+	config := ActiveConfig()
+	
+	// Surface missing RBAC permissions up front instead of as 403s mid-operation
+	if missing := RunPermissionSelfTest(k8sClient, config); len(missing) > 0 && config.PermissionCheckStrict {
+		log.Fatalf("Refusing to start with %d missing permission(s) (PERMISSION_CHECK_STRICT=true)", len(missing))
+	}
+	
+	// Fail fast on a malformed API key encryption key rather than on the first publish
+	if _, err := apiKeyCipher(config); err != nil {
+		log.Fatalf("Invalid API key encryption configuration: %v", err)
+	}
+	
+	// Root context for background components, cancelled on SIGINT or SIGTERM
+	lifecycle := NewLifecycle()
+	
+	authService := NewAuthService(k8sClient)
 	publishingService := NewPublishingService(k8sClient, authService)
-	testExecutionService := NewTestExecutionService(publishingService, config)
+	modelService := NewModelService(k8sClient, publishingService, lifecycle)
+	adminService := NewAdminService(k8sClient)
+	testExecutionService := NewTestExecutionService(publishingService)
+	reconciler := NewPublishingReconciler(publishingService, config)
+	logPruner := NewLogPruner(k8sClient)
 	
 	// Initialize HTTP server
-	server := NewServer(config, authService, modelService, adminService, publishingService, testExecutionService)
+	server := NewServer(config, authService, modelService, adminService, publishingService, testExecutionService, reconciler, logPruner)
 	
 	// Setup routes
 	server.SetupRoutes()
 	
 	// Start server
-	srv := &http.Server{
-		Addr:    ":" + config.Port,
-		Handler: server.Router,
-	}
+	srv := NewHTTPServer(config, server.Router)
 	
 	// Start server in a goroutine
 	go func() {
@@ -55,19 +68,60 @@ func main() {
 		log.Println("  GET  /api/models - List models")
 		log.Println("  GET  /api/models/:name - Get model details")
 		log.Println("  POST /api/models - Create model")
+		log.Println("  POST /api/models/import - Import model from InferenceService manifest")
+		log.Println("  POST /api/models/validate - Validate a model request or manifest without creating it")
+		log.Println("  POST /api/models/:name/diff - Preview changes of a model update")
 		log.Println("  PUT  /api/models/:name - Update model")
 		log.Println("  DELETE /api/models/:name - Delete model")
+		log.Println("  GET  /api/models/:name/versions - List stored model versions")
+		log.Println("  DELETE /api/models/:name/versions/:id - Delete a stored model version")
+		log.Println("  POST /api/models/:name/disable - Disable model (scale to zero)")
+		log.Println("  POST /api/models/:name/enable - Re-enable disabled model")
 		log.Println("  POST /api/models/:name/predict - Make prediction")
+		log.Println("  POST /api/models/:name/explain - Get prediction explanation")
 		log.Println("  GET  /api/models/:name/logs - Get model logs")
+		log.Println("  GET  /api/models/:name/metrics - Get model Prometheus metrics")
+		log.Println("  GET  /api/serving-runtimes/:name/formats - List model formats of a serving runtime")
+		log.Println("  GET  /api/cluster-serving-runtimes/:name/formats - List model formats of a cluster serving runtime")
 		log.Println("  GET  /api/tenant - Get tenant info")
+		log.Println("  GET  /api/tenant/usage - Get usage summed across the tenant's published models")
 		log.Println("  GET  /api/frameworks - List supported frameworks")
+		log.Println("  GET  /api/model-tasks - List model tasks and the publish mode each maps to")
 		log.Println("  POST /api/models/:name/publish - Publish model")
 		log.Println("  DELETE /api/models/:name/publish - Unpublish model")
 		log.Println("  GET  /api/models/:name/publish - Get published model")
+		log.Println("  GET  /api/models/:name/publish/preview-docs - Preview published model documentation")
+		log.Println("  POST /api/models/:name/publish/refresh-docs - Regenerate published model documentation")
+		log.Println("  GET  /api/models/:name/publish/export - Export a published model bundle")
+		log.Println("  GET  /api/models/:name/publish/sdk/:language - Download a client SDK project for a published model")
+		log.Println("  GET  /api/models/:name/publish/resources - List the resources publishing creates for a model")
+		log.Println("  POST /api/models/:name/publish/import - Publish a model from an exported bundle")
 		log.Println("  POST /api/models/:name/publish/rotate-key - Rotate API key")
+		log.Println("  GET  /api/models/:name/publish/rate-limit-status - Get rate-limit counters")
+		log.Println("  GET  /api/models/:name/publish/errors - List recent publishing errors for a model")
 		log.Println("  GET  /api/published-models - List published models")
+		log.Println("  GET  /api/published-models/lookup - Find the published model serving a hostname and path")
+		log.Println("  GET  /api/admin/summary - Get the admin overview counts")
+		log.Println("  GET  /api/admin/models - List models across namespaces with filters")
+		log.Println("  GET  /api/admin/models/duplicates - Report model names used in more than one namespace")
+		log.Println("  POST /api/admin/impersonate - Issue a short-lived token scoped to a tenant")
+		log.Println("  DELETE /api/admin/publish/:name/force - Force-unpublish a model across all namespaces")
+		log.Println("  GET  /api/admin/gateway/hostnames - List gateway listener hostnames")
+		log.Println("  GET  /api/admin/gateway/tls-status - Report the expiry of the gateway's TLS certificates")
+		log.Println("  GET  /api/admin/gateway/connectivity-check - Check the gateway service is reachable from the cluster")
+		log.Println("  GET  /api/admin/hostname-reservations - List tenant hostname reservations")
+		log.Println("  PUT  /api/admin/hostname-reservations - Replace tenant hostname reservations")
+		log.Println("  POST /api/admin/serving-runtimes - Create a serving runtime")
+		log.Println("  PUT  /api/admin/serving-runtimes/:name - Update a serving runtime")
+		log.Println("  DELETE /api/admin/serving-runtimes/:name - Delete a serving runtime")
+		log.Println("  POST /api/admin/config/reload - Reload configuration without a restart")
+		log.Println("  POST /api/admin/logs/prune - Delete daily usage, audit and error logs past the retention window")
+		log.Println("  POST /api/admin/usage/compare - Compare and rank the usage of several models over a date range")
+		log.Println("  GET  /api/admin/proxy-load - Get prediction proxy in-flight and rejected request counts")
+		log.Println("  GET  /api/admin/published-models/orphaned - List published models whose InferenceService is gone")
 		log.Println("  POST /api/publish/test/execute - Execute test for published models")
 		log.Println("  GET  /api/publish/test/history - Get published model test history")
+		log.Println("  GET  /api/publish/errors - List recent publishing errors for the tenant")
 		log.Println("  POST /api/publish/test/validate - Validate published model test request")
 		log.Println("  GET  /* - Serve React application")
 		
@@ -76,19 +130,28 @@ func main() {
 		}
 	}()
 	
+	// Keep published-model metadata in sync with live gateway resources
+	lifecycle.Go("published model reconciler", reconciler.Start)
+	
+	// Delete daily logs past LOG_RETENTION_DAYS
+	lifecycle.Go("daily log pruner", logPruner.Start)
+	
 	// Wait for interrupt signal to gracefully shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	<-lifecycle.Done()
 	
 	log.Println("🛑 Server shutting down...")
 	
-	// Graceful shutdown with timeout
+	// Graceful shutdown with timeout shared by the server, background components and log export
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 	
+	// Stop accepting requests first, so handlers cannot start background work after the wait
 	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatal("Server forced to shutdown:", err)
+		log.Printf("Server forced to shutdown: %v", err)
+	}
+	lifecycle.Wait(ctx)
+	if exporter := GetLogExporter(); exporter != nil {
+		exporter.Flush(ctx)
 	}
 	
 	log.Println("✅ Server exited")
@@ -134,7 +197,7 @@ func testConfiguration() {
 		return
 	}
 	
-	authService := NewAuthService(config, k8sClient)
+	authService := NewAuthService(k8sClient)
 	user, err := authService.ValidateToken("super-admin-token")
 	if err == nil && user.IsAdmin {
 		log.Println("✅ JWT validation works")
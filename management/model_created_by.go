@@ -0,0 +1,66 @@
+package main
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Label recording the user that created a model through the API
+const ModelCreatedByLabel = "inference-in-a-box/created-by"
+
+// createdByLabelValue returns the label value identifying u, using the token subject when the user
+// has no name. Characters a label value cannot hold, such as the @ of an email, become "_".
+func createdByLabelValue(u *User) string {
+	name := u.Name
+	if name == "" {
+		name = u.Subject
+	}
+	return sanitizeLabelValue(name)
+}
+
+// sanitizeLabelValue maps s to a valid label value, or "" when nothing usable is left
+func sanitizeLabelValue(s string) string {
+	value := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, s)
+	if len(value) > validation.LabelValueMaxLength {
+		value = value[:validation.LabelValueMaxLength]
+	}
+	value = strings.Trim(value, "-._")
+	if len(validation.IsValidLabelValue(value)) > 0 {
+		return ""
+	}
+	return value
+}
+
+// setModelCreatedBy labels an InferenceService manifest with its creator, leaving it unlabeled
+// when the creator cannot be expressed as a label value
+func setModelCreatedBy(manifest map[string]interface{}, createdBy string) {
+	if createdBy == "" {
+		return
+	}
+	metadata, ok := manifest["metadata"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	labels, ok := metadata["labels"].(map[string]interface{})
+	if !ok {
+		labels = map[string]interface{}{}
+		metadata["labels"] = labels
+	}
+	labels[ModelCreatedByLabel] = createdBy
+}
+
+// modelCreatedBy reads the creator label of an InferenceService, "" for models created outside the API
+func modelCreatedBy(obj map[string]interface{}) string {
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	labels, _ := metadata["labels"].(map[string]interface{})
+	createdBy, _ := labels[ModelCreatedByLabel].(string)
+	return createdBy
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetDuplicateModels handles GET /api/admin/models/duplicates
+// It reports model names that exist in more than one namespace, as an InferenceService or as a
+// published model, since name-only admin operations cannot tell those models apart.
+func (s *AdminService) GetDuplicateModels(c *gin.Context) {
+	publishedOnly := c.Query("published")
+	if publishedOnly != "" && publishedOnly != "true" && publishedOnly != "false" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Published must be true or false",
+		})
+		return
+	}
+
+	inferenceServices, err := s.k8sClient.GetInferenceServices("")
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to list models",
+			Details: err.Error(),
+		})
+		return
+	}
+	publishedModels, err := s.k8sClient.ListPublishedModels("")
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to list published models",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	duplicates := findDuplicateModelNames(inferenceServices, publishedModels)
+	if publishedOnly == "true" {
+		filtered := []DuplicateModelName{}
+		for _, duplicate := range duplicates {
+			if duplicate.PublishedCount > 1 {
+				filtered = append(filtered, duplicate)
+			}
+		}
+		duplicates = filtered
+	}
+
+	c.JSON(http.StatusOK, DuplicateModelsResponse{
+		Duplicates: duplicates,
+		Total:      len(duplicates),
+		CheckedAt:  time.Now(),
+	})
+}
+
+// findDuplicateModelNames groups InferenceServices and published-model metadata by model name and
+// returns the names found in more than one namespace, sorted by name, with their namespaces sorted
+func findDuplicateModelNames(inferenceServices, publishedModels []map[string]interface{}) []DuplicateModelName {
+	byName := make(map[string]map[string]*DuplicateModelNamespace)
+	entry := func(name, namespace string) *DuplicateModelNamespace {
+		namespaces, ok := byName[name]
+		if !ok {
+			namespaces = make(map[string]*DuplicateModelNamespace)
+			byName[name] = namespaces
+		}
+		ns, ok := namespaces[namespace]
+		if !ok {
+			ns = &DuplicateModelNamespace{Namespace: namespace}
+			namespaces[namespace] = ns
+		}
+		return ns
+	}
+
+	for _, is := range inferenceServices {
+		metadata, _ := is["metadata"].(map[string]interface{})
+		name, _ := metadata["name"].(string)
+		namespace, _ := metadata["namespace"].(string)
+		if name == "" || namespace == "" {
+			continue
+		}
+		entry(name, namespace).InferenceService = true
+	}
+	for _, published := range publishedModels {
+		name, _ := published["modelName"].(string)
+		namespace, _ := published["namespace"].(string)
+		if name == "" || namespace == "" {
+			continue
+		}
+		entry(name, namespace).Published = true
+	}
+
+	duplicates := []DuplicateModelName{}
+	for name, namespaces := range byName {
+		if len(namespaces) < 2 {
+			continue
+		}
+		duplicate := DuplicateModelName{
+			ModelName:  name,
+			Namespaces: make([]DuplicateModelNamespace, 0, len(namespaces)),
+		}
+		for _, ns := range namespaces {
+			duplicate.Namespaces = append(duplicate.Namespaces, *ns)
+			if ns.Published {
+				duplicate.PublishedCount++
+			}
+		}
+		sort.Slice(duplicate.Namespaces, func(i, j int) bool {
+			return duplicate.Namespaces[i].Namespace < duplicate.Namespaces[j].Namespace
+		})
+		duplicates = append(duplicates, duplicate)
+	}
+	sort.Slice(duplicates, func(i, j int) bool {
+		return duplicates[i].ModelName < duplicates[j].ModelName
+	})
+	return duplicates
+}
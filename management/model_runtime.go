@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Resource names counted as GPUs in the runtime summary
+var gpuResourceNames = []string{"nvidia.com/gpu", "amd.com/gpu", "gpu.intel.com/i915"}
+
+// detectRuntimeInfo returns what the model actually runs. A running predictor pod is preferred,
+// since it shows the image resolved from the ServingRuntime; without pods, for example when the
+// model is scaled to zero, the predictor spec is used.
+func detectRuntimeInfo(modelInfo ModelInfo, pods []corev1.Pod) *RuntimeInfo {
+	predictor, _ := modelInfo.Predictor.(map[string]interface{})
+
+	var info *RuntimeInfo
+	if pod := pickRuntimePod(pods); pod != nil {
+		info = runtimeInfoFromPod(*pod)
+	} else if predictor != nil {
+		info = runtimeInfoFromSpec(predictor)
+	}
+	if info == nil {
+		return nil
+	}
+
+	if model, ok := predictor["model"].(map[string]interface{}); ok {
+		info.Runtime, _ = model["runtime"].(string)
+	}
+	return info
+}
+
+// pickRuntimePod returns a running pod if there is one, else the first pod
+func pickRuntimePod(pods []corev1.Pod) *corev1.Pod {
+	for i := range pods {
+		if pods[i].Status.Phase == corev1.PodRunning {
+			return &pods[i]
+		}
+	}
+	if len(pods) > 0 {
+		return &pods[0]
+	}
+	return nil
+}
+
+func runtimeInfoFromPod(pod corev1.Pod) *RuntimeInfo {
+	if len(pod.Spec.Containers) == 0 {
+		return nil
+	}
+	container := pod.Spec.Containers[0]
+	for _, c := range pod.Spec.Containers {
+		if c.Name == "kserve-container" {
+			container = c
+			break
+		}
+	}
+
+	info := &RuntimeInfo{
+		Source:             "pod",
+		Container:          container.Name,
+		Image:              container.Image,
+		Args:               container.Args,
+		ServiceAccountName: pod.Spec.ServiceAccountName,
+	}
+	for _, env := range container.Env {
+		info.Env = append(info.Env, RuntimeEnvVar{
+			Name:      env.Name,
+			Value:     env.Value,
+			ValueFrom: envVarSourceReference(env.ValueFrom),
+		})
+	}
+	info.Resources.Requests = resourceListStrings(container.Resources.Requests)
+	info.Resources.Limits = resourceListStrings(container.Resources.Limits)
+	summarizeRuntimeResources(&info.Resources)
+	return info
+}
+
+// runtimeInfoFromSpec reads the model container from the predictor: the new-style model section,
+// a legacy framework section, or the first custom container
+func runtimeInfoFromSpec(predictor map[string]interface{}) *RuntimeInfo {
+	info := &RuntimeInfo{Source: "spec"}
+	info.ServiceAccountName, _ = predictor["serviceAccountName"].(string)
+
+	container, _ := predictor["model"].(map[string]interface{})
+	if container == nil {
+		if framework := detectFramework(map[string]interface{}{"predictor": predictor}); framework != "" && framework != "custom" {
+			container, _ = predictor[framework].(map[string]interface{})
+		}
+	}
+	if container == nil {
+		if containers, ok := predictor["containers"].([]interface{}); ok && len(containers) > 0 {
+			container, _ = containers[0].(map[string]interface{})
+			for _, item := range containers {
+				if c, ok := item.(map[string]interface{}); ok && c["name"] == "kserve-container" {
+					container = c
+					break
+				}
+			}
+		}
+	}
+	if container == nil {
+		return info
+	}
+
+	info.Container, _ = container["name"].(string)
+	info.Image, _ = container["image"].(string)
+	if args, ok := container["args"].([]interface{}); ok {
+		for _, arg := range args {
+			info.Args = append(info.Args, fmt.Sprint(arg))
+		}
+	}
+	if envList, ok := container["env"].([]interface{}); ok {
+		for _, item := range envList {
+			env, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			envVar := RuntimeEnvVar{}
+			envVar.Name, _ = env["name"].(string)
+			envVar.Value, _ = env["value"].(string)
+			if valueFrom, ok := env["valueFrom"].(map[string]interface{}); ok {
+				envVar.ValueFrom = specEnvVarSourceReference(valueFrom)
+			}
+			info.Env = append(info.Env, envVar)
+		}
+	}
+	if resources, ok := container["resources"].(map[string]interface{}); ok {
+		info.Resources.Requests = specResourceStrings(resources["requests"])
+		info.Resources.Limits = specResourceStrings(resources["limits"])
+	}
+	summarizeRuntimeResources(&info.Resources)
+	return info
+}
+
+// envVarSourceReference describes where an environment variable's value comes from
+func envVarSourceReference(source *corev1.EnvVarSource) string {
+	switch {
+	case source == nil:
+		return ""
+	case source.SecretKeyRef != nil:
+		return fmt.Sprintf("secretKeyRef:%s/%s", source.SecretKeyRef.Name, source.SecretKeyRef.Key)
+	case source.ConfigMapKeyRef != nil:
+		return fmt.Sprintf("configMapKeyRef:%s/%s", source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key)
+	case source.FieldRef != nil:
+		return "fieldRef:" + source.FieldRef.FieldPath
+	case source.ResourceFieldRef != nil:
+		return "resourceFieldRef:" + source.ResourceFieldRef.Resource
+	}
+	return ""
+}
+
+// specEnvVarSourceReference is envVarSourceReference for a valueFrom read from an unstructured spec
+func specEnvVarSourceReference(valueFrom map[string]interface{}) string {
+	for _, kind := range []string{"secretKeyRef", "configMapKeyRef"} {
+		if ref, ok := valueFrom[kind].(map[string]interface{}); ok {
+			return fmt.Sprintf("%s:%v/%v", kind, ref["name"], ref["key"])
+		}
+	}
+	if ref, ok := valueFrom["fieldRef"].(map[string]interface{}); ok {
+		return fmt.Sprintf("fieldRef:%v", ref["fieldPath"])
+	}
+	if ref, ok := valueFrom["resourceFieldRef"].(map[string]interface{}); ok {
+		return fmt.Sprintf("resourceFieldRef:%v", ref["resource"])
+	}
+	return ""
+}
+
+func resourceListStrings(resources corev1.ResourceList) map[string]string {
+	if len(resources) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(resources))
+	for name, quantity := range resources {
+		result[string(name)] = quantity.String()
+	}
+	return result
+}
+
+func specResourceStrings(value interface{}) map[string]string {
+	resources, ok := value.(map[string]interface{})
+	if !ok || len(resources) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(resources))
+	for name, quantity := range resources {
+		result[name] = fmt.Sprint(quantity)
+	}
+	return result
+}
+
+// summarizeRuntimeResources fills in the CPU, memory and GPU amounts, preferring limits
+func summarizeRuntimeResources(resources *RuntimeResources) {
+	amount := func(name string) string {
+		if value, ok := resources.Limits[name]; ok {
+			return value
+		}
+		return resources.Requests[name]
+	}
+	resources.CPU = amount("cpu")
+	resources.Memory = amount("memory")
+
+	for _, name := range gpuResourceNames {
+		if value := amount(name); value != "" {
+			resources.GPU = value
+			break
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// knownModelTask describes a model task the UI can offer. Whether a task is served as an
+// OpenAI-compatible model is not stored here: it comes from the configured task detection rules,
+// so GET /api/model-tasks and publish-time detection always agree.
+type knownModelTask struct {
+	name        string
+	description string
+	aliases     []string // Spellings used by runtimes, such as the KServe HuggingFace server
+	frameworks  []string
+	openAIPath  string // OpenAI API path served when the task maps to openai
+}
+
+// knownModelTasks lists the tasks reported by GET /api/model-tasks, in display order
+var knownModelTasks = []knownModelTask{
+	{"text-generation", "Generate text from a prompt, including chat", []string{"text_generation"}, []string{"huggingface", "pytorch"}, "/v1/chat/completions"},
+	{"text2text-generation", "Generate text from an input text, such as summaries or translations", []string{"text2text_generation"}, []string{"huggingface", "pytorch"}, "/v1/completions"},
+	{"conversational", "Multi-turn chat", nil, []string{"huggingface"}, "/v1/chat/completions"},
+	{"feature-extraction", "Produce embeddings for text", []string{"text_embedding", "text-embedding", "embeddings"}, []string{"huggingface", "pytorch"}, "/v1/embeddings"},
+	{"fill-mask", "Predict masked tokens in a text", []string{"fill_mask"}, []string{"huggingface", "pytorch"}, ""},
+	{"text-classification", "Assign labels to a text", []string{"sequence_classification", "sequence-classification"}, []string{"huggingface", "pytorch", "tensorflow"}, ""},
+	{"token-classification", "Label each token of a text, such as named entities", []string{"token_classification"}, []string{"huggingface", "pytorch"}, ""},
+	{"tabular-classification", "Predict a class from tabular features", nil, []string{"sklearn", "xgboost", "lightgbm"}, ""},
+	{"tabular-regression", "Predict a value from tabular features", nil, []string{"sklearn", "xgboost", "lightgbm"}, ""},
+	{"image-classification", "Assign labels to an image", nil, []string{"tensorflow", "pytorch", "onnx"}, ""},
+}
+
+// canonicalModelTask maps a task as written in a spec to its name in knownModelTasks.
+// Unknown tasks are returned lowercased with underscores turned into dashes.
+func canonicalModelTask(task string) string {
+	task = strings.ToLower(strings.TrimSpace(task))
+	for _, known := range knownModelTasks {
+		if task == known.name {
+			return known.name
+		}
+		for _, alias := range known.aliases {
+			if task == alias {
+				return known.name
+			}
+		}
+	}
+	return strings.ReplaceAll(task, "_", "-")
+}
+
+// modelTypeForTask returns "openai" when the task matches a configured detection rule, and
+// "traditional" otherwise, with the matching rule
+func modelTypeForTask(task string, rules ModelTypeDetectionRules) (string, string) {
+	if match := firstSubstringMatch(canonicalModelTask(task), rules.Tasks); match != "" {
+		return "openai", match
+	}
+	return "traditional", ""
+}
+
+// GetModelTasks handles GET /api/model-tasks
+func (s *ModelService) GetModelTasks(c *gin.Context) {
+	tasks := make([]ModelTask, 0, len(knownModelTasks))
+	for _, known := range knownModelTasks {
+		modelType, _ := modelTypeForTask(known.name, ActiveConfig().ModelTypeDetection)
+		task := ModelTask{
+			Name:        known.name,
+			Description: known.description,
+			Aliases:     known.aliases,
+			Frameworks:  known.frameworks,
+			ModelType:   modelType,
+		}
+
+		// Recommended limits mirror the publishing examples; LLM endpoints also get a token budget
+		task.RecommendedPublishConfig = RecommendedPublishConfig{
+			ModelType: modelType,
+			RateLimiting: RateLimitConfig{
+				RequestsPerMinute: 100,
+				RequestsPerHour:   5000,
+			},
+			Endpoint: "/v1/models/{name}:predict",
+		}
+		if modelType == "openai" {
+			task.RecommendedPublishConfig.RateLimiting = RateLimitConfig{
+				RequestsPerMinute: 60,
+				RequestsPerHour:   1000,
+				TokensPerHour:     100000,
+			}
+			task.RecommendedPublishConfig.Endpoint = known.openAIPath
+			if task.RecommendedPublishConfig.Endpoint == "" {
+				task.RecommendedPublishConfig.Endpoint = "/v1/completions"
+			}
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	c.JSON(http.StatusOK, ModelTasksResponse{Tasks: tasks})
+}
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Storage URI schemes understood by the KServe storage initializer
+var supportedStorageSchemes = []string{"s3://", "gs://", "pvc://", "hf://", "oci://", "http://", "https://"}
+
+// Scaling metrics accepted by the KServe autoscaler
+var supportedScaleMetrics = map[string]bool{
+	"concurrency": true,
+	"rps":         true,
+	"cpu":         true,
+	"memory":      true,
+}
+
+// ValidateModel handles POST /api/models/validate
+// The body is either a ModelRequest or an InferenceService manifest (YAML or JSON). All checks
+// run without creating anything and every problem is reported at once.
+func (s *ModelService) ValidateModel(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Failed to read request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	document, err := ParseManifest(body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	var result ModelValidationResponse
+	if _, isManifest := document["kind"]; isManifest {
+		result = s.validateModelManifest(u, document)
+	} else {
+		// Round-trip through JSON so YAML bodies bind to the request struct too
+		var req ModelRequest
+		raw, _ := json.Marshal(document)
+		if err := json.Unmarshal(raw, &req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request format",
+				Details: err.Error(),
+			})
+			return
+		}
+		result = s.validateModelRequest(u, req)
+	}
+
+	result.Valid = len(result.Errors) == 0
+	if result.Errors == nil {
+		result.Errors = []ValidationError{}
+	}
+	if result.Warnings == nil {
+		result.Warnings = []ValidationError{}
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// validateModelRequest runs the CreateModel checks against a ModelRequest
+func (s *ModelService) validateModelRequest(u *User, req ModelRequest) ModelValidationResponse {
+	result := ModelValidationResponse{
+		Source:    "request",
+		Name:      req.Name,
+		Framework: req.Framework,
+	}
+
+	result.Errors = append(result.Errors, validateModelName(req.Name)...)
+	result.Namespace, result.Errors = s.validateModelNamespace(u, req.Namespace, result.Errors)
+	result.Errors = append(result.Errors, s.validateModelFramework(req.Framework)...)
+	result.Errors = append(result.Errors, validateStorageURI("storageUri", req.StorageUri)...)
+
+	config := ModelConfig{MinReplicas: 1, MaxReplicas: 3, ScaleTarget: 60, ScaleMetric: "concurrency"}
+	if req.MinReplicas != nil {
+		config.MinReplicas = *req.MinReplicas
+	}
+	if req.MaxReplicas != nil {
+		config.MaxReplicas = *req.MaxReplicas
+	}
+	if req.ScaleTarget != nil {
+		config.ScaleTarget = *req.ScaleTarget
+	}
+	if req.ScaleMetric != "" {
+		config.ScaleMetric = req.ScaleMetric
+	} else if req.DeploymentMode == DeploymentModeRaw {
+		config.ScaleMetric = "cpu"
+	}
+	result.Errors = append(result.Errors, validateScaling(config)...)
+	if config.MinReplicas == 0 && req.DeploymentMode != DeploymentModeRaw {
+		result.Warnings = append(result.Warnings, ValidationError{
+			Field:   "minReplicas",
+			Value:   config.MinReplicas,
+			Message: "Model scales to zero, the first prediction after idle time will hit a cold start",
+		})
+	}
+
+	config.ContainerConcurrency = req.ContainerConcurrency
+	config.TargetBurstCapacity = req.TargetBurstCapacity
+	if err := ValidateConcurrencySettings(config); err != nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "concurrency",
+			Message: err.Error(),
+		})
+	}
+
+	config.DeploymentMode = req.DeploymentMode
+	if err := ValidateDeploymentMode(config); err != nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "deploymentMode",
+			Value:   req.DeploymentMode,
+			Message: err.Error(),
+		})
+	}
+
+	config.ReadinessProbe = req.ReadinessProbe
+	config.LivenessProbe = req.LivenessProbe
+	if err := ValidateProbes(config); err != nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "probes",
+			Message: err.Error(),
+		})
+	}
+
+	containers := append(append([]ContainerSpec{}, req.InitContainers...), req.Sidecars...)
+	if err := ValidateContainerSpecs(containers, ActiveConfig().AllowedImageRegistries); err != nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "containers",
+			Message: err.Error(),
+		})
+	}
+
+	result.Warnings = append(result.Warnings, s.existingModelWarnings(result.Namespace, req.Name)...)
+	return result
+}
+
+// validateModelManifest runs the ImportModel checks against an InferenceService manifest,
+// adding storage URI and resource quantity checks
+func (s *ModelService) validateModelManifest(u *User, manifest map[string]interface{}) ModelValidationResponse {
+	result := ModelValidationResponse{Source: "manifest"}
+
+	kind, _ := manifest["kind"].(string)
+	apiVersion, _ := manifest["apiVersion"].(string)
+	if kind != "InferenceService" || !strings.HasPrefix(apiVersion, "serving.kserve.io/") {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "kind",
+			Value:   fmt.Sprintf("%s %s", apiVersion, kind),
+			Message: "Expected a serving.kserve.io InferenceService",
+		})
+	}
+
+	metadata, _ := manifest["metadata"].(map[string]interface{})
+	if metadata == nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "metadata",
+			Message: "Manifest is missing metadata",
+		})
+		metadata = map[string]interface{}{}
+	}
+	result.Name, _ = metadata["name"].(string)
+	result.Errors = append(result.Errors, validateModelName(result.Name)...)
+	manifestNamespace, _ := metadata["namespace"].(string)
+	result.Namespace, result.Errors = s.validateModelNamespace(u, manifestNamespace, result.Errors)
+
+	result.Framework = ManifestFramework(manifest, ActiveConfig().SupportedFrameworks)
+	if result.Framework == "" {
+		result.Warnings = append(result.Warnings, ValidationError{
+			Field:   "spec.predictor",
+			Message: "Framework could not be detected, the predictor is treated as a custom container",
+		})
+	} else {
+		result.Errors = append(result.Errors, s.validateModelFramework(result.Framework)...)
+	}
+
+	spec, _ := manifest["spec"].(map[string]interface{})
+	predictor, _ := spec["predictor"].(map[string]interface{})
+	if predictor == nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "spec.predictor",
+			Message: "Manifest is missing spec.predictor",
+		})
+		return result
+	}
+
+	// The storage URI and resources live in the framework block (legacy) or predictor.model
+	for key, value := range predictor {
+		block, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if storageURI, ok := block["storageUri"].(string); ok {
+			result.Errors = append(result.Errors, validateStorageURI("spec.predictor."+key+".storageUri", storageURI)...)
+		}
+		result.Errors = append(result.Errors, validateResourceQuantities("spec.predictor."+key+".resources", block["resources"])...)
+	}
+	for _, listKey := range []string{"containers", "initContainers"} {
+		list, _ := predictor[listKey].([]interface{})
+		for i, item := range list {
+			if container, ok := item.(map[string]interface{}); ok {
+				field := fmt.Sprintf("spec.predictor.%s[%d].resources", listKey, i)
+				result.Errors = append(result.Errors, validateResourceQuantities(field, container["resources"])...)
+			}
+		}
+	}
+	if err := ValidateContainerSpecs(manifestContainerSpecs(manifest), ActiveConfig().AllowedImageRegistries); err != nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "spec.predictor.containers",
+			Message: err.Error(),
+		})
+	}
+
+	result.Warnings = append(result.Warnings, s.existingModelWarnings(result.Namespace, result.Name)...)
+	return result
+}
+
+// validateModelName checks a model name is a valid DNS-1123 label
+func validateModelName(name string) []ValidationError {
+	if name == "" {
+		return []ValidationError{{Field: "name", Value: name, Message: "Name is required"}}
+	}
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return []ValidationError{{Field: "name", Value: name, Message: strings.Join(errs, "; ")}}
+	}
+	return nil
+}
+
+// validateModelNamespace resolves the target namespace, which only admins may set outside their tenant
+func (s *ModelService) validateModelNamespace(u *User, namespace string, errors []ValidationError) (string, []ValidationError) {
+	if namespace == "" || namespace == u.Tenant {
+		return u.Tenant, errors
+	}
+	if !u.IsAdmin {
+		return u.Tenant, append(errors, ValidationError{
+			Field:   "namespace",
+			Value:   namespace,
+			Message: "Namespace is outside your tenant",
+		})
+	}
+	return namespace, errors
+}
+
+// validateModelFramework checks the framework is one of the supported frameworks
+func (s *ModelService) validateModelFramework(framework string) []ValidationError {
+	if framework == "" {
+		return []ValidationError{{Field: "framework", Value: framework, Message: "Framework is required"}}
+	}
+	serviceConfig := ActiveConfig()
+	if !serviceConfig.IsValidFramework(framework) {
+		supportedFrameworks := make([]string, len(serviceConfig.SupportedFrameworks))
+		for i, fw := range serviceConfig.SupportedFrameworks {
+			supportedFrameworks[i] = fw.Name
+		}
+		return []ValidationError{{
+			Field:   "framework",
+			Value:   framework,
+			Message: fmt.Sprintf("Unsupported framework. Supported: %s", strings.Join(supportedFrameworks, ", ")),
+		}}
+	}
+	return nil
+}
+
+// validateStorageURI checks a storage URI uses a scheme the storage initializer can fetch
+func validateStorageURI(field, storageURI string) []ValidationError {
+	if storageURI == "" {
+		return []ValidationError{{Field: field, Value: storageURI, Message: "Storage URI is required"}}
+	}
+	for _, scheme := range supportedStorageSchemes {
+		if strings.HasPrefix(storageURI, scheme) && len(storageURI) > len(scheme) {
+			return nil
+		}
+	}
+	return []ValidationError{{
+		Field:   field,
+		Value:   storageURI,
+		Message: fmt.Sprintf("Unsupported storage URI. Supported schemes: %s", strings.Join(supportedStorageSchemes, ", ")),
+	}}
+}
+
+// validateScaling checks replica bounds and the autoscaling target
+func validateScaling(config ModelConfig) []ValidationError {
+	var errors []ValidationError
+	if config.MinReplicas < 0 {
+		errors = append(errors, ValidationError{Field: "minReplicas", Value: config.MinReplicas, Message: "Min replicas cannot be negative"})
+	}
+	if config.MaxReplicas < 1 || config.MaxReplicas < config.MinReplicas {
+		errors = append(errors, ValidationError{Field: "maxReplicas", Value: config.MaxReplicas, Message: "Max replicas must be at least 1 and not below min replicas"})
+	}
+	if config.ScaleTarget <= 0 {
+		errors = append(errors, ValidationError{Field: "scaleTarget", Value: config.ScaleTarget, Message: "Scale target must be greater than 0"})
+	}
+	if !supportedScaleMetrics[config.ScaleMetric] {
+		errors = append(errors, ValidationError{Field: "scaleMetric", Value: config.ScaleMetric, Message: "Scale metric must be concurrency, rps, cpu or memory"})
+	}
+	return errors
+}
+
+// validateResourceQuantities checks every request and limit parses as a Kubernetes quantity
+func validateResourceQuantities(field string, value interface{}) []ValidationError {
+	resources, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var errors []ValidationError
+	for _, section := range []string{"requests", "limits"} {
+		quantities, _ := resources[section].(map[string]interface{})
+		for name, quantity := range quantities {
+			if _, err := resource.ParseQuantity(fmt.Sprint(quantity)); err != nil {
+				errors = append(errors, ValidationError{
+					Field:   fmt.Sprintf("%s.%s.%s", field, section, name),
+					Value:   quantity,
+					Message: "Invalid resource quantity",
+				})
+			}
+		}
+	}
+	return errors
+}
+
+// existingModelWarnings warns when creating the model would collide with an existing one
+func (s *ModelService) existingModelWarnings(namespace, modelName string) []ValidationError {
+	if modelName == "" {
+		return nil
+	}
+	if _, err := s.k8sClient.GetInferenceService(namespace, modelName); err == nil {
+		return []ValidationError{{
+			Field:   "name",
+			Value:   modelName,
+			Message: fmt.Sprintf("Model already exists in %s, creating or importing it will fail", namespace),
+		}}
+	}
+	return nil
+}
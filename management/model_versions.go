@@ -0,0 +1,208 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// recordModelVersion stores the configuration a model was just created or updated with as a new
+// version and prunes the oldest versions beyond MaxModelVersions. Failures are logged only, since
+// the model change itself has already been applied.
+func (s *ModelService) recordModelVersion(namespace, modelName, createdBy string, config ModelConfig) {
+	versions, err := s.loadModelVersions(namespace, modelName)
+	if err != nil {
+		log.Printf("Failed to list versions of model %s/%s: %v", namespace, modelName, err)
+		return
+	}
+
+	nextID := 1
+	if len(versions) > 0 {
+		nextID = versions[len(versions)-1].ID + 1
+	}
+
+	version := map[string]interface{}{
+		"id":        nextID,
+		"createdAt": time.Now().Format(time.RFC3339),
+		"createdBy": createdBy,
+		"config":    config,
+	}
+	if err := s.k8sClient.CreateModelVersion(namespace, modelName, nextID, version); err != nil {
+		log.Printf("Failed to record version %d of model %s/%s: %v", nextID, namespace, modelName, err)
+		return
+	}
+
+	// The new version is the active one, so only older versions are pruned
+	maxVersions := ActiveConfig().MaxModelVersions
+	if maxVersions <= 0 {
+		return
+	}
+	excess := len(versions) + 1 - maxVersions
+	for i := 0; i < excess && i < len(versions); i++ {
+		if err := s.k8sClient.DeleteModelVersion(namespace, modelName, versions[i].ID); err != nil {
+			log.Printf("Failed to prune version %d of model %s/%s: %v", versions[i].ID, namespace, modelName, err)
+		}
+	}
+}
+
+// loadModelVersions returns the stored versions of a model ordered from oldest to newest.
+// The newest version is the one the model is currently running.
+func (s *ModelService) loadModelVersions(namespace, modelName string) ([]ModelVersion, error) {
+	configMaps, err := s.k8sClient.ListModelVersions(namespace, modelName)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]ModelVersion, 0, len(configMaps))
+	for _, cm := range configMaps {
+		var version ModelVersion
+		if err := json.Unmarshal([]byte(cm.Data["version.json"]), &version); err != nil || version.ID <= 0 {
+			log.Printf("Skipping unreadable model version %s/%s", cm.Namespace, cm.Name)
+			continue
+		}
+		versions = append(versions, version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].ID < versions[j].ID
+	})
+	if len(versions) > 0 {
+		versions[len(versions)-1].Active = true
+	}
+
+	return versions, nil
+}
+
+// cleanupModelVersions deletes every stored version of a model
+func (s *ModelService) cleanupModelVersions(namespace, modelName string) {
+	versions, err := s.loadModelVersions(namespace, modelName)
+	if err != nil {
+		log.Printf("Failed to list versions of model %s/%s: %v", namespace, modelName, err)
+		return
+	}
+
+	for _, version := range versions {
+		if err := s.k8sClient.DeleteModelVersion(namespace, modelName, version.ID); err != nil {
+			log.Printf("Failed to cleanup version %d of model %s/%s: %v", version.ID, namespace, modelName, err)
+		}
+	}
+}
+
+// ListModelVersions handles GET /api/models/:modelName/versions
+func (s *ModelService) ListModelVersions(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	modelName := c.Param("modelName")
+	tenant := u.Tenant
+
+	versions, err := s.loadModelVersions(tenant, modelName)
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to list model versions",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ModelVersionsResponse{
+		ModelName:   modelName,
+		Namespace:   tenant,
+		MaxVersions: ActiveConfig().MaxModelVersions,
+		Versions:    versions,
+	})
+}
+
+// DeleteModelVersion handles DELETE /api/models/:modelName/versions/:id
+func (s *ModelService) DeleteModelVersion(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	modelName := c.Param("modelName")
+	tenant := u.Tenant
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Version id must be a positive integer",
+		})
+		return
+	}
+
+	versions, err := s.loadModelVersions(tenant, modelName)
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to list model versions",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	var found *ModelVersion
+	for i := range versions {
+		if versions[i].ID == id {
+			found = &versions[i]
+			break
+		}
+	}
+	if found == nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: fmt.Sprintf("Version %d of model %s not found", id, modelName),
+		})
+		return
+	}
+	if found.Active {
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error:   "Cannot delete the active model version",
+			Details: "The newest version matches the running model; update the model before deleting it",
+		})
+		return
+	}
+
+	if err := s.k8sClient.DeleteModelVersion(tenant, modelName, id); err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to delete model version",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   fmt.Sprintf("Version %d of model %s deleted", id, modelName),
+		"modelName": modelName,
+		"namespace": tenant,
+		"id":        id,
+	})
+}
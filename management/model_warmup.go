@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Interval between readiness checks while waiting to warm up a new model
+const warmupPollInterval = 5 * time.Second
+
+// ModelWarmups records the outcome of the warm-up request sent after a model was created.
+// Results are kept in memory, so they are lost when the service restarts.
+type ModelWarmups struct {
+	mu      sync.Mutex
+	results map[string]*ModelWarmupStatus
+}
+
+// NewModelWarmups creates an empty warm-up record
+func NewModelWarmups() *ModelWarmups {
+	return &ModelWarmups{results: make(map[string]*ModelWarmupStatus)}
+}
+
+// Get returns a copy of the warm-up status of a model, or nil when no warm-up was requested
+func (w *ModelWarmups) Get(namespace, modelName string) *ModelWarmupStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	status, ok := w.results[namespace+"/"+modelName]
+	if !ok {
+		return nil
+	}
+	copied := *status
+	return &copied
+}
+
+func (w *ModelWarmups) set(namespace, modelName string, status ModelWarmupStatus) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.results[namespace+"/"+modelName] = &status
+}
+
+// Delete forgets the warm-up status of a model
+func (w *ModelWarmups) Delete(namespace, modelName string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.results, namespace+"/"+modelName)
+}
+
+// warmupModel waits for a newly created model to become ready and sends it payload once, so the
+// model is loaded before the first real request. It is best effort: failures are logged and
+// recorded, never surfaced to the caller that created the model.
+func (s *ModelService) warmupModel(ctx context.Context, namespace, modelName string, payload json.RawMessage) {
+	requestedAt := time.Now()
+	s.warmups.set(namespace, modelName, ModelWarmupStatus{State: "pending", RequestedAt: requestedAt})
+
+	fail := func(format string, args ...interface{}) {
+		message := fmt.Sprintf(format, args...)
+		log.Printf("Warm-up of model %s/%s failed: %s", namespace, modelName, message)
+		s.warmups.set(namespace, modelName, ModelWarmupStatus{State: "failed", RequestedAt: requestedAt, CompletedAt: time.Now(), Error: message})
+	}
+
+	timeout, err := parseDuration(ActiveConfig().ModelWarmupTimeout, time.Second, time.Hour)
+	if err != nil {
+		fail("invalid MODEL_WARMUP_TIMEOUT: %v", err)
+		return
+	}
+
+	// Wait for the InferenceService to report Ready with a URL
+	var modelURL string
+	deadline := requestedAt.Add(timeout)
+	for modelURL == "" {
+		obj, err := s.k8sClient.GetInferenceService(namespace, modelName)
+		if err != nil {
+			if IsNotFound(err) {
+				fail("model was deleted before it became ready")
+				return
+			}
+		} else if info := ConvertToModelInfo(obj); info.Ready && info.URL != "" {
+			modelURL = info.URL
+			break
+		}
+
+		if time.Now().Add(warmupPollInterval).After(deadline) {
+			fail("model did not become ready within %s", timeout)
+			return
+		}
+		select {
+		case <-time.After(warmupPollInterval):
+		case <-ctx.Done():
+			fail("service is shutting down")
+			return
+		}
+	}
+
+	requestURL := modelURL + defaultPredictPath(modelName, detectPayloadFormat(payload))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", requestURL, bytes.NewReader(payload))
+	if err != nil {
+		fail("failed to create request: %v", err)
+		return
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+	resp, err := NewProxyHTTPClient(nil, predictClientOptions).Do(httpReq)
+	if err != nil {
+		fail("request to %s failed: %v", requestURL, err)
+		return
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	latency := time.Since(start)
+
+	if resp.StatusCode >= 400 {
+		fail("model answered %d after %dms", resp.StatusCode, latency.Milliseconds())
+		return
+	}
+
+	log.Printf("Warmed up model %s/%s in %dms", namespace, modelName, latency.Milliseconds())
+	s.warmups.set(namespace, modelName, ModelWarmupStatus{
+		State:       "completed",
+		RequestedAt: requestedAt,
+		CompletedAt: time.Now(),
+		LatencyMs:   latency.Milliseconds(),
+		StatusCode:  resp.StatusCode,
+	})
+}
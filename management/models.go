@@ -4,26 +4,70 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Annotations used to pause a model while keeping its configuration
+const (
+	ModelDisabledAnnotation         = "inference-in-a-box/disabled"
+	ModelDisabledReplicasAnnotation = "inference-in-a-box/disabled-replicas" // Replica settings to restore on enable
+	KServeStopAnnotation            = "serving.kserve.io/stop"
 )
 
 type ModelService struct {
-	k8sClient *K8sClient
-	config    *Config
+	k8sClient         *K8sClient
+	publishingService *PublishingService
+	predictionCache   *PredictionCache
+	proxyLimiter      *ConcurrencyLimiter
+	requestWindows    *RequestWindowCounter
+	lifecycle         *Lifecycle // Runs warm-ups and usage writes so shutdown waits for them
+
+	metricsMu    sync.Mutex
+	metricsCache map[string]cachedModelMetrics
+
+	warmups *ModelWarmups
 }
 
-func NewModelService(k8sClient *K8sClient) *ModelService {
+// cachedModelMetrics holds a recent metrics scrape so repeated console refreshes do not hit the pod
+type cachedModelMetrics struct {
+	body      []byte
+	fetchedAt time.Time
+}
+
+// How long a model metrics scrape is reused
+const modelMetricsCacheTTL = 15 * time.Second
+
+func NewModelService(k8sClient *K8sClient, publishingService *PublishingService, lifecycle *Lifecycle) *ModelService {
+	config := ActiveConfig()
+	queueTimeout, err := parseDuration(config.PredictQueueTimeout, 0, time.Minute)
+	if err != nil {
+		log.Printf("Invalid PREDICT_QUEUE_TIMEOUT: %v, using 5s", err)
+		queueTimeout = 5 * time.Second
+	}
 	return &ModelService{
-		k8sClient: k8sClient,
-		config:    NewConfig(),
+		k8sClient:         k8sClient,
+		publishingService: publishingService,
+		lifecycle:         lifecycle,
+		predictionCache:   NewPredictionCache(config),
+		proxyLimiter:      NewConcurrencyLimiter(config.PredictMaxConcurrencyPerModel, config.PredictMaxConcurrencyPerTenant, config.PredictMaxQueuedPerModel, queueTimeout),
+		requestWindows:    NewRequestWindowCounter(time.Minute),
+		metricsCache:      make(map[string]cachedModelMetrics),
+		warmups:           NewModelWarmups(),
 	}
 }
 
@@ -100,12 +144,12 @@ func (s *ModelService) GetModel(c *gin.Context) {
 	// Get inference service from Kubernetes
 	obj, err := s.k8sClient.GetInferenceService(tenant, modelName)
 	if err != nil {
-		if IsResourceNotFoundError(err) {
+		if IsNotFound(err) {
 			c.JSON(http.StatusNotFound, ErrorResponse{
 				Error: "Model not found",
 			})
 		} else {
-			c.JSON(http.StatusInternalServerError, ErrorResponse{
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
 				Error:   "Failed to get model",
 				Details: err.Error(),
 			})
@@ -115,9 +159,110 @@ func (s *ModelService) GetModel(c *gin.Context) {
 
 	// Convert to ModelInfo
 	modelInfo := ConvertToModelInfo(obj)
+	s.populateReplicaStatus(&modelInfo)
+	pods := s.predictorPods(modelInfo)
+	populateStorageInitializerStatus(&modelInfo, pods)
+	modelInfo.Runtime = detectRuntimeInfo(modelInfo, pods)
+	modelInfo.Warmup = s.warmups.Get(tenant, modelName)
+	modelInfo.ProxyLoad = s.proxyLimiter.Load(tenant, modelName)
 	c.JSON(http.StatusOK, modelInfo)
 }
 
+// predictorPods returns the pods of a model. Failures are logged and return no pods, since the
+// rest of the model info is still useful.
+func (s *ModelService) predictorPods(modelInfo ModelInfo) []corev1.Pod {
+	selector := fmt.Sprintf("serving.kserve.io/inferenceservice=%s", modelInfo.Name)
+	pods, err := s.k8sClient.GetPodsWithSelector(modelInfo.Namespace, selector)
+	if err != nil {
+		log.Printf("Failed to read predictor pods of %s/%s: %v", modelInfo.Namespace, modelInfo.Name, err)
+		return nil
+	}
+	return pods
+}
+
+// populateStorageInitializerStatus reports the storage-initializer state of each predictor pod, so a
+// model stuck downloading shows as such rather than just not ready. Models without the init
+// container, such as those served from a PVC, report nothing.
+func populateStorageInitializerStatus(modelInfo *ModelInfo, pods []corev1.Pod) {
+	for _, pod := range pods {
+		for _, containerStatus := range pod.Status.InitContainerStatuses {
+			if containerStatus.Name != storageInitializerContainer {
+				continue
+			}
+			status := StorageInitializerStatus{
+				Pod:      pod.Name,
+				State:    "waiting",
+				Restarts: containerStatus.RestartCount,
+			}
+			switch {
+			case containerStatus.State.Terminated != nil:
+				terminated := containerStatus.State.Terminated
+				status.State = "completed"
+				if terminated.ExitCode != 0 {
+					status.State = "failed"
+				}
+				status.Reason = terminated.Reason
+				status.Message = terminated.Message
+				status.ExitCode = terminated.ExitCode
+				startedAt := terminated.StartedAt.Time
+				finishedAt := terminated.FinishedAt.Time
+				status.StartedAt = &startedAt
+				status.FinishedAt = &finishedAt
+			case containerStatus.State.Running != nil:
+				status.State = "running"
+				startedAt := containerStatus.State.Running.StartedAt.Time
+				status.StartedAt = &startedAt
+			case containerStatus.State.Waiting != nil:
+				status.Reason = containerStatus.State.Waiting.Reason
+				status.Message = containerStatus.State.Waiting.Message
+				// A crash-looping download shows as waiting; the last attempt's result explains why
+				if lastTerminated := containerStatus.LastTerminationState.Terminated; lastTerminated != nil && lastTerminated.ExitCode != 0 {
+					status.State = "failed"
+					status.ExitCode = lastTerminated.ExitCode
+					if status.Message == "" {
+						status.Message = lastTerminated.Message
+					}
+				}
+			}
+			modelInfo.StatusDetails.StorageInitializer = append(modelInfo.StatusDetails.StorageInitializer, status)
+		}
+	}
+}
+
+// populateReplicaStatus fills in live replica counts from the predictor Deployments. Serverless
+// models have one Deployment per Knative revision, so counts are summed across revisions while
+// they scale over. Failures leave the counts zeroed since the rest of the model info is still useful.
+func (s *ModelService) populateReplicaStatus(modelInfo *ModelInfo) {
+	selector := fmt.Sprintf("serving.kserve.io/inferenceservice=%s,component=predictor", modelInfo.Name)
+	deployments, err := s.k8sClient.GetDeploymentsWithSelector(modelInfo.Namespace, selector)
+	if err != nil {
+		log.Printf("Failed to read predictor deployments of %s/%s: %v", modelInfo.Namespace, modelInfo.Name, err)
+		return
+	}
+
+	replicas := ModelReplicas{}
+	for _, deployment := range deployments {
+		if deployment.Spec.Replicas != nil {
+			replicas.Desired += int(*deployment.Spec.Replicas)
+		}
+		replicas.Ready += int(deployment.Status.ReadyReplicas)
+		replicas.Total += int(deployment.Status.Replicas)
+	}
+
+	switch {
+	case replicas.Desired == 0 && replicas.Total == 0:
+		replicas.Scaling = "idle"
+	case replicas.Desired > replicas.Ready:
+		replicas.Scaling = "up"
+	case replicas.Total > replicas.Desired:
+		replicas.Scaling = "down"
+	default:
+		replicas.Scaling = "stable"
+	}
+
+	modelInfo.StatusDetails.Replicas = replicas
+}
+
 // CreateModel handles POST /api/models
 func (s *ModelService) CreateModel(c *gin.Context) {
 	user, exists := c.Get("user")
@@ -154,9 +299,10 @@ func (s *ModelService) CreateModel(c *gin.Context) {
 	}
 
 	// Validate framework
-	if !s.config.IsValidFramework(req.Framework) {
-		supportedFrameworks := make([]string, len(s.config.SupportedFrameworks))
-		for i, fw := range s.config.SupportedFrameworks {
+	serviceConfig := ActiveConfig()
+	if !serviceConfig.IsValidFramework(req.Framework) {
+		supportedFrameworks := make([]string, len(serviceConfig.SupportedFrameworks))
+		for i, fw := range serviceConfig.SupportedFrameworks {
 			supportedFrameworks[i] = fw.Name
 		}
 		c.JSON(http.StatusBadRequest, ErrorResponse{
@@ -195,6 +341,48 @@ func (s *ModelService) CreateModel(c *gin.Context) {
 	}
 	if req.ScaleMetric != "" {
 		config.ScaleMetric = req.ScaleMetric
+	} else if req.DeploymentMode == DeploymentModeRaw {
+		// The HPA behind raw deployments cannot scale on concurrency
+		config.ScaleMetric = "cpu"
+	}
+	config.DeploymentMode = req.DeploymentMode
+	config.InitContainers = req.InitContainers
+	config.Sidecars = req.Sidecars
+	config.ContainerConcurrency = req.ContainerConcurrency
+	config.TargetBurstCapacity = req.TargetBurstCapacity
+	config.ReadinessProbe = req.ReadinessProbe
+	config.LivenessProbe = req.LivenessProbe
+
+	if err := ValidateContainerSpecs(append(append([]ContainerSpec{}, config.InitContainers...), config.Sidecars...), ActiveConfig().AllowedImageRegistries); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid container configuration",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if err := ValidateConcurrencySettings(config); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid concurrency configuration",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if err := ValidateDeploymentMode(config); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid deployment mode",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if err := ValidateProbes(config); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid probe configuration",
+			Details: err.Error(),
+		})
+		return
 	}
 
 	// Generate model YAML
@@ -207,6 +395,8 @@ func (s *ModelService) CreateModel(c *gin.Context) {
 		return
 	}
 
+	setModelCreatedBy(modelSpec, createdByLabelValue(u))
+
 	// Create inference service
 	if err := s.k8sClient.CreateInferenceService(tenant, modelSpec); err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
@@ -216,6 +406,14 @@ func (s *ModelService) CreateModel(c *gin.Context) {
 		return
 	}
 
+	s.recordModelVersion(tenant, req.Name, u.Name, config)
+
+	if len(req.WarmupPayload) > 0 {
+		s.lifecycle.Go("model warm-up", func(ctx context.Context) {
+			s.warmupModel(ctx, tenant, req.Name, req.WarmupPayload)
+		})
+	}
+
 	c.JSON(http.StatusCreated, ModelResponse{
 		Message:   "Model created successfully",
 		Name:      req.Name,
@@ -224,8 +422,8 @@ func (s *ModelService) CreateModel(c *gin.Context) {
 	})
 }
 
-// UpdateModel handles PUT /api/models/:modelName
-func (s *ModelService) UpdateModel(c *gin.Context) {
+// ImportModel handles POST /api/models/import
+func (s *ModelService) ImportModel(c *gin.Context) {
 	user, exists := c.Get("user")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
@@ -242,120 +440,117 @@ func (s *ModelService) UpdateModel(c *gin.Context) {
 		return
 	}
 
-	modelName := c.Param("modelName")
-	tenant := u.Tenant
-
-	var req ModelRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	// The body is the raw InferenceService manifest (YAML or JSON)
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid request format",
+			Error:   "Failed to read manifest",
 			Details: err.Error(),
 		})
 		return
 	}
 
-	// Get existing model
-	existingObj, err := s.k8sClient.GetInferenceService(tenant, modelName)
+	manifest, err := ParseManifest(body)
 	if err != nil {
-		if IsResourceNotFoundError(err) {
-			c.JSON(http.StatusNotFound, ErrorResponse{
-				Error: "Model not found",
-			})
-		} else {
-			c.JSON(http.StatusInternalServerError, ErrorResponse{
-				Error:   "Failed to get existing model",
-				Details: err.Error(),
-			})
-		}
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid manifest",
+			Details: err.Error(),
+		})
 		return
 	}
 
-	// Extract current configuration
-	currentConfig := ModelConfig{
-		MinReplicas: 1,
-		MaxReplicas: 3,
-		ScaleTarget: 60,
-		ScaleMetric: "concurrency",
+	// Validate kind and apiVersion
+	kind, _ := manifest["kind"].(string)
+	apiVersion, _ := manifest["apiVersion"].(string)
+	if kind != "InferenceService" || !strings.HasPrefix(apiVersion, "serving.kserve.io/") {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: fmt.Sprintf("Unsupported manifest %s %s, expected serving.kserve.io InferenceService", apiVersion, kind),
+		})
+		return
 	}
 
-	// Parse existing spec
-	if spec, ok := existingObj["spec"].(map[string]interface{}); ok {
-		if predictor, ok := spec["predictor"].(map[string]interface{}); ok {
-			if minReplicas, ok := predictor["minReplicas"].(float64); ok {
-				currentConfig.MinReplicas = int(minReplicas)
-			}
-			if maxReplicas, ok := predictor["maxReplicas"].(float64); ok {
-				currentConfig.MaxReplicas = int(maxReplicas)
-			}
-			if scaleTarget, ok := predictor["scaleTarget"].(float64); ok {
-				currentConfig.ScaleTarget = int(scaleTarget)
-			}
-			if scaleMetric, ok := predictor["scaleMetric"].(string); ok {
-				currentConfig.ScaleMetric = scaleMetric
-			}
-
-			// Find the framework and storage URI
-			for _, framework := range s.config.SupportedFrameworks {
-				if frameworkConfig, ok := predictor[framework.Name].(map[string]interface{}); ok {
-					currentConfig.Framework = framework.Name
-					if storageUri, ok := frameworkConfig["storageUri"].(string); ok {
-						currentConfig.StorageUri = storageUri
-					}
-					break
-				}
-			}
-		}
+	metadata, ok := manifest["metadata"].(map[string]interface{})
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Manifest is missing metadata",
+		})
+		return
 	}
 
-	// Update with new values
-	if req.Framework != "" {
-		currentConfig.Framework = req.Framework
-	}
-	if req.StorageUri != "" {
-		currentConfig.StorageUri = req.StorageUri
-	}
-	if req.MinReplicas != nil {
-		currentConfig.MinReplicas = *req.MinReplicas
-	}
-	if req.MaxReplicas != nil {
-		currentConfig.MaxReplicas = *req.MaxReplicas
+	modelName, _ := metadata["name"].(string)
+	if errs := validation.IsDNS1123Label(modelName); len(errs) > 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid model name in manifest",
+			Details: strings.Join(errs, "; "),
+		})
+		return
 	}
-	if req.ScaleTarget != nil {
-		currentConfig.ScaleTarget = *req.ScaleTarget
+
+	// Force the namespace to the caller's tenant; only admins may target other namespaces
+	tenant := u.Tenant
+	if manifestNamespace, _ := metadata["namespace"].(string); manifestNamespace != "" && manifestNamespace != tenant {
+		if !u.IsAdmin {
+			c.JSON(http.StatusForbidden, ErrorResponse{
+				Error: "Manifest targets namespace outside your tenant: " + manifestNamespace,
+			})
+			return
+		}
+		tenant = manifestNamespace
 	}
-	if req.ScaleMetric != "" {
-		currentConfig.ScaleMetric = req.ScaleMetric
+	metadata["namespace"] = tenant
+
+	// Validate framework
+	serviceConfig := ActiveConfig()
+	framework := ManifestFramework(manifest, serviceConfig.SupportedFrameworks)
+	if framework != "" && !serviceConfig.IsValidFramework(framework) {
+		supportedFrameworks := make([]string, len(serviceConfig.SupportedFrameworks))
+		for i, fw := range serviceConfig.SupportedFrameworks {
+			supportedFrameworks[i] = fw.Name
+		}
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: fmt.Sprintf("Unsupported framework %s. Supported: %s", framework, strings.Join(supportedFrameworks, ", ")),
+		})
+		return
 	}
 
-	// Generate updated model YAML
-	modelSpec, err := GenerateModelYAML(modelName, tenant, currentConfig)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to generate model specification",
+	if err := ValidateContainerSpecs(manifestContainerSpecs(manifest), serviceConfig.AllowedImageRegistries); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid containers in manifest",
 			Details: err.Error(),
 		})
 		return
 	}
 
-	// Update inference service
-	if err := s.k8sClient.UpdateInferenceService(tenant, modelName, modelSpec); err != nil {
+	// Strip server-populated fields so the manifest can be created fresh
+	StripServerFields(manifest)
+	setModelCreatedBy(manifest, createdByLabelValue(u))
+
+	// Creation goes through kubectl apply, so refuse to overwrite an existing model
+	if _, err := s.k8sClient.GetInferenceService(tenant, modelName); err == nil {
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Model already exists: " + modelName,
+		})
+		return
+	}
+
+	if err := s.k8sClient.CreateInferenceService(tenant, manifest); err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to update model",
+			Error:   "Failed to import model",
 			Details: err.Error(),
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, ModelResponse{
-		Message:   "Model updated successfully",
-		Name:      modelName,
-		Namespace: tenant,
-		Config:    currentConfig,
+	c.JSON(http.StatusCreated, gin.H{
+		"message":   "Model imported successfully",
+		"name":      modelName,
+		"namespace": tenant,
+		"framework": framework,
 	})
 }
 
-// DeleteModel handles DELETE /api/models/:modelName
-func (s *ModelService) DeleteModel(c *gin.Context) {
+// UpdateModel handles PUT /api/models/:modelName
+func (s *ModelService) UpdateModel(c *gin.Context) {
 	user, exists := c.Get("user")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
@@ -375,30 +570,75 @@ func (s *ModelService) DeleteModel(c *gin.Context) {
 	modelName := c.Param("modelName")
 	tenant := u.Tenant
 
-	// Delete inference service
-	if err := s.k8sClient.DeleteInferenceService(tenant, modelName); err != nil {
-		if IsResourceNotFoundError(err) {
+	var req ModelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Get existing model
+	existingObj, err := s.k8sClient.GetInferenceService(tenant, modelName)
+	if err != nil {
+		if IsNotFound(err) {
 			c.JSON(http.StatusNotFound, ErrorResponse{
 				Error: "Model not found",
 			})
 		} else {
-			c.JSON(http.StatusInternalServerError, ErrorResponse{
-				Error:   "Failed to delete model",
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+				Error:   "Failed to get existing model",
 				Details: err.Error(),
 			})
 		}
 		return
 	}
 
+	currentConfig, err := s.mergeModelRequest(existingObj, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid model configuration",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Generate updated model YAML
+	modelSpec, err := GenerateModelYAML(modelName, tenant, currentConfig)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to generate model specification",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Updates go through kubectl apply, which would drop a label missing from the new spec
+	setModelCreatedBy(modelSpec, modelCreatedBy(existingObj))
+
+	// Update inference service
+	if err := s.k8sClient.UpdateInferenceService(tenant, modelName, modelSpec); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to update model",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	s.recordModelVersion(tenant, modelName, u.Name, currentConfig)
+
 	c.JSON(http.StatusOK, ModelResponse{
-		Message:   "Model deleted successfully",
+		Message:   "Model updated successfully",
 		Name:      modelName,
 		Namespace: tenant,
+		Config:    currentConfig,
 	})
 }
 
-// PredictModel handles POST /api/models/:modelName/predict
-func (s *ModelService) PredictModel(c *gin.Context) {
+// DiffModel handles POST /api/models/:modelName/diff
+// It previews the changes an update with the same body would make to the model spec.
+func (s *ModelService) DiffModel(c *gin.Context) {
 	user, exists := c.Get("user")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
@@ -416,8 +656,9 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 	}
 
 	modelName := c.Param("modelName")
+	tenant := u.Tenant
 
-	var req PredictRequest
+	var req ModelRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error:   "Invalid request format",
@@ -426,22 +667,535 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 		return
 	}
 
-	// Marshal input data
-	inputDataJSON, err := json.Marshal(req.InputData)
+	existingObj, err := s.k8sClient.GetInferenceService(tenant, modelName)
+	if err != nil {
+		if IsNotFound(err) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: "Model not found",
+			})
+		} else {
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+				Error:   "Failed to get existing model",
+				Details: err.Error(),
+			})
+		}
+		return
+	}
+
+	proposedConfig, err := s.mergeModelRequest(existingObj, req)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid input data",
+			Error:   "Invalid model configuration",
 			Details: err.Error(),
 		})
 		return
 	}
 
-	var modelUrl string
-	var fullPath string
+	proposedSpec, err := GenerateModelYAML(modelName, tenant, proposedConfig)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to generate model specification",
+			Details: err.Error(),
+		})
+		return
+	}
 
-	if req.ConnectionSettings != nil && req.ConnectionSettings.UseCustom {
-		// Use custom connection settings
-		protocol := req.ConnectionSettings.Protocol
+	changes := diffModelSpecs(existingObj, proposedSpec, ActiveConfig().SupportedFrameworks)
+
+	c.JSON(http.StatusOK, ModelDiffResponse{
+		ModelName:  modelName,
+		Namespace:  tenant,
+		HasChanges: len(changes) > 0,
+		Changes:    changes,
+	})
+}
+
+// mergeModelRequest reads the managed configuration from an existing InferenceService and
+// applies the fields set in req on top of it
+func (s *ModelService) mergeModelRequest(existingObj map[string]interface{}, req ModelRequest) (ModelConfig, error) {
+	// Extract current configuration
+	currentConfig := ModelConfig{
+		MinReplicas: 1,
+		MaxReplicas: 3,
+		ScaleTarget: 60,
+		ScaleMetric: "concurrency",
+	}
+
+	// Parse existing spec
+	if spec, ok := existingObj["spec"].(map[string]interface{}); ok {
+		if predictor, ok := spec["predictor"].(map[string]interface{}); ok {
+			if minReplicas, ok := predictor["minReplicas"].(float64); ok {
+				currentConfig.MinReplicas = int(minReplicas)
+			}
+			if maxReplicas, ok := predictor["maxReplicas"].(float64); ok {
+				currentConfig.MaxReplicas = int(maxReplicas)
+			}
+			if scaleTarget, ok := predictor["scaleTarget"].(float64); ok {
+				currentConfig.ScaleTarget = int(scaleTarget)
+			}
+			if scaleMetric, ok := predictor["scaleMetric"].(string); ok {
+				currentConfig.ScaleMetric = scaleMetric
+			}
+			if initContainers, ok := predictor["initContainers"]; ok {
+				currentConfig.InitContainers = manifestToContainerSpecs(initContainers)
+			}
+			if containers, ok := predictor["containers"]; ok {
+				currentConfig.Sidecars = manifestToContainerSpecs(containers)
+			}
+			if containerConcurrency, ok := predictor["containerConcurrency"].(float64); ok {
+				value := int(containerConcurrency)
+				currentConfig.ContainerConcurrency = &value
+			}
+
+			// Find the framework and storage URI
+			for _, framework := range ActiveConfig().SupportedFrameworks {
+				if frameworkConfig, ok := predictor[framework.Name].(map[string]interface{}); ok {
+					currentConfig.Framework = framework.Name
+					if storageUri, ok := frameworkConfig["storageUri"].(string); ok {
+						currentConfig.StorageUri = storageUri
+					}
+					currentConfig.ReadinessProbe = manifestToProbeSpec(frameworkConfig["readinessProbe"])
+					currentConfig.LivenessProbe = manifestToProbeSpec(frameworkConfig["livenessProbe"])
+					break
+				}
+			}
+		}
+	}
+
+	if metadata, ok := existingObj["metadata"].(map[string]interface{}); ok {
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			if value, ok := annotations[TargetBurstCapacityAnnotation].(string); ok {
+				if targetBurstCapacity, err := strconv.Atoi(value); err == nil {
+					currentConfig.TargetBurstCapacity = &targetBurstCapacity
+				}
+			}
+			if value, ok := annotations[DeploymentModeAnnotation].(string); ok {
+				currentConfig.DeploymentMode = deploymentModeFromAnnotation(value)
+			}
+		}
+	}
+
+	// Update with new values
+	if req.Framework != "" {
+		currentConfig.Framework = req.Framework
+	}
+	if req.StorageUri != "" {
+		currentConfig.StorageUri = req.StorageUri
+	}
+	if req.MinReplicas != nil {
+		currentConfig.MinReplicas = *req.MinReplicas
+	}
+	if req.MaxReplicas != nil {
+		currentConfig.MaxReplicas = *req.MaxReplicas
+	}
+	if req.ScaleTarget != nil {
+		currentConfig.ScaleTarget = *req.ScaleTarget
+	}
+	if req.ScaleMetric != "" {
+		currentConfig.ScaleMetric = req.ScaleMetric
+	}
+	if req.ContainerConcurrency != nil {
+		currentConfig.ContainerConcurrency = req.ContainerConcurrency
+	}
+	if req.TargetBurstCapacity != nil {
+		currentConfig.TargetBurstCapacity = req.TargetBurstCapacity
+	}
+	if req.DeploymentMode != "" {
+		currentConfig.DeploymentMode = req.DeploymentMode
+	}
+	if err := ValidateConcurrencySettings(currentConfig); err != nil {
+		return currentConfig, err
+	}
+	if err := ValidateDeploymentMode(currentConfig); err != nil {
+		return currentConfig, err
+	}
+	if req.ReadinessProbe != nil {
+		currentConfig.ReadinessProbe = req.ReadinessProbe
+	}
+	if req.LivenessProbe != nil {
+		currentConfig.LivenessProbe = req.LivenessProbe
+	}
+	if err := ValidateProbes(currentConfig); err != nil {
+		return currentConfig, err
+	}
+	if req.InitContainers != nil || req.Sidecars != nil {
+		if req.InitContainers != nil {
+			currentConfig.InitContainers = req.InitContainers
+		}
+		if req.Sidecars != nil {
+			currentConfig.Sidecars = req.Sidecars
+		}
+		if err := ValidateContainerSpecs(append(append([]ContainerSpec{}, currentConfig.InitContainers...), currentConfig.Sidecars...), ActiveConfig().AllowedImageRegistries); err != nil {
+			return currentConfig, err
+		}
+	}
+
+	return currentConfig, nil
+}
+
+// DeleteModel handles DELETE /api/models/:modelName
+func (s *ModelService) DeleteModel(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	modelName := c.Param("modelName")
+	tenant := u.Tenant
+
+	if !unpublishBeforeDelete(s.publishingService, u, tenant, modelName, c.Query("cascade") == "true") {
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error:   "Model is published, unpublish first",
+			Details: "Unpublish the model or retry with ?cascade=true to remove its published resources",
+		})
+		return
+	}
+
+	// Delete inference service
+	if err := s.k8sClient.DeleteInferenceService(tenant, modelName); err != nil {
+		if IsNotFound(err) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: "Model not found",
+			})
+		} else {
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+				Error:   "Failed to delete model",
+				Details: err.Error(),
+			})
+		}
+		return
+	}
+
+	s.cleanupModelVersions(tenant, modelName)
+	s.warmups.Delete(tenant, modelName)
+
+	c.JSON(http.StatusOK, ModelResponse{
+		Message:   "Model deleted successfully",
+		Name:      modelName,
+		Namespace: tenant,
+	})
+}
+
+// modelUnpublisher is the part of PublishingService that DeleteModel needs
+type modelUnpublisher interface {
+	isModelPublished(namespace, modelName string) bool
+	cleanupPublishedResources(namespace, modelName string)
+	logPublishingEvent(user *User, modelName, namespace, action string)
+}
+
+// unpublishBeforeDelete prepares a model for deletion. Published models keep gateway routes, API
+// keys and policies that would be left dangling, so they are only unpublished when the caller
+// explicitly asks to cascade. It returns false, changing nothing, for a published model without
+// cascade.
+func unpublishBeforeDelete(publisher modelUnpublisher, u *User, namespace, modelName string, cascade bool) bool {
+	if !publisher.isModelPublished(namespace, modelName) {
+		return true
+	}
+	if !cascade {
+		return false
+	}
+
+	publisher.cleanupPublishedResources(namespace, modelName)
+	publisher.logPublishingEvent(u, modelName, namespace, "unpublished")
+	return true
+}
+
+// DisableModel handles POST /api/models/:modelName/disable
+func (s *ModelService) DisableModel(c *gin.Context) {
+	s.setModelDisabled(c, true)
+}
+
+// EnableModel handles POST /api/models/:modelName/enable
+func (s *ModelService) EnableModel(c *gin.Context) {
+	s.setModelDisabled(c, false)
+}
+
+// setModelDisabled scales a model to zero and records its replica settings, or restores them
+func (s *ModelService) setModelDisabled(c *gin.Context, disable bool) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	modelName := c.Param("modelName")
+	tenant := u.Tenant
+
+	obj, err := s.k8sClient.GetInferenceService(tenant, modelName)
+	if err != nil {
+		if IsNotFound(err) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: "Model not found",
+			})
+		} else {
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+				Error:   "Failed to get model",
+				Details: err.Error(),
+			})
+		}
+		return
+	}
+
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	spec, _ := obj["spec"].(map[string]interface{})
+	predictor, _ := spec["predictor"].(map[string]interface{})
+	if metadata == nil || predictor == nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Model has no predictor specification",
+		})
+		return
+	}
+
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		annotations = make(map[string]interface{})
+		metadata["annotations"] = annotations
+	}
+
+	isDisabled := annotations[ModelDisabledAnnotation] == "true"
+	if isDisabled == disable {
+		state := "enabled"
+		if disable {
+			state = "disabled"
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"message":   "Model is already " + state,
+			"name":      modelName,
+			"namespace": tenant,
+			"disabled":  isDisabled,
+		})
+		return
+	}
+
+	if disable {
+		// Remember the current replica settings so enable can restore them
+		replicas := map[string]interface{}{}
+		for _, field := range []string{"minReplicas", "maxReplicas"} {
+			if value, ok := predictor[field]; ok {
+				replicas[field] = value
+			}
+		}
+		replicasJSON, err := json.Marshal(replicas)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "Failed to record replica settings",
+				Details: err.Error(),
+			})
+			return
+		}
+
+		annotations[ModelDisabledAnnotation] = "true"
+		annotations[ModelDisabledReplicasAnnotation] = string(replicasJSON)
+		annotations[KServeStopAnnotation] = "true"
+		predictor["minReplicas"] = 0
+	} else {
+		delete(predictor, "minReplicas")
+		delete(predictor, "maxReplicas")
+		if replicasJSON, ok := annotations[ModelDisabledReplicasAnnotation].(string); ok {
+			var replicas map[string]interface{}
+			if err := json.Unmarshal([]byte(replicasJSON), &replicas); err == nil {
+				for field, value := range replicas {
+					predictor[field] = value
+				}
+			}
+		}
+
+		delete(annotations, ModelDisabledAnnotation)
+		delete(annotations, ModelDisabledReplicasAnnotation)
+		delete(annotations, KServeStopAnnotation)
+	}
+
+	StripServerFields(obj)
+
+	if err := s.k8sClient.UpdateInferenceService(tenant, modelName, obj); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to update model",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	message := "Model enabled successfully"
+	if disable {
+		message = "Model disabled successfully"
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":   message,
+		"name":      modelName,
+		"namespace": tenant,
+		"disabled":  disable,
+	})
+}
+
+// Inference operations proxied to KServe model servers
+const (
+	InferenceOperationPredict = "predict"
+	InferenceOperationExplain = "explain"
+)
+
+// PredictModel handles POST /api/models/:modelName/predict
+func (s *ModelService) PredictModel(c *gin.Context) {
+	s.proxyInferenceRequest(c, InferenceOperationPredict)
+}
+
+// ExplainModel handles POST /api/models/:modelName/explain
+func (s *ModelService) ExplainModel(c *gin.Context) {
+	s.proxyInferenceRequest(c, InferenceOperationExplain)
+}
+
+// proxyInferenceRequest forwards a predict or explain request to a model
+func (s *ModelService) proxyInferenceRequest(c *gin.Context, operation string) {
+	handlerStart := time.Now()
+	includeTiming := c.Query("includeTiming") == "true"
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	modelName := c.Param("modelName")
+
+	var req PredictRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if req.DeadlineMs < 0 || req.DeadlineMs > maxPredictDeadlineMs {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: fmt.Sprintf("deadlineMs must be between 1 and %d", maxPredictDeadlineMs),
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin && req.ConnectionSettings != nil && req.ConnectionSettings.Namespace != "" {
+		namespace = req.ConnectionSettings.Namespace
+	}
+
+	// Input data is forwarded as-is so named inputs and v2 tensors keep their shape
+	inputDataJSON := []byte(req.InputData)
+	viaGateway := c.Query("via") == "gateway"
+
+	// Reshape inputs for published models whose input contract differs from what clients send.
+	// Gateway requests are left alone, since the gateway forwards bodies unchanged.
+	if !viaGateway {
+		if transform := s.requestTransform(namespace, modelName); transform != nil {
+			transformed, err := applyRequestTransform(transform, req.InputData)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResponse{
+					Error:   "Request transformation failed",
+					Details: err.Error(),
+				})
+				return
+			}
+			inputDataJSON = transformed
+			c.Header("X-Request-Transformed", "true")
+		}
+	}
+	payloadFormat := detectPayloadFormat(inputDataJSON)
+
+	var modelUrl string
+	var fullPath string
+
+	if req.ConnectionSettings != nil {
+		normalizeConnectionSettings(req.ConnectionSettings)
+		if err := s.validateConnectionSettings(u, req.ConnectionSettings); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid connection settings",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	var gatewayAPIKey string
+	var gatewayRequestsPerMinute int
+	var cacheTTL time.Duration
+
+	if viaGateway {
+		// Send the request through the public gateway path exactly as an external consumer would
+		publishedModel, err := s.publishingService.getPublishedModelMetadata(namespace, modelName)
+		if err != nil {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "Model is not published",
+				Details: err.Error(),
+			})
+			return
+		}
+
+		externalURL, err := url.Parse(publishedModel.ExternalURL)
+		if err != nil || externalURL.Host == "" {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "Invalid published model URL",
+				Details: publishedModel.ExternalURL,
+			})
+			return
+		}
+
+		modelUrl = fmt.Sprintf("%s://%s", externalURL.Scheme, externalURL.Host)
+		fullPath = externalURL.Path
+		if req.ConnectionSettings != nil && req.ConnectionSettings.Path != "" {
+			fullPath = strings.TrimSuffix(fullPath, "/") + req.ConnectionSettings.Path
+		}
+		gatewayAPIKey = publishedModel.APIKey
+		gatewayRequestsPerMinute = publishedModel.RateLimiting.RequestsPerMinute
+		cacheTTL = publishedCacheTTL(publishedModel)
+
+		// Public hostnames rarely resolve inside the cluster, so default to the gateway service address
+		if req.ConnectionSettings == nil {
+			req.ConnectionSettings = &ConnectionSettings{}
+		}
+		if len(req.ConnectionSettings.DNSResolve) == 0 {
+			if address := s.gatewayClusterIP(); address != "" {
+				port := externalURL.Port()
+				if port == "" {
+					port = "443"
+					if externalURL.Scheme == "http" {
+						port = "80"
+					}
+				}
+				req.ConnectionSettings.DNSResolve = []DNSResolve{{Host: externalURL.Hostname(), Port: port, Address: address}}
+			}
+		}
+	} else if req.ConnectionSettings != nil && req.ConnectionSettings.UseCustom {
+		// Use custom connection settings
+		protocol := req.ConnectionSettings.Protocol
 		host := req.ConnectionSettings.Host
 		port := req.ConnectionSettings.Port
 		path := req.ConnectionSettings.Path
@@ -456,7 +1210,7 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 		}
 
 		if path == "" {
-			path = fmt.Sprintf("/v1/models/%s:predict", modelName)
+			path = defaultInferencePath(operation, modelName, payloadFormat)
 		}
 
 		modelUrl = fmt.Sprintf("%s://%s%s", protocol, host, portPart)
@@ -471,12 +1225,12 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 		// Get model URL from InferenceService status
 		obj, err := s.k8sClient.GetInferenceService(tenant, modelName)
 		if err != nil {
-			if IsResourceNotFoundError(err) {
+			if IsNotFound(err) {
 				c.JSON(http.StatusNotFound, ErrorResponse{
 					Error: "Model not found",
 				})
 			} else {
-				c.JSON(http.StatusInternalServerError, ErrorResponse{
+				c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
 					Error:   "Failed to get model",
 					Details: err.Error(),
 				})
@@ -484,6 +1238,14 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 			return
 		}
 
+		if operation == InferenceOperationExplain && !hasExplainer(obj) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Model has no explainer",
+				Details: fmt.Sprintf("InferenceService %s does not define spec.explainer", modelName),
+			})
+			return
+		}
+
 		// Extract model URL from status
 		if status, ok := obj["status"].(map[string]interface{}); ok {
 			if url, ok := status["url"].(string); ok {
@@ -491,6 +1253,16 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 			}
 		}
 
+		// During a rollout, avoid sending traffic to a predictor revision that is not ready yet
+		if revision := selectPredictorRevision(tenant, obj); operation == InferenceOperationPredict && revision.Name != "" {
+			if revision.URL != "" {
+				log.Printf("[REQ-%s] Latest revision of %s/%s is not ready, using ready revision %s", c.GetString("request_id"), tenant, modelName, revision.Name)
+				modelUrl = revision.URL
+			} else {
+				log.Printf("[REQ-%s] Using revision %s for %s/%s", c.GetString("request_id"), revision.Name, tenant, modelName)
+			}
+		}
+
 		if modelUrl == "" {
 			c.JSON(http.StatusNotFound, ErrorResponse{
 				Error: "Model not ready or not found",
@@ -498,8 +1270,35 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 			return
 		}
 
-		fullPath = fmt.Sprintf("/v1/models/%s:predict", modelName)
+		// An explicit port overrides the one in the status URL, e.g. to reach a second serving port
+		if req.ConnectionSettings != nil && req.ConnectionSettings.Port != "" {
+			parsedURL, err := url.Parse(modelUrl)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, ErrorResponse{
+					Error:   "Invalid model URL",
+					Details: err.Error(),
+				})
+				return
+			}
+			parsedURL.Host = net.JoinHostPort(parsedURL.Hostname(), req.ConnectionSettings.Port)
+			modelUrl = parsedURL.String()
+		}
+
+		fullPath = defaultInferencePath(operation, modelName, payloadFormat)
+	}
+
+	// Bound in-flight proxy requests so one tenant or model cannot starve the others. Slots are
+	// taken only once the model is resolved, so unknown model names are not tracked.
+	release, ok := s.proxyLimiter.Acquire(c.Request.Context(), namespace, modelName)
+	if !ok {
+		c.Header("Retry-After", "1")
+		c.JSON(http.StatusTooManyRequests, ErrorResponse{
+			Error:   "Too many concurrent requests",
+			Details: fmt.Sprintf("In-flight request limit reached for %s/%s", namespace, modelName),
+		})
+		return
 	}
+	defer release()
 
 	// Build full URL
 	requestURL := modelUrl + fullPath
@@ -531,11 +1330,68 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 		}
 	}
 
+	// An explicit key takes precedence so specific (expired or revoked) keys can be verified end-to-end
+	if req.ConnectionSettings != nil && req.ConnectionSettings.APIKey != "" {
+		httpReq.Header.Set("X-API-Key", req.ConnectionSettings.APIKey)
+	} else if viaGateway && httpReq.Header.Get("X-API-Key") == "" {
+		httpReq.Header.Set("X-API-Key", gatewayAPIKey)
+	}
+
+	// Serve repeated inputs from the prediction cache when the published model opts in
+	var cacheKey string
+	if !viaGateway {
+		cacheTTL = s.predictionCacheTTL(namespace, modelName)
+	}
+	if cacheTTL > 0 {
+		var dnsResolve []DNSResolve
+		if req.ConnectionSettings != nil {
+			dnsResolve = req.ConnectionSettings.DNSResolve
+		}
+		cacheKey = predictionCacheKey(namespace, modelName, requestURL+"|"+httpReq.Host, httpReq.Header.Get("X-API-Key"), dnsResolve, inputDataJSON)
+		if c.Query("nocache") != "true" {
+			if cached, ok := s.predictionCache.Get(cacheKey); ok {
+				c.Header("X-Cache", "HIT")
+				if includeTiming {
+					c.JSON(http.StatusOK, TimedPredictionResponse{
+						Prediction: json.RawMessage(cached),
+						Timing:     newPredictionTiming(handlerStart, time.Time{}, nil, 0),
+					})
+					return
+				}
+				c.Data(http.StatusOK, "application/json; charset=utf-8", cached)
+				return
+			}
+		}
+		c.Header("X-Cache", "MISS")
+	}
+
 	// Create HTTP client with custom DNS resolution if needed
-	client := s.createHTTPClient(req.ConnectionSettings)
+	clientOptions := proxyClientOptionsFor(predictClientOptions, req.ConnectionSettings, u, httpReq.URL.Host)
+	if !u.IsAdmin && req.ConnectionSettings != nil && req.ConnectionSettings.UseCustom {
+		// Custom targets were checked by hostname; the dial check pins them to tenant addresses
+		clientOptions = s.tenantDialOptions(clientOptions, u.Tenant)
+	}
+	client := NewProxyHTTPClient(req.ConnectionSettings, clientOptions)
+
+	// A caller-supplied deadline cancels the upstream call, including cold-start and throttle retries
+	ctx := c.Request.Context()
+	if req.DeadlineMs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(req.DeadlineMs)*time.Millisecond)
+		defer cancel()
+		httpReq = httpReq.WithContext(ctx)
+	}
 
-	// Execute HTTP request
-	resp, err := client.Do(httpReq)
+	// Execute HTTP request, optionally waiting out upstream throttling
+	requestStart := time.Now()
+	resp, coldStart, err := s.doPredictRequest(ctx, client, httpReq, c.Query("waitOnThrottle") == "true")
+	if req.DeadlineMs > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		abortPredictDeadline(c, req.DeadlineMs, requestStart)
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "Failed to make prediction request",
@@ -545,9 +1401,17 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 	}
 	defer resp.Body.Close()
 
+	if viaGateway {
+		s.setRateLimitHeaders(c, resp.Header, namespace, modelName, httpReq.Header.Get("X-API-Key"), gatewayRequestsPerMinute)
+	}
+
 	// Read response body
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
+		if req.DeadlineMs > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			abortPredictDeadline(c, req.DeadlineMs, requestStart)
+			return
+		}
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "Failed to read response",
 			Details: err.Error(),
@@ -555,6 +1419,18 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 		return
 	}
 
+	// Surface upstream throttling as-is so callers can back off
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			c.Header("Retry-After", retryAfter)
+		}
+		c.JSON(http.StatusTooManyRequests, ErrorResponse{
+			Error:   "Model is rate limited, retry later",
+			Details: string(responseBody),
+		})
+		return
+	}
+
 	// Check if response status is not successful
 	if resp.StatusCode >= 400 {
 		c.JSON(http.StatusBadGateway, ErrorResponse{
@@ -564,61 +1440,326 @@ func (s *ModelService) PredictModel(c *gin.Context) {
 		return
 	}
 
+	if coldStart > 0 {
+		coldStartMs := coldStart.Milliseconds()
+		c.Header("X-Cold-Start-Ms", strconv.FormatInt(coldStartMs, 10))
+
+		// Record the cold start in the usage log without holding up the response
+		apiKey := httpReq.Header.Get("X-API-Key")
+		requestData := APIRequestData{
+			Method:       http.MethodPost,
+			Endpoint:     c.Request.URL.Path,
+			StatusCode:   resp.StatusCode,
+			ResponseTime: time.Since(requestStart).Milliseconds(),
+			RequestSize:  int64(len(inputDataJSON)),
+			ResponseSize: int64(len(responseBody)),
+			UserAgent:    c.Request.UserAgent(),
+			ClientIP:     c.ClientIP(),
+			ColdStartMs:  coldStartMs,
+		}
+		s.lifecycle.Go("usage tracking", func(context.Context) {
+			if err := NewUsageTracker(s.k8sClient).TrackAPIRequest(namespace, modelName, apiKey, requestData); err != nil {
+				log.Printf("Failed to record cold start for model %s/%s: %v", namespace, modelName, err)
+			}
+		})
+	}
+
+	// With includeTiming, the result is wrapped together with its latency breakdown
+	respond := func(result interface{}) {
+		if includeTiming {
+			result = TimedPredictionResponse{
+				Prediction: result,
+				Timing:     newPredictionTiming(handlerStart, requestStart, resp.Header, coldStart),
+			}
+		}
+		c.JSON(http.StatusOK, result)
+	}
+
 	// Parse prediction result
 	var prediction interface{}
 	if err := json.Unmarshal(responseBody, &prediction); err != nil {
 		// If JSON parsing fails, return raw response
-		c.JSON(http.StatusOK, map[string]interface{}{
+		respond(map[string]interface{}{
 			"raw_response": string(responseBody),
 			"status_code":  resp.StatusCode,
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, prediction)
+	if cacheTTL > 0 {
+		s.predictionCache.Set(cacheKey, responseBody, cacheTTL)
+	}
+
+	respond(prediction)
+}
+
+// Upper bound for a per-request prediction deadline, matching the proxy client timeout
+const maxPredictDeadlineMs = 30000
+
+// abortPredictDeadline responds with 504 when a prediction exceeds the caller's deadlineMs
+func abortPredictDeadline(c *gin.Context, deadlineMs int, start time.Time) {
+	elapsedMs := time.Since(start).Milliseconds()
+	c.Header("X-Prediction-Elapsed-Ms", strconv.FormatInt(elapsedMs, 10))
+	c.JSON(http.StatusGatewayTimeout, ErrorResponse{
+		Error:   fmt.Sprintf("Prediction exceeded the deadline of %dms", deadlineMs),
+		Details: fmt.Sprintf("Upstream request cancelled after %dms", elapsedMs),
+	})
+}
+
+// Bounds for waiting out upstream 429 responses when the caller sets waitOnThrottle
+const (
+	maxThrottleRetries = 2
+	maxThrottleWait    = 10 * time.Second
+)
+
+// Interval between attempts while a scaled-to-zero model is starting up
+const coldStartRetryInterval = time.Second
+
+// doPredictRequest sends a prediction request. When waitOnThrottle is set, 429 responses are retried
+// after the upstream Retry-After delay, as long as the delay is within maxThrottleWait.
+// The returned duration is the observed cold-start latency, or 0 when the model was already serving.
+func (s *ModelService) doPredictRequest(ctx context.Context, client *http.Client, httpReq *http.Request, waitOnThrottle bool) (*http.Response, time.Duration, error) {
+	resp, coldStart, err := s.doColdStartRequest(ctx, client, httpReq)
+	if err != nil || !waitOnThrottle {
+		return resp, coldStart, err
+	}
+
+	for attempt := 0; attempt < maxThrottleRetries && resp.StatusCode == http.StatusTooManyRequests; attempt++ {
+		wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"))
+		if !ok || wait > maxThrottleWait || httpReq.GetBody == nil {
+			break
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return resp, coldStart, nil
+		}
+
+		body, err := httpReq.GetBody()
+		if err != nil {
+			break
+		}
+		retryReq := httpReq.Clone(ctx)
+		retryReq.Body = body
+
+		retryResp, err := client.Do(retryReq)
+		if err != nil {
+			break
+		}
+		resp.Body.Close()
+		resp = retryResp
+	}
+
+	return resp, coldStart, nil
+}
+
+// doColdStartRequest sends a prediction request, retrying 503 and connection-refused responses
+// until PredictColdStartTimeout while the predictor scales up from zero. The elapsed time is
+// reported as a cold start only when a retry eventually succeeds.
+func (s *ModelService) doColdStartRequest(ctx context.Context, client *http.Client, httpReq *http.Request) (*http.Response, time.Duration, error) {
+	start := time.Now()
+	resp, err := client.Do(httpReq)
+
+	timeout, parseErr := parseDuration(ActiveConfig().PredictColdStartTimeout, 0, 5*time.Minute)
+	if parseErr != nil || timeout == 0 || httpReq.GetBody == nil || !isColdStartFailure(resp, err) {
+		return resp, 0, err
+	}
+
+	deadline := start.Add(timeout)
+	for isColdStartFailure(resp, err) && time.Now().Add(coldStartRetryInterval).Before(deadline) {
+		select {
+		case <-time.After(coldStartRetryInterval):
+		case <-ctx.Done():
+			return resp, 0, err
+		}
+
+		body, bodyErr := httpReq.GetBody()
+		if bodyErr != nil {
+			break
+		}
+		retryReq := httpReq.Clone(ctx)
+		retryReq.Body = body
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+		resp, err = client.Do(retryReq)
+	}
+
+	if err != nil || resp.StatusCode >= 400 {
+		return resp, 0, err
+	}
+	return resp, time.Since(start), nil
+}
+
+// isColdStartFailure reports whether a prediction attempt failed the way a predictor that is
+// still scaling up from zero does
+func isColdStartFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, syscall.ECONNREFUSED)
+	}
+	return resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+// A missing header defaults to one second.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return time.Second, true
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(t); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// predictionCacheTTL returns the cache TTL of a published traditional model, or 0 when caching is off
+func (s *ModelService) predictionCacheTTL(namespace, modelName string) time.Duration {
+	if s.publishingService == nil {
+		return 0
+	}
+
+	publishedModel, err := s.publishingService.getPublishedModelMetadata(namespace, modelName)
+	if err != nil {
+		return 0
+	}
+	return publishedCacheTTL(publishedModel)
+}
+
+// publishedCacheTTL returns the cache TTL of a published model, or 0 when caching is off or the
+// model is an OpenAI model
+func publishedCacheTTL(publishedModel *PublishedModel) time.Duration {
+	if publishedModel.CacheTTL == "" || publishedModel.ModelType == "openai" {
+		return 0
+	}
+
+	ttl, err := parseDuration(publishedModel.CacheTTL, minCacheTTL, maxCacheTTL)
+	if err != nil {
+		return 0
+	}
+	return ttl
+}
+
+// predictorRevision identifies the predictor revision a prediction is sent to.
+// URL is only set when the request must bypass the status URL to reach a ready revision.
+type predictorRevision struct {
+	Name string
+	URL  string
+}
+
+// selectPredictorRevision inspects status.components.predictor and, when the latest created
+// revision is not ready yet, returns an address for the latest ready revision instead
+func selectPredictorRevision(namespace string, obj map[string]interface{}) predictorRevision {
+	status, _ := obj["status"].(map[string]interface{})
+	components, _ := status["components"].(map[string]interface{})
+	predictor, ok := components["predictor"].(map[string]interface{})
+	if !ok {
+		return predictorRevision{} // Raw deployments have no revisions
+	}
+
+	latestCreated, _ := predictor["latestCreatedRevision"].(string)
+	latestReady, _ := predictor["latestReadyRevision"].(string)
+	if latestReady == "" || latestCreated == "" || latestCreated == latestReady {
+		return predictorRevision{Name: latestReady}
+	}
+
+	// Prefer the tagged traffic URL for the ready revision, then its revision service
+	revisionURL := fmt.Sprintf("http://%s.%s.svc.cluster.local", latestReady, namespace)
+	if traffic, ok := predictor["traffic"].([]interface{}); ok {
+		for _, target := range traffic {
+			targetMap, ok := target.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name, _ := targetMap["revisionName"].(string); name == latestReady {
+				if url, ok := targetMap["url"].(string); ok && url != "" {
+					revisionURL = url
+				}
+			}
+		}
+	}
+
+	return predictorRevision{Name: latestReady, URL: revisionURL}
 }
 
-// createHTTPClient creates an HTTP client with custom DNS resolution support
-func (s *ModelService) createHTTPClient(settings *ConnectionSettings) *http.Client {
-	client := &http.Client{
-		Timeout: 30 * time.Second,
+// Prediction payload formats understood by KServe model servers
+const (
+	PayloadFormatInstances   = "instances"    // v1 {"instances": [...]}
+	PayloadFormatNamedInputs = "named-inputs" // v1 {"inputs": {"input_1": [...]}}
+	PayloadFormatV2Tensors   = "v2-tensors"   // v2 {"inputs": [{"name": ..., "shape": ..., "datatype": ..., "data": ...}]}
+)
+
+// detectPayloadFormat inspects a prediction body to determine which inference protocol it targets
+func detectPayloadFormat(payload json.RawMessage) string {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return PayloadFormatInstances
 	}
 
-	// If no DNS resolution overrides, return default client
-	if settings == nil || len(settings.DNSResolve) == 0 {
-		return client
+	inputs, ok := body["inputs"]
+	if !ok {
+		return PayloadFormatInstances
 	}
 
-	// Build DNS resolution map
-	dnsResolveMap := make(map[string]string)
-	for _, dnsResolve := range settings.DNSResolve {
-		if dnsResolve.Host != "" && dnsResolve.Port != "" && dnsResolve.Address != "" {
-			// Create address key (host:port)
-			addressKey := dnsResolve.Host + ":" + dnsResolve.Port
-			// Set IP:port as the target
-			dnsResolveMap[addressKey] = dnsResolve.Address + ":" + dnsResolve.Port
+	// v2 requests carry a list of named tensors
+	var tensors []map[string]interface{}
+	if err := json.Unmarshal(inputs, &tensors); err == nil && len(tensors) > 0 {
+		if _, hasName := tensors[0]["name"]; hasName {
+			return PayloadFormatV2Tensors
 		}
 	}
 
-	// Create custom dialer
-	dialer := &net.Dialer{
-		Timeout: 30 * time.Second,
+	var namedInputs map[string]interface{}
+	if err := json.Unmarshal(inputs, &namedInputs); err == nil {
+		return PayloadFormatNamedInputs
 	}
 
-	// Create custom transport with DNS override
-	transport := &http.Transport{
-		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
-			// Check if this address needs DNS override
-			if dnsOverride, exists := dnsResolveMap[addr]; exists {
-				// Use the override address
-				addr = dnsOverride
+	return PayloadFormatInstances
+}
+
+// hasExplainer reports whether an InferenceService has an explainer component in its spec or status
+func hasExplainer(obj map[string]interface{}) bool {
+	if spec, ok := obj["spec"].(map[string]interface{}); ok {
+		if _, ok := spec["explainer"]; ok {
+			return true
+		}
+	}
+	if status, ok := obj["status"].(map[string]interface{}); ok {
+		if components, ok := status["components"].(map[string]interface{}); ok {
+			if _, ok := components["explainer"]; ok {
+				return true
 			}
-			return dialer.DialContext(ctx, network, addr)
-		},
+		}
+	}
+	return false
+}
+
+// defaultInferencePath returns the KServe path for an operation. Explain requests always use the v1 protocol.
+func defaultInferencePath(operation, modelName, payloadFormat string) string {
+	if operation == InferenceOperationExplain {
+		return fmt.Sprintf("/v1/models/%s:explain", modelName)
+	}
+	return defaultPredictPath(modelName, payloadFormat)
+}
+
+// defaultPredictPath returns the KServe inference path matching the payload format
+func defaultPredictPath(modelName, payloadFormat string) string {
+	if payloadFormat == PayloadFormatV2Tensors {
+		return fmt.Sprintf("/v2/models/%s/infer", modelName)
 	}
+	return fmt.Sprintf("/v1/models/%s:predict", modelName)
+}
 
-	client.Transport = transport
-	return client
+// predictClientOptions are used for calls the service proxies to model endpoints on a user's behalf
+var predictClientOptions = ProxyClientOptions{
+	BlockLinkLocal: true,
 }
 
 // GetModelLogs handles GET /api/models/:modelName/logs
@@ -650,9 +1791,39 @@ func (s *ModelService) GetModelLogs(c *gin.Context) {
 		}
 	}
 
-	// Get model logs
-	logs, err := s.k8sClient.GetModelLogs(tenant, modelName, lines)
+	container := c.Query("container")
+
+	// Without a container, the storage-initializer logs are returned alongside the model server's
+	// so download progress is visible while the model is still initializing
+	var storageInitializerLogs []string
+	if container == "" {
+		initLogs, err := s.k8sClient.GetModelLogs(tenant, modelName, storageInitializerContainer, lines)
+		if err == nil {
+			storageInitializerLogs = initLogs
+		} else if _, ok := err.(*ContainerNotFoundError); !ok {
+			log.Printf("Failed to get storage-initializer logs of %s/%s: %v", tenant, modelName, err)
+		}
+	}
+
+	// Get model logs, optionally from a specific container (e.g. queue-proxy, storage-initializer)
+	logs, err := s.k8sClient.GetModelLogs(tenant, modelName, container, lines)
+	if err != nil && storageInitializerLogs != nil {
+		// The model server has not started while the model is still downloading
+		c.JSON(http.StatusOK, LogsResponse{
+			Logs:                   []string{},
+			StorageInitializerLogs: storageInitializerLogs,
+		})
+		return
+	}
 	if err != nil {
+		if containerErr, ok := err.(*ContainerNotFoundError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":               "Container not found",
+				"details":             containerErr.Error(),
+				"availableContainers": containerErr.Available,
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "Failed to get logs",
 			Details: err.Error(),
@@ -661,13 +1832,157 @@ func (s *ModelService) GetModelLogs(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, LogsResponse{
-		Logs: logs,
+		Logs:                   logs,
+		StorageInitializerLogs: storageInitializerLogs,
 	})
 }
 
+// GetModelMetrics handles GET /api/models/:modelName/metrics
+func (s *ModelService) GetModelMetrics(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	modelName := c.Param("modelName")
+	tenant := u.Tenant
+	cacheKey := tenant + "/" + modelName
+
+	s.metricsMu.Lock()
+	cached, found := s.metricsCache[cacheKey]
+	s.metricsMu.Unlock()
+	if found && time.Since(cached.fetchedAt) < modelMetricsCacheTTL {
+		c.Header("X-Cache", "HIT")
+		c.Data(http.StatusOK, "text/plain; version=0.0.4; charset=utf-8", s.withProxyLoadMetrics(cached.body, tenant, modelName))
+		return
+	}
+
+	selector := fmt.Sprintf("serving.kserve.io/inferenceservice=%s", modelName)
+	pods, err := s.k8sClient.GetPodsWithSelector(tenant, selector)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to get model pods",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Scrape the first running predictor pod
+	var metricsURL string
+	for _, pod := range pods {
+		if pod.Status.Phase != "Running" || pod.Status.PodIP == "" {
+			continue
+		}
+		if component := pod.Labels["component"]; component != "" && component != "predictor" {
+			continue
+		}
+		port, path, err := podMetricsEndpoint(pod.Annotations)
+		if err != nil {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "Metrics not available",
+				Details: fmt.Sprintf("Model %s has invalid metrics annotations: %v", modelName, err),
+			})
+			return
+		}
+		metricsURL = (&url.URL{Scheme: "http", Host: net.JoinHostPort(pod.Status.PodIP, port), Path: path}).String()
+		break
+	}
+	if metricsURL == "" {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "Metrics not available",
+			Details: fmt.Sprintf("Model %s has no running predictor pods", modelName),
+		})
+		return
+	}
+
+	// The port and path come from pod annotations, so the scrape gets the same guards as the proxy
+	client := NewProxyHTTPClient(nil, ProxyClientOptions{Timeout: 5 * time.Second, BlockLinkLocal: true})
+	resp, err := client.Get(metricsURL)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "Metrics not available",
+			Details: fmt.Sprintf("Model %s does not expose metrics: %v", modelName, err),
+		})
+		return
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4*1024*1024))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "Metrics not available",
+			Details: fmt.Sprintf("Model %s metrics endpoint returned status %d", modelName, resp.StatusCode),
+		})
+		return
+	}
+
+	s.metricsMu.Lock()
+	s.metricsCache[cacheKey] = cachedModelMetrics{body: body, fetchedAt: time.Now()}
+	s.metricsMu.Unlock()
+
+	c.Header("X-Cache", "MISS")
+	c.Data(http.StatusOK, "text/plain; version=0.0.4; charset=utf-8", s.withProxyLoadMetrics(body, tenant, modelName))
+}
+
+// withProxyLoadMetrics appends the current prediction proxy load to a scraped metrics body. The
+// load is never cached, since it changes with every request.
+func (s *ModelService) withProxyLoadMetrics(body []byte, tenant, modelName string) []byte {
+	out := make([]byte, 0, len(body)+2048)
+	out = append(out, body...)
+	if len(out) > 0 && out[len(out)-1] != '\n' {
+		out = append(out, '\n')
+	}
+	return append(out, proxyLoadMetrics(tenant, modelName, s.proxyLimiter.Load(tenant, modelName))...)
+}
+
+// GetProxyLoad handles GET /api/admin/proxy-load
+func (s *ModelService) GetProxyLoad(c *gin.Context) {
+	c.JSON(http.StatusOK, s.proxyLimiter.Snapshot())
+}
+
+// podMetricsEndpoint returns the metrics port and path of a KServe pod. The aggregated
+// prometheus.io annotations win over the model server's prometheus.kserve.io ones. The
+// annotations are set by the tenant, so a port that is not a number or a path that does not
+// start with / is rejected rather than allowed to change the scraped host.
+func podMetricsEndpoint(annotations map[string]string) (string, string, error) {
+	port, path := "8080", "/metrics"
+
+	if v := annotations["prometheus.kserve.io/port"]; v != "" {
+		port = v
+	}
+	if v := annotations["prometheus.kserve.io/path"]; v != "" {
+		path = v
+	}
+	if v := annotations["prometheus.io/port"]; v != "" {
+		port = v
+	}
+	if v := annotations["prometheus.io/path"]; v != "" {
+		path = v
+	}
+
+	if !isValidPort(port) {
+		return "", "", fmt.Errorf("invalid metrics port %q", port)
+	}
+	if !strings.HasPrefix(path, "/") {
+		return "", "", fmt.Errorf("metrics path %q must start with /", path)
+	}
+	return port, path, nil
+}
+
 // GetFrameworks handles GET /api/frameworks
 func (s *ModelService) GetFrameworks(c *gin.Context) {
 	c.JSON(http.StatusOK, FrameworksResponse{
-		Frameworks: s.config.SupportedFrameworks,
+		Frameworks: ActiveConfig().SupportedFrameworks,
 	})
 }
\ No newline at end of file
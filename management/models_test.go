@@ -0,0 +1,88 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// fakeUnpublisher records the calls DeleteModel makes to the publishing service
+type fakeUnpublisher struct {
+	published map[string]bool
+	calls     []string
+}
+
+func (f *fakeUnpublisher) isModelPublished(namespace, modelName string) bool {
+	return f.published[namespace+"/"+modelName]
+}
+
+func (f *fakeUnpublisher) cleanupPublishedResources(namespace, modelName string) {
+	f.calls = append(f.calls, "cleanup "+namespace+"/"+modelName)
+}
+
+func (f *fakeUnpublisher) logPublishingEvent(user *User, modelName, namespace, action string) {
+	f.calls = append(f.calls, action+" "+namespace+"/"+modelName+" by "+user.Name)
+}
+
+func TestUnpublishBeforeDelete(t *testing.T) {
+	user := &User{Name: "alice", Tenant: "tenant-a"}
+
+	tests := []struct {
+		name      string
+		published bool
+		cascade   bool
+		wantOK    bool
+		wantCalls []string
+	}{
+		{
+			name:      "published without cascade is refused",
+			published: true,
+			cascade:   false,
+			wantOK:    false,
+		},
+		{
+			name:      "published with cascade is unpublished",
+			published: true,
+			cascade:   true,
+			wantOK:    true,
+			wantCalls: []string{"cleanup tenant-a/my-model", "unpublished tenant-a/my-model by alice"},
+		},
+		{
+			name:      "unpublished model is deleted as is",
+			published: false,
+			cascade:   false,
+			wantOK:    true,
+		},
+		{
+			name:      "cascade on an unpublished model does nothing",
+			published: false,
+			cascade:   true,
+			wantOK:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher := &fakeUnpublisher{published: map[string]bool{"tenant-a/my-model": tt.published}}
+
+			ok := unpublishBeforeDelete(publisher, user, "tenant-a", "my-model", tt.cascade)
+			if ok != tt.wantOK {
+				t.Errorf("unpublishBeforeDelete() = %t, want %t", ok, tt.wantOK)
+			}
+			if !reflect.DeepEqual(publisher.calls, tt.wantCalls) {
+				t.Errorf("calls = %q, want %q", publisher.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestUnpublishBeforeDeleteChecksTheCallersNamespace(t *testing.T) {
+	// The same name published by another tenant must not block or be removed
+	publisher := &fakeUnpublisher{published: map[string]bool{"tenant-b/my-model": true}}
+
+	if !unpublishBeforeDelete(publisher, &User{Name: "alice", Tenant: "tenant-a"}, "tenant-a", "my-model", false) {
+		t.Error("deletion was refused for a model published only in another namespace")
+	}
+	if len(publisher.calls) != 0 {
+		t.Errorf("calls = %q, want none", publisher.calls)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -24,7 +25,7 @@ func (t *UsageTracker) TrackAPIRequest(namespace, modelName, apiKey string, requ
 		"timestamp":    time.Now().Format(time.RFC3339),
 		"modelName":    modelName,
 		"namespace":    namespace,
-		"apiKey":       apiKey[:8] + "...", // Only store first 8 chars for security
+		"apiKey":       maskUsageAPIKey(apiKey),
 		"method":       requestData.Method,
 		"endpoint":     requestData.Endpoint,
 		"statusCode":   requestData.StatusCode,
@@ -42,57 +43,83 @@ func (t *UsageTracker) TrackAPIRequest(namespace, modelName, apiKey string, requ
 		usageEntry["completionTokens"] = requestData.CompletionTokens
 	}
 	
-	// Store in daily usage log
+	// Record how long the predictor took to come up when the request hit a cold start
+	if requestData.ColdStartMs > 0 {
+		usageEntry["coldStartMs"] = requestData.ColdStartMs
+	}
+	
+	// Forward to the external sink before the size-limited ConfigMap write
+	exportLogEntry("usage", namespace, usageEntry)
+	
+	// Store in daily usage log, rolling over to a new part when the ConfigMap fills up
 	usageLogName := fmt.Sprintf("model-usage-%s-%s", modelName, time.Now().Format("2006-01-02"))
 	
-	// Try to get existing usage log for today
-	existingLog, err := t.k8sClient.GetConfigMap(namespace, usageLogName)
-	if err != nil {
-		// Create new usage log
+	newUsageLog := func(entry map[string]interface{}) map[string]interface{} {
 		usageData := map[string]interface{}{
-			"entries": []interface{}{usageEntry},
+			"entries": []interface{}{entry},
 			"summary": map[string]interface{}{
 				"totalRequests": 1,
 				"totalTokens":   requestData.TokensUsed,
 				"avgResponseTime": requestData.ResponseTime,
 				"errorCount":    0,
+				"coldStartCount": 0,
+				"avgColdStartMs": 0,
 			},
 		}
 		if requestData.StatusCode >= 400 {
 			usageData["summary"].(map[string]interface{})["errorCount"] = 1
 		}
-		return t.k8sClient.CreateConfigMap(namespace, usageLogName, usageData)
-	} else {
-		// Append to existing usage log and update summary
-		if entries, ok := existingLog["entries"].([]interface{}); ok {
-			entries = append(entries, usageEntry)
-			existingLog["entries"] = entries
-			
-			// Update summary
-			if summary, ok := existingLog["summary"].(map[string]interface{}); ok {
-				if totalRequests, ok := summary["totalRequests"].(float64); ok {
-					summary["totalRequests"] = totalRequests + 1
-				}
-				if totalTokens, ok := summary["totalTokens"].(float64); ok {
-					summary["totalTokens"] = totalTokens + float64(requestData.TokensUsed)
-				}
-				if requestData.StatusCode >= 400 {
-					if errorCount, ok := summary["errorCount"].(float64); ok {
-						summary["errorCount"] = errorCount + 1
-					}
-				}
-				// Update average response time
-				if avgResponseTime, ok := summary["avgResponseTime"].(float64); ok {
-					newCount := summary["totalRequests"].(float64)
-					summary["avgResponseTime"] = (avgResponseTime*(newCount-1) + float64(requestData.ResponseTime)) / newCount
+		if requestData.ColdStartMs > 0 {
+			usageData["summary"].(map[string]interface{})["coldStartCount"] = 1
+			usageData["summary"].(map[string]interface{})["avgColdStartMs"] = requestData.ColdStartMs
+		}
+		return usageData
+	}
+	
+	updateSummary := func(existingLog map[string]interface{}) {
+		if summary, ok := existingLog["summary"].(map[string]interface{}); ok {
+			if totalRequests, ok := summary["totalRequests"].(float64); ok {
+				summary["totalRequests"] = totalRequests + 1
+			}
+			if totalTokens, ok := summary["totalTokens"].(float64); ok {
+				summary["totalTokens"] = totalTokens + float64(requestData.TokensUsed)
+			}
+			if requestData.StatusCode >= 400 {
+				if errorCount, ok := summary["errorCount"].(float64); ok {
+					summary["errorCount"] = errorCount + 1
 				}
 			}
-			
-			return t.k8sClient.UpdateConfigMap(namespace, usageLogName, existingLog)
+			// Update average response time
+			if avgResponseTime, ok := summary["avgResponseTime"].(float64); ok {
+				newCount := summary["totalRequests"].(float64)
+				summary["avgResponseTime"] = (avgResponseTime*(newCount-1) + float64(requestData.ResponseTime)) / newCount
+			}
+			// Logs written before cold-start tracking have no counters yet, so start them at zero
+			if requestData.ColdStartMs > 0 {
+				coldStartCount, _ := summary["coldStartCount"].(float64)
+				avgColdStartMs, _ := summary["avgColdStartMs"].(float64)
+				summary["coldStartCount"] = coldStartCount + 1
+				summary["avgColdStartMs"] = (avgColdStartMs*coldStartCount + float64(requestData.ColdStartMs)) / (coldStartCount + 1)
+			}
 		}
 	}
 	
-	return nil
+	return appendLogEntry(t.k8sClient, namespace, usageLogName, usageEntry, newUsageLog, updateSummary)
+}
+
+// maskUsageAPIKey keeps only the non-secret part of an API key for the usage log: the
+// namespace and key id prefix, or the first 8 characters of a key issued without one
+func maskUsageAPIKey(apiKey string) string {
+	if apiKey == "" {
+		return ""
+	}
+	if namespace, shortID, ok := parseAPIKeyPrefix(apiKey); ok {
+		return fmt.Sprintf("%s_%s_%s_...", apiKeyPrefix, namespace, shortID)
+	}
+	if len(apiKey) <= 8 {
+		return "..."
+	}
+	return apiKey[:8] + "..."
 }
 
 // GetUsageStats retrieves usage statistics for a published model
@@ -104,7 +131,7 @@ func (t *UsageTracker) GetUsageStats(namespace, modelName string, days int) (*Us
 		date := time.Now().AddDate(0, 0, -i).Format("2006-01-02")
 		usageLogName := fmt.Sprintf("model-usage-%s-%s", modelName, date)
 		
-		usageLog, err := t.k8sClient.GetConfigMap(namespace, usageLogName)
+		usageLog, err := readDailyLog(t.k8sClient, namespace, usageLogName)
 		if err != nil {
 			continue // Skip days with no data
 		}
@@ -116,6 +143,9 @@ func (t *UsageTracker) GetUsageStats(namespace, modelName string, days int) (*Us
 			if totalTokens, ok := summary["totalTokens"].(float64); ok {
 				stats.TokensUsed += int64(totalTokens)
 			}
+			if errorCount, ok := summary["errorCount"].(float64); ok {
+				stats.ErrorCount += int64(errorCount)
+			}
 			if i == 0 { // Today's requests
 				stats.RequestsToday = int64(summary["totalRequests"].(float64))
 			}
@@ -134,10 +164,172 @@ func (t *UsageTracker) GetUsageStats(namespace, modelName string, days int) (*Us
 			}
 		}
 	}
+	stats.ErrorRate = usageErrorRate(stats.ErrorCount, stats.TotalRequests)
 	
 	return stats, nil
 }
 
+// usageErrorRate returns the share of requests that failed, 0 when there were none
+func usageErrorRate(errorCount, totalRequests int64) float64 {
+	if totalRequests <= 0 {
+		return 0
+	}
+	return float64(errorCount) / float64(totalRequests)
+}
+
+// Number of models whose daily logs are read in parallel for a tenant rollup
+const tenantUsageWorkers = 8
+
+// GetTenantUsage sums GetUsageStats across the given models of a namespace and keeps the
+// per-model breakdown in the order the models were given. With daily set, each model and the
+// total also get a per-day series.
+func (t *UsageTracker) GetTenantUsage(namespace string, modelNames []string, days int, daily bool) (*TenantUsageResponse, error) {
+	models := make([]ModelUsage, len(modelNames))
+	errs := make([]error, len(modelNames))
+	
+	// Each model costs one ConfigMap read per day, so fan the models out over a few workers
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, tenantUsageWorkers)
+	for i, modelName := range modelNames {
+		wg.Add(1)
+		go func(i int, modelName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			
+			stats, err := t.GetUsageStats(namespace, modelName, days)
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to get usage for %s: %w", modelName, err)
+				return
+			}
+			models[i] = ModelUsage{ModelName: modelName, Usage: *stats}
+			if daily {
+				series, err := t.GetDailyUsageSeries(namespace, modelName, days)
+				if err != nil {
+					errs[i] = fmt.Errorf("failed to get daily usage for %s: %w", modelName, err)
+					return
+				}
+				models[i].Daily = series
+			}
+		}(i, modelName)
+	}
+	wg.Wait()
+	
+	report := &TenantUsageResponse{
+		Namespace:   namespace,
+		Days:        days,
+		Granularity: "total",
+		Models:      models,
+		CheckedAt:   time.Now(),
+	}
+	if daily {
+		report.Granularity = "daily"
+		report.Daily = emptyDailyUsageSeries(days)
+	}
+	for i, model := range models {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		report.Total.TotalRequests += model.Usage.TotalRequests
+		report.Total.RequestsToday += model.Usage.RequestsToday
+		report.Total.TokensUsed += model.Usage.TokensUsed
+		report.Total.ErrorCount += model.Usage.ErrorCount
+		if model.Usage.LastAccessTime.After(report.Total.LastAccessTime) {
+			report.Total.LastAccessTime = model.Usage.LastAccessTime
+		}
+		for day := range model.Daily {
+			addDailyUsage(&report.Daily[day], model.Daily[day])
+		}
+	}
+	report.Total.ErrorRate = usageErrorRate(report.Total.ErrorCount, report.Total.TotalRequests)
+	
+	return report, nil
+}
+
+// GetDailyUsageSeries returns one entry per day for the last N days, oldest first. It reuses the
+// daily stats of GetDetailedUsageReport without request patterns, and days without a usage log
+// are included as zeros so the series can be charted directly.
+func (t *UsageTracker) GetDailyUsageSeries(namespace, modelName string, days int) ([]DailyUsageStats, error) {
+	series := emptyDailyUsageSeries(days)
+	report, err := t.GetDetailedUsageReport(namespace, modelName, series[0].Date, series[len(series)-1].Date)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stats := range report.DailyStats {
+		day := int(stats.Date.Sub(series[0].Date).Hours()/24 + 0.5)
+		if day < 0 || day >= len(series) {
+			continue
+		}
+		stats.RequestPatterns = nil
+		series[day] = stats
+	}
+	return series, nil
+}
+
+// emptyDailyUsageSeries returns zeroed entries for the last N days, oldest first, each dated at
+// local midnight like the daily usage logs
+func emptyDailyUsageSeries(days int) []DailyUsageStats {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	series := make([]DailyUsageStats, days)
+	for i := range series {
+		series[i].Date = today.AddDate(0, 0, i-days+1)
+	}
+	return series
+}
+
+// addDailyUsage adds one model's day to a total, weighting the averages by request and cold start counts
+func addDailyUsage(total *DailyUsageStats, day DailyUsageStats) {
+	if requests := total.TotalRequests + day.TotalRequests; requests > 0 {
+		total.AvgResponseTime = (total.AvgResponseTime*float64(total.TotalRequests) + day.AvgResponseTime*float64(day.TotalRequests)) / float64(requests)
+	}
+	if coldStarts := total.ColdStartCount + day.ColdStartCount; coldStarts > 0 {
+		total.AvgColdStartMs = (total.AvgColdStartMs*float64(total.ColdStartCount) + day.AvgColdStartMs*float64(day.ColdStartCount)) / float64(coldStarts)
+	}
+	total.TotalRequests += day.TotalRequests
+	total.TokensUsed += day.TokensUsed
+	total.ErrorCount += day.ErrorCount
+	total.ColdStartCount += day.ColdStartCount
+	total.ErrorRate = usageErrorRate(total.ErrorCount, total.TotalRequests)
+}
+
+// CountRequestsSince counts tracked requests for a published model from the given time until now
+func (t *UsageTracker) CountRequestsSince(namespace, modelName string, since time.Time) (int64, error) {
+	var count int64
+	now := time.Now()
+
+	// A window may span midnight, so walk every daily log it touches
+	startDay := time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, since.Location())
+	for d := startDay; !d.After(now); d = d.AddDate(0, 0, 1) {
+		usageLogName := fmt.Sprintf("model-usage-%s-%s", modelName, d.Format("2006-01-02"))
+
+		usageLog, err := readDailyLog(t.k8sClient, namespace, usageLogName)
+		if err != nil {
+			continue // Skip days with no data
+		}
+
+		entries, ok := usageLog["entries"].([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, entry := range entries {
+			entryMap, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if timestamp, ok := entryMap["timestamp"].(string); ok {
+				if ts, err := time.Parse(time.RFC3339, timestamp); err == nil && !ts.Before(since) && !ts.After(now) {
+					count++
+				}
+			}
+		}
+	}
+
+	return count, nil
+}
+
 // GetDetailedUsageReport generates a detailed usage report
 func (t *UsageTracker) GetDetailedUsageReport(namespace, modelName string, startDate, endDate time.Time) (*DetailedUsageReport, error) {
 	report := &DetailedUsageReport{
@@ -148,12 +340,14 @@ func (t *UsageTracker) GetDetailedUsageReport(namespace, modelName string, start
 		DailyStats: make([]DailyUsageStats, 0),
 	}
 	
+	var coldStartTotalMs float64
+	
 	// Iterate through each day in the range
 	for d := startDate; d.Before(endDate) || d.Equal(endDate); d = d.AddDate(0, 0, 1) {
 		date := d.Format("2006-01-02")
 		usageLogName := fmt.Sprintf("model-usage-%s-%s", modelName, date)
 		
-		usageLog, err := t.k8sClient.GetConfigMap(namespace, usageLogName)
+		usageLog, err := readDailyLog(t.k8sClient, namespace, usageLogName)
 		if err != nil {
 			continue // Skip days with no data
 		}
@@ -178,11 +372,21 @@ func (t *UsageTracker) GetDetailedUsageReport(namespace, modelName string, start
 				dailyStats.ErrorCount = int64(errorCount)
 				report.TotalErrors += dailyStats.ErrorCount
 			}
+			if coldStartCount, ok := summary["coldStartCount"].(float64); ok && coldStartCount > 0 {
+				dailyStats.ColdStartCount = int64(coldStartCount)
+				if avgColdStartMs, ok := summary["avgColdStartMs"].(float64); ok {
+					dailyStats.AvgColdStartMs = avgColdStartMs
+					coldStartTotalMs += avgColdStartMs * coldStartCount
+				}
+				report.ColdStartCount += dailyStats.ColdStartCount
+			}
 		}
+		dailyStats.ErrorRate = usageErrorRate(dailyStats.ErrorCount, dailyStats.TotalRequests)
 		
 		// Analyze request patterns
 		if entries, ok := usageLog["entries"].([]interface{}); ok {
-			dailyStats.RequestPatterns = t.analyzeRequestPatterns(entries)
+			patterns := t.analyzeRequestPatterns(entries)
+			dailyStats.RequestPatterns = &patterns
 		}
 		
 		report.DailyStats = append(report.DailyStats, dailyStats)
@@ -193,6 +397,9 @@ func (t *UsageTracker) GetDetailedUsageReport(namespace, modelName string, start
 		report.AvgRequestsPerDay = float64(report.TotalRequests) / float64(len(report.DailyStats))
 		report.AvgTokensPerDay = float64(report.TotalTokens) / float64(len(report.DailyStats))
 	}
+	if report.ColdStartCount > 0 {
+		report.AvgColdStartMs = coldStartTotalMs / float64(report.ColdStartCount)
+	}
 	
 	return report, nil
 }
@@ -266,27 +473,13 @@ func (a *AuditLogger) LogPublishingEvent(event AuditEvent) error {
 		"sessionID":   event.SessionID,
 	}
 	
-	// Store in daily audit log
-	auditLogName := fmt.Sprintf("publishing-audit-%s", event.Timestamp.Format("2006-01-02"))
+	// Forward to the external sink before the size-limited ConfigMap write
+	exportLogEntry("audit", event.Namespace, auditEntry)
 	
-	// Try to get existing audit log for today
-	existingLog, err := a.k8sClient.GetConfigMap(event.Namespace, auditLogName)
-	if err != nil {
-		// Create new audit log
-		auditData := map[string]interface{}{
-			"entries": []interface{}{auditEntry},
-		}
-		return a.k8sClient.CreateConfigMap(event.Namespace, auditLogName, auditData)
-	} else {
-		// Append to existing audit log
-		if entries, ok := existingLog["entries"].([]interface{}); ok {
-			entries = append(entries, auditEntry)
-			existingLog["entries"] = entries
-			return a.k8sClient.UpdateConfigMap(event.Namespace, auditLogName, existingLog)
-		}
-	}
+	// Store in daily audit log, rolling over to a new part when the ConfigMap fills up
+	auditLogName := fmt.Sprintf("publishing-audit-%s", event.Timestamp.Format("2006-01-02"))
 	
-	return nil
+	return appendLogEntry(a.k8sClient, event.Namespace, auditLogName, auditEntry, newEntriesLog, nil)
 }
 
 // GetAuditLogs retrieves audit logs for a date range
@@ -297,7 +490,7 @@ func (a *AuditLogger) GetAuditLogs(namespace string, startDate, endDate time.Tim
 	for d := startDate; d.Before(endDate) || d.Equal(endDate); d = d.AddDate(0, 0, 1) {
 		auditLogName := fmt.Sprintf("publishing-audit-%s", d.Format("2006-01-02"))
 		
-		auditLog, err := a.k8sClient.GetConfigMap(namespace, auditLogName)
+		auditLog, err := readDailyLog(a.k8sClient, namespace, auditLogName)
 		if err != nil {
 			continue // Skip days with no data
 		}
@@ -361,6 +554,7 @@ type APIRequestData struct {
 	TokensUsed        int64
 	PromptTokens      int64
 	CompletionTokens  int64
+	ColdStartMs       int64 // Observed cold-start latency, 0 when the model was already serving
 }
 
 // DetailedUsageReport represents a detailed usage report
@@ -374,17 +568,22 @@ type DetailedUsageReport struct {
 	TotalErrors       int64              `json:"totalErrors"`
 	AvgRequestsPerDay float64            `json:"avgRequestsPerDay"`
 	AvgTokensPerDay   float64            `json:"avgTokensPerDay"`
+	ColdStartCount    int64              `json:"coldStartCount"`
+	AvgColdStartMs    float64            `json:"avgColdStartMs"`
 	DailyStats        []DailyUsageStats  `json:"dailyStats"`
 }
 
 // DailyUsageStats represents usage statistics for a single day
 type DailyUsageStats struct {
-	Date            time.Time       `json:"date"`
-	TotalRequests   int64           `json:"totalRequests"`
-	TokensUsed      int64           `json:"tokensUsed"`
-	ErrorCount      int64           `json:"errorCount"`
-	AvgResponseTime float64         `json:"avgResponseTime"`
-	RequestPatterns RequestPatterns `json:"requestPatterns"`
+	Date            time.Time        `json:"date"`
+	TotalRequests   int64            `json:"totalRequests"`
+	TokensUsed      int64            `json:"tokensUsed"`
+	ErrorCount      int64            `json:"errorCount"`
+	ErrorRate       float64          `json:"errorRate"`
+	AvgResponseTime float64          `json:"avgResponseTime"`
+	ColdStartCount  int64            `json:"coldStartCount"`
+	AvgColdStartMs  float64          `json:"avgColdStartMs"`
+	RequestPatterns *RequestPatterns `json:"requestPatterns,omitempty"` // Left out of daily usage series
 }
 
 // RequestPatterns represents patterns in API requests
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PermissionCheck describes a single RBAC permission the service relies on
+type PermissionCheck struct {
+	Group       string
+	Resource    string
+	Subresource string
+	Verb        string
+	Namespace   string // Empty for cluster-scoped checks
+}
+
+func (p PermissionCheck) String() string {
+	resource := p.Resource
+	if p.Group != "" {
+		resource = p.Resource + "." + p.Group
+	}
+	if p.Subresource != "" {
+		resource = resource + "/" + p.Subresource
+	}
+	if p.Namespace == "" {
+		return fmt.Sprintf("%s %s (cluster)", p.Verb, resource)
+	}
+	return fmt.Sprintf("%s %s in %s", p.Verb, resource, p.Namespace)
+}
+
+// requiredPermissions lists the verbs and resources used by the model, publishing and logging code paths
+func requiredPermissions(config *Config) []PermissionCheck {
+	var checks []PermissionCheck
+	add := func(group, resource, namespace string, verbs ...string) {
+		for _, verb := range verbs {
+			checks = append(checks, PermissionCheck{Group: group, Resource: resource, Verb: verb, Namespace: namespace})
+		}
+	}
+
+	// Tenant namespaces: models, usage/audit logs, published-model metadata and API keys
+	for _, tenant := range config.ValidTenants {
+		add("serving.kserve.io", "inferenceservices", tenant, "get", "list", "create", "update", "delete")
+		add("", "configmaps", tenant, "get", "list", "create", "update", "delete")
+		add("", "secrets", tenant, "get", "list", "create", "update", "delete")
+		add("", "pods", tenant, "get", "list")
+		add("", "services", tenant, "list") // Dial check of custom prediction targets
+		add("apps", "deployments", tenant, "list")
+		checks = append(checks, PermissionCheck{Resource: "pods", Subresource: "log", Verb: "get", Namespace: tenant})
+	}
+
+	// Gateway resources created when publishing models
+	add("gateway.networking.k8s.io", "gateways", "envoy-gateway-system", "get", "update")
+	add("gateway.networking.k8s.io", "httproutes", "envoy-gateway-system", "get", "create", "delete")
+	add("gateway.envoyproxy.io", "backendtrafficpolicies", "envoy-gateway-system", "get", "create", "delete")
+	add("gateway.envoyproxy.io", "backends", "envoy-gateway-system", "get", "create", "delete")
+	add("gateway.envoyproxy.io", "httproutefilters", "envoy-gateway-system", "get", "create", "delete")
+	add("gateway.envoyproxy.io", "securitypolicies", "envoy-gateway-system", "get", "create", "delete")
+	add("aigateway.envoyproxy.io", "aigatewayroutes", "envoy-gateway-system", "get", "create", "delete")
+	add("aigateway.envoyproxy.io", "aiservicebackends", "envoy-gateway-system", "get", "create", "delete")
+	add("gateway.networking.k8s.io", "referencegrants", "istio-system", "get", "create", "delete")
+	add("", "services", "istio-system", "get")
+	add("", "services", "envoy-gateway-system", "get")
+	add("", "secrets", "envoy-gateway-system", "get") // Gateway TLS certificates
+
+	// Service namespace: configuration overrides and hostname reservations
+	add("", "configmaps", serviceNamespace(), "get", "create", "update")
+
+	// Admin endpoints
+	add("", "namespaces", "", "list")
+	add("", "configmaps", "", "list", "delete") // Listing all namespaces and pruning daily logs
+	add("", "secrets", "", "list")
+	add("gateway.networking.k8s.io", "httproutes", "", "list")
+	add("gateway.networking.k8s.io", "referencegrants", "", "list")
+	add("gateway.envoyproxy.io", "backendtrafficpolicies", "", "list")
+	add("gateway.envoyproxy.io", "backends", "", "list")
+	add("gateway.envoyproxy.io", "httproutefilters", "", "list")
+	add("gateway.envoyproxy.io", "securitypolicies", "", "list")
+	add("aigateway.envoyproxy.io", "aigatewayroutes", "", "list")
+	add("aigateway.envoyproxy.io", "aiservicebackends", "", "list")
+	add("", "nodes", "", "list")
+	add("serving.kserve.io", "servingruntimes", "", "get", "list", "create", "update", "delete")
+	add("serving.kserve.io", "clusterservingruntimes", "", "get", "list")
+
+	return checks
+}
+
+// CheckPermission asks the API server whether the service account may perform the given action
+func (k *K8sClient) CheckPermission(check PermissionCheck) (bool, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   check.Namespace,
+				Verb:        check.Verb,
+				Group:       check.Group,
+				Resource:    check.Resource,
+				Subresource: check.Subresource,
+			},
+		},
+	}
+
+	result, err := k.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(context.Background(), review, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to review access for %s: %w", check, err)
+	}
+
+	return result.Status.Allowed, nil
+}
+
+// RunPermissionSelfTest checks every required permission at startup and logs a warning for each
+// one that is missing. It returns the missing permissions.
+func RunPermissionSelfTest(k8sClient *K8sClient, config *Config) []PermissionCheck {
+	var missing []PermissionCheck
+
+	for _, check := range requiredPermissions(config) {
+		allowed, err := k8sClient.CheckPermission(check)
+		if err != nil {
+			log.Printf("⚠️  Permission self-test could not run: %v", err)
+			return nil
+		}
+		if !allowed {
+			log.Printf("⚠️  Missing RBAC permission: %s", check)
+			missing = append(missing, check)
+		}
+	}
+
+	if len(missing) == 0 {
+		log.Println("✅ Permission self-test passed")
+	} else {
+		log.Printf("⚠️  Permission self-test found %d missing permission(s); related operations will fail with 403", len(missing))
+	}
+
+	return missing
+}
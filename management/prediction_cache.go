@@ -0,0 +1,111 @@
+package main
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// PredictionCache is an in-memory LRU of prediction responses for models published with a cacheTTL.
+// It is bounded both by entry count and by the total size of the cached bodies.
+type PredictionCache struct {
+	maxEntries int
+	maxBytes   int
+
+	mu    sync.Mutex
+	bytes int
+	order *list.List // Front is most recently used
+	items map[string]*list.Element
+}
+
+// Bounds for PublishConfig.CacheTTL
+const (
+	minCacheTTL = time.Second
+	maxCacheTTL = 24 * time.Hour
+)
+
+type predictionCacheEntry struct {
+	key       string
+	body      []byte
+	expiresAt time.Time
+}
+
+// NewPredictionCache creates a new prediction cache using the configured bounds
+func NewPredictionCache(config *Config) *PredictionCache {
+	return &PredictionCache{
+		maxEntries: config.PredictionCacheMaxEntries,
+		maxBytes:   config.PredictionCacheMaxBytes,
+		order:      list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// predictionCacheKey hashes the request target and body so identical inputs share an entry.
+// The API key sent and the DNS overrides are part of the target, so a response is never served
+// to a request with another key or routed to another address.
+func predictionCacheKey(namespace, modelName, requestURL, apiKey string, dnsResolve []DNSResolve, body []byte) string {
+	resolve, _ := json.Marshal(dnsResolve)
+
+	hash := sha256.New()
+	hash.Write([]byte(namespace + "/" + modelName + "\n" + requestURL + "\n" + apiKey + "\n"))
+	hash.Write(resolve)
+	hash.Write([]byte("\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Get returns a cached response body if present and not expired
+func (c *PredictionCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*predictionCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.removeElement(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry.body, true
+}
+
+// Set stores a response body for the given TTL, evicting least recently used entries as needed
+func (c *PredictionCache) Set(key string, body []byte, ttl time.Duration) {
+	if c.maxEntries <= 0 || c.maxBytes <= 0 || len(body) > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		c.removeElement(element)
+	}
+
+	entry := &predictionCacheEntry{
+		key:       key,
+		body:      body,
+		expiresAt: time.Now().Add(ttl),
+	}
+	c.items[key] = c.order.PushFront(entry)
+	c.bytes += len(body)
+
+	for c.order.Len() > c.maxEntries || c.bytes > c.maxBytes {
+		c.removeElement(c.order.Back())
+	}
+}
+
+func (c *PredictionCache) removeElement(element *list.Element) {
+	entry := element.Value.(*predictionCacheEntry)
+	c.order.Remove(element)
+	delete(c.items, entry.key)
+	c.bytes -= len(entry.body)
+}
@@ -0,0 +1,70 @@
+package main
+
+import "testing"
+
+func TestPredictionCacheKey(t *testing.T) {
+	body := []byte(`{"instances": [[1, 2, 3]]}`)
+	resolve := []DNSResolve{{Host: "api.example.com", Port: "443", Address: "10.0.0.10"}}
+	base := predictionCacheKey("tenant-a", "my-model", "https://api.example.com/v1/models/my-model:predict|", "key-1", resolve, body)
+
+	if again := predictionCacheKey("tenant-a", "my-model", "https://api.example.com/v1/models/my-model:predict|", "key-1", resolve, body); again != base {
+		t.Errorf("identical requests got different keys: %s and %s", base, again)
+	}
+
+	tests := []struct {
+		name       string
+		requestURL string
+		apiKey     string
+		dnsResolve []DNSResolve
+		body       []byte
+	}{
+		{
+			name:       "other API key",
+			requestURL: "https://api.example.com/v1/models/my-model:predict|",
+			apiKey:     "key-2",
+			dnsResolve: resolve,
+			body:       body,
+		},
+		{
+			name:       "no API key",
+			requestURL: "https://api.example.com/v1/models/my-model:predict|",
+			dnsResolve: resolve,
+			body:       body,
+		},
+		{
+			name:       "other resolved address",
+			requestURL: "https://api.example.com/v1/models/my-model:predict|",
+			apiKey:     "key-1",
+			dnsResolve: []DNSResolve{{Host: "api.example.com", Port: "443", Address: "10.0.0.11"}},
+			body:       body,
+		},
+		{
+			name:       "no DNS override",
+			requestURL: "https://api.example.com/v1/models/my-model:predict|",
+			apiKey:     "key-1",
+			body:       body,
+		},
+		{
+			name:       "other host header",
+			requestURL: "https://api.example.com/v1/models/my-model:predict|internal.example.com",
+			apiKey:     "key-1",
+			dnsResolve: resolve,
+			body:       body,
+		},
+		{
+			name:       "other body",
+			requestURL: "https://api.example.com/v1/models/my-model:predict|",
+			apiKey:     "key-1",
+			dnsResolve: resolve,
+			body:       []byte(`{"instances": [[1, 2, 4]]}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if key := predictionCacheKey("tenant-a", "my-model", tt.requestURL, tt.apiKey, tt.dnsResolve, tt.body); key == base {
+				t.Errorf("key = %s, same as the base request", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// upstreamModelTime reads the model's own processing time from response headers. A Server-Timing
+// header, summed over its metrics, is preferred since it comes from the model server itself;
+// otherwise the x-envoy-upstream-service-time set by the Envoy or Istio proxy in front of the
+// predictor is used.
+func upstreamModelTime(header http.Header) (int64, string, bool) {
+	if values := header.Values("Server-Timing"); len(values) > 0 {
+		var total float64
+		found := false
+		for _, value := range values {
+			for _, metric := range strings.Split(value, ",") {
+				for _, param := range strings.Split(metric, ";") {
+					name, duration, ok := strings.Cut(strings.TrimSpace(param), "=")
+					if !ok || !strings.EqualFold(name, "dur") {
+						continue
+					}
+					if ms, err := strconv.ParseFloat(strings.Trim(duration, `"`), 64); err == nil && ms >= 0 {
+						total += ms
+						found = true
+					}
+				}
+			}
+		}
+		if found {
+			return int64(total + 0.5), "Server-Timing", true
+		}
+	}
+
+	if value := header.Get("X-Envoy-Upstream-Service-Time"); value != "" {
+		if ms, err := strconv.ParseInt(value, 10, 64); err == nil && ms >= 0 {
+			return ms, "X-Envoy-Upstream-Service-Time", true
+		}
+	}
+	return 0, "", false
+}
+
+// newPredictionTiming builds the timing of a prediction that was sent upstream. upstream is nil
+// for responses served from the prediction cache.
+func newPredictionTiming(handlerStart, requestStart time.Time, upstream http.Header, coldStart time.Duration) PredictionTiming {
+	timing := PredictionTiming{TotalMs: time.Since(handlerStart).Milliseconds()}
+	if upstream == nil {
+		timing.Cached = true
+		return timing
+	}
+
+	timing.UpstreamMs = time.Since(requestStart).Milliseconds()
+	timing.ColdStartMs = coldStart.Milliseconds()
+	if ms, source, ok := upstreamModelTime(upstream); ok {
+		timing.ModelMs = &ms
+		timing.ModelTimeSource = source
+	}
+	return timing
+}
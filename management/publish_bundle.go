@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Format version of exported published-model bundles
+const publishedModelBundleVersion = 1
+
+// ExportPublishedModel handles GET /api/models/:modelName/publish/export
+// It returns the published model, its documentation and live gateway resources as one bundle.
+// The API key is replaced with a placeholder wherever it appears.
+func (s *PublishingService) ExportPublishedModel(c *gin.Context) {
+	modelName := c.Param("modelName")
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin {
+		if ns := c.Query("namespace"); ns != "" {
+			namespace = ns
+		}
+	}
+
+	publishedModel, err := s.getPublishedModelMetadata(namespace, modelName)
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Published model not found",
+			Details: err.Error(),
+		})
+		return
+	}
+	if publishedModel.Config == nil {
+		config := publishConfigFromModel(*publishedModel)
+		publishedModel.Config = &config
+	}
+
+	redacted, err := redactPublishedModelAPIKey(*publishedModel)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to export published model",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-%s-publish.json"`, namespace, modelName))
+	c.JSON(http.StatusOK, PublishedModelBundle{
+		Version:        publishedModelBundleVersion,
+		ExportedAt:     time.Now(),
+		PublishedModel: redacted,
+		Resources:      s.exportGatewayResources(*publishedModel),
+	})
+}
+
+// ImportPublishedModel handles POST /api/models/:modelName/publish/import
+// It publishes the model from an exported bundle's config. Gateway resources are generated for
+// the target environment rather than applied verbatim, and a new API key is issued.
+func (s *PublishingService) ImportPublishedModel(c *gin.Context) {
+	modelName := c.Param("modelName")
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	var bundle PublishedModelBundle
+	if err := c.ShouldBindJSON(&bundle); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+	if bundle.Version != publishedModelBundleVersion {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Unsupported bundle version",
+			Details: fmt.Sprintf("expected version %d, got %d", publishedModelBundleVersion, bundle.Version),
+		})
+		return
+	}
+
+	config := publishConfigFromModel(bundle.PublishedModel)
+
+	// The bundle's tenant belongs to the source environment; the target is the caller's tenant
+	// unless an admin picks one
+	config.TenantID = u.Tenant
+	if u.IsAdmin {
+		config.TenantID = bundle.PublishedModel.Namespace
+		if ns := c.Query("namespace"); ns != "" {
+			config.TenantID = ns
+		}
+	}
+
+	s.publishWithConfig(c, u, modelName, config, "imported", "Model imported successfully")
+}
+
+// redactPublishedModelAPIKey replaces the model's API key, including its copies in the generated
+// documentation, with the documentation placeholder
+func redactPublishedModelAPIKey(model PublishedModel) (PublishedModel, error) {
+	if model.APIKey == "" {
+		return model, nil
+	}
+
+	data, err := json.Marshal(model)
+	if err != nil {
+		return PublishedModel{}, err
+	}
+	data = []byte(strings.ReplaceAll(string(data), model.APIKey, previewAPIKeyPlaceholder))
+
+	var redacted PublishedModel
+	if err := json.Unmarshal(data, &redacted); err != nil {
+		return PublishedModel{}, err
+	}
+	return redacted, nil
+}
+
+// exportGatewayResources returns the live gateway resources of a published model with the
+// server-populated fields removed, so they read like the manifests that created them
+func (s *PublishingService) exportGatewayResources(model PublishedModel) []map[string]interface{} {
+	resources := []map[string]interface{}{}
+	for _, ref := range publishedResourceRefs(model.Namespace, model.ModelName, model.ModelType, model.Mirror != nil, model.CORS != nil) {
+		// The API key Secret is never exported
+		if ref.Kind == "Secret" {
+			continue
+		}
+		resource, err := s.k8sClient.getGatewayResource(ref)
+		if err != nil {
+			// Missing resources are reported by the reconciler; export what exists
+			continue
+		}
+		delete(resource, "status")
+		if metadata, ok := resource["metadata"].(map[string]interface{}); ok {
+			for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "managedFields"} {
+				delete(metadata, field)
+			}
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Request headers browsers may always send to a published model, so the API key can be passed
+var defaultCORSAllowHeaders = []string{"Content-Type", "X-API-Key", "Authorization"}
+
+// Headers identifying a CORS preflight. Both must be present for the preflight rule to match.
+var corsPreflightHeaders = []string{"origin", "access-control-request-method"}
+
+// corsPreflightFilterName is the HTTPRouteFilter answering preflight requests on a published route
+func corsPreflightFilterName(routeName string) string {
+	return routeName + "-cors-preflight"
+}
+
+// corsSecurityPolicyName is the SecurityPolicy carrying the CORS settings of a published route
+func corsSecurityPolicyName(routeName string) string {
+	return routeName + "-cors"
+}
+
+// createCORSPreflightRule creates the HTTPRouteFilter that answers preflights and returns the rule
+// using it. The rule only matches OPTIONS requests carrying the preflight headers and has no
+// backend, so it never forwards a request to the model. Envoy's CORS filter adds the configured
+// headers for allowed origins before the direct response is sent.
+func (s *PublishingService) createCORSPreflightRule(namespace, modelName, routeName, externalPath string) (map[string]interface{}, error) {
+	filterName := corsPreflightFilterName(routeName)
+	filter := map[string]interface{}{
+		"apiVersion": "gateway.envoyproxy.io/v1alpha1",
+		"kind":       "HTTPRouteFilter",
+		"metadata": map[string]interface{}{
+			"name":      filterName,
+			"namespace": "envoy-gateway-system",
+			"labels": map[string]interface{}{
+				"app":        "published-model",
+				"model-name": modelName,
+				"tenant":     namespace,
+			},
+		},
+		"spec": map[string]interface{}{
+			"directResponse": map[string]interface{}{
+				"statusCode": http.StatusNoContent,
+			},
+		},
+	}
+	if err := s.k8sClient.CreateHTTPRouteFilter("envoy-gateway-system", filter); err != nil {
+		return nil, fmt.Errorf("failed to create CORS preflight filter: %w", err)
+	}
+
+	var headers []interface{}
+	for _, name := range corsPreflightHeaders {
+		headers = append(headers, map[string]interface{}{
+			"name":  name,
+			"type":  "RegularExpression",
+			"value": ".+",
+		})
+	}
+
+	rule := map[string]interface{}{
+		"matches": []interface{}{
+			map[string]interface{}{
+				"path": map[string]interface{}{
+					"type":  "PathPrefix",
+					"value": externalPath,
+				},
+				"method":  http.MethodOptions,
+				"headers": headers,
+			},
+		},
+		"filters": []interface{}{
+			map[string]interface{}{
+				"type": "ExtensionRef",
+				"extensionRef": map[string]interface{}{
+					"group": "gateway.envoyproxy.io",
+					"kind":  "HTTPRouteFilter",
+					"name":  filterName,
+				},
+			},
+		},
+	}
+	if err := checkPreflightRuleIsolated(rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// checkPreflightRuleIsolated verifies that a rule matched without an API key can only take CORS
+// preflights and cannot reach a backend, so it never bypasses authentication for other requests
+func checkPreflightRuleIsolated(rule map[string]interface{}) error {
+	if backendRefs, ok := rule["backendRefs"].([]interface{}); ok && len(backendRefs) > 0 {
+		return fmt.Errorf("CORS preflight rule must not have backends")
+	}
+
+	matches, _ := rule["matches"].([]interface{})
+	if len(matches) == 0 {
+		return fmt.Errorf("CORS preflight rule must have a match")
+	}
+	for _, item := range matches {
+		match, _ := item.(map[string]interface{})
+		if match["method"] != http.MethodOptions {
+			return fmt.Errorf("CORS preflight rule must only match OPTIONS requests")
+		}
+		present := make(map[string]bool)
+		if headers, ok := match["headers"].([]interface{}); ok {
+			for _, header := range headers {
+				if h, ok := header.(map[string]interface{}); ok {
+					if name, ok := h["name"].(string); ok {
+						present[name] = true
+					}
+				}
+			}
+		}
+		for _, name := range corsPreflightHeaders {
+			if !present[name] {
+				return fmt.Errorf("CORS preflight rule must require the %s header", name)
+			}
+		}
+	}
+	return nil
+}
+
+// createCORSSecurityPolicy attaches the CORS settings to the published route
+func (s *PublishingService) createCORSSecurityPolicy(namespace, modelName, routeName string, cors CORSConfig, predictMethods []string) error {
+	allowMethods := cors.AllowMethods
+	if len(allowMethods) == 0 {
+		allowMethods = predictMethods
+	}
+
+	allowHeaders := append([]string{}, defaultCORSAllowHeaders...)
+	for _, header := range cors.AllowHeaders {
+		known := false
+		for _, existing := range allowHeaders {
+			known = known || strings.EqualFold(existing, header)
+		}
+		if !known {
+			allowHeaders = append(allowHeaders, header)
+		}
+	}
+
+	spec := map[string]interface{}{
+		"allowOrigins": stringsToInterfaces(cors.AllowOrigins),
+		"allowMethods": stringsToInterfaces(allowMethods),
+		"allowHeaders": stringsToInterfaces(allowHeaders),
+	}
+	if len(cors.ExposeHeaders) > 0 {
+		spec["exposeHeaders"] = stringsToInterfaces(cors.ExposeHeaders)
+	}
+	if cors.MaxAge != "" {
+		spec["maxAge"] = cors.MaxAge
+	}
+	if cors.AllowCredentials {
+		spec["allowCredentials"] = true
+	}
+
+	policy := map[string]interface{}{
+		"apiVersion": "gateway.envoyproxy.io/v1alpha1",
+		"kind":       "SecurityPolicy",
+		"metadata": map[string]interface{}{
+			"name":      corsSecurityPolicyName(routeName),
+			"namespace": "envoy-gateway-system",
+			"labels": map[string]interface{}{
+				"app":        "published-model",
+				"model-name": modelName,
+				"tenant":     namespace,
+			},
+		},
+		"spec": map[string]interface{}{
+			"targetRefs": []interface{}{
+				map[string]interface{}{
+					"group": "gateway.networking.k8s.io",
+					"kind":  "HTTPRoute",
+					"name":  routeName,
+				},
+			},
+			"cors": spec,
+		},
+	}
+	if err := s.k8sClient.CreateSecurityPolicy("envoy-gateway-system", policy); err != nil {
+		return fmt.Errorf("failed to create CORS SecurityPolicy: %w", err)
+	}
+	return nil
+}
+
+// parseCORSConfig converts stored CORS settings back from their generic JSON form
+func parseCORSConfig(value interface{}) *CORSConfig {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var cors CORSConfig
+	if err := json.Unmarshal(data, &cors); err != nil || len(cors.AllowOrigins) == 0 {
+		return nil
+	}
+	return &cors
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// publishedResourceRefs returns the per-model resources created when publishing a model, in the
+// order they are checked by the reconciler. The metadata ConfigMap is not included.
+func publishedResourceRefs(namespace, modelName, modelType string, mirror, cors bool) []PublishedResourceRef {
+	routeName := fmt.Sprintf("published-model-%s-%s", namespace, modelName)
+	backendName := fmt.Sprintf("%s-backend", modelName)
+
+	var refs []PublishedResourceRef
+	if modelType == "openai" {
+		refs = append(refs,
+			PublishedResourceRef{Kind: "AIGatewayRoute", APIVersion: "aigateway.envoyproxy.io/v1alpha1", Namespace: "envoy-gateway-system", Name: routeName},
+			PublishedResourceRef{Kind: "Backend", APIVersion: "gateway.envoyproxy.io/v1alpha1", Namespace: "envoy-gateway-system", Name: backendName},
+			PublishedResourceRef{Kind: "AIServiceBackend", APIVersion: "aigateway.envoyproxy.io/v1alpha1", Namespace: "envoy-gateway-system", Name: backendName + "-ai"},
+			PublishedResourceRef{Kind: "ReferenceGrant", APIVersion: "gateway.networking.k8s.io/v1beta1", Namespace: "istio-system", Name: fmt.Sprintf("published-model-grant-%s-%s", namespace, modelName)},
+		)
+	} else {
+		refs = append(refs,
+			PublishedResourceRef{Kind: "HTTPRoute", APIVersion: "gateway.networking.k8s.io/v1", Namespace: "envoy-gateway-system", Name: routeName},
+			PublishedResourceRef{Kind: "HTTPRouteFilter", APIVersion: "gateway.envoyproxy.io/v1alpha1", Namespace: "envoy-gateway-system", Name: methodNotAllowedFilterName(routeName)},
+		)
+		if mirror {
+			refs = append(refs, PublishedResourceRef{Kind: "Backend", APIVersion: "gateway.envoyproxy.io/v1alpha1", Namespace: "envoy-gateway-system", Name: mirrorBackendName(modelName)})
+		}
+		if cors {
+			refs = append(refs,
+				PublishedResourceRef{Kind: "HTTPRouteFilter", APIVersion: "gateway.envoyproxy.io/v1alpha1", Namespace: "envoy-gateway-system", Name: corsPreflightFilterName(routeName)},
+				PublishedResourceRef{Kind: "SecurityPolicy", APIVersion: "gateway.envoyproxy.io/v1alpha1", Namespace: "envoy-gateway-system", Name: corsSecurityPolicyName(routeName)},
+			)
+		}
+	}
+
+	refs = append(refs,
+		PublishedResourceRef{Kind: "BackendTrafficPolicy", APIVersion: "gateway.envoyproxy.io/v1alpha1", Namespace: "envoy-gateway-system", Name: fmt.Sprintf("published-model-rate-limit-%s-%s", namespace, modelName)},
+		PublishedResourceRef{Kind: "Secret", APIVersion: "v1", Namespace: namespace, Name: fmt.Sprintf("published-model-apikey-%s", modelName)},
+	)
+	return refs
+}
+
+// getPublishedResource fetches the resource a ref points at, returning its error if any
+func (k *K8sClient) getPublishedResource(ref PublishedResourceRef) error {
+	var err error
+	switch ref.Kind {
+	case "Secret":
+		_, err = k.GetAPIKeySecret(ref.Namespace, ref.Name)
+	case "ConfigMap":
+		_, err = k.GetConfigMapData(ref.Namespace, ref.Name)
+	default:
+		_, err = k.getGatewayResource(ref)
+	}
+	return err
+}
+
+// getGatewayResource fetches the gateway resource a ref points at
+func (k *K8sClient) getGatewayResource(ref PublishedResourceRef) (map[string]interface{}, error) {
+	switch ref.Kind {
+	case "AIGatewayRoute":
+		return k.GetAIGatewayRoute(ref.Namespace, ref.Name)
+	case "Backend":
+		return k.GetBackend(ref.Namespace, ref.Name)
+	case "AIServiceBackend":
+		return k.GetAIServiceBackend(ref.Namespace, ref.Name)
+	case "ReferenceGrant":
+		return k.GetReferenceGrant(ref.Namespace, ref.Name)
+	case "HTTPRoute":
+		return k.GetHTTPRoute(ref.Namespace, ref.Name)
+	case "HTTPRouteFilter":
+		return k.GetHTTPRouteFilter(ref.Namespace, ref.Name)
+	case "SecurityPolicy":
+		return k.GetSecurityPolicy(ref.Namespace, ref.Name)
+	case "BackendTrafficPolicy":
+		return k.GetBackendTrafficPolicy(ref.Namespace, ref.Name)
+	default:
+		return nil, fmt.Errorf("unknown resource kind %s", ref.Kind)
+	}
+}
+
+// GetPublishResources handles GET /api/models/:modelName/publish/resources
+// It lists the names, kinds and namespaces of the resources publishing creates for the model and
+// whether each exists. For a model that is not published yet, the names it would get are listed.
+func (s *PublishingService) GetPublishResources(c *gin.Context) {
+	modelName := c.Param("modelName")
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin {
+		if ns := c.Query("namespace"); ns != "" {
+			namespace = ns
+		}
+	}
+
+	response := PublishResourcesResponse{
+		ModelName: modelName,
+		Namespace: namespace,
+	}
+
+	mirror, cors := false, false
+	if publishedModel, err := s.getPublishedModelMetadata(namespace, modelName); err == nil {
+		response.Published = true
+		response.ModelType = publishedModel.ModelType
+		mirror = publishedModel.Mirror != nil
+		cors = publishedModel.CORS != nil
+	} else {
+		// Not published: list the names publishing would use for the detected or requested type
+		response.ModelType = c.Query("modelType")
+		if response.ModelType == "" {
+			detectedType, _, err := s.detectModelType(namespace, modelName)
+			if err != nil {
+				c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+					Error:   "Model not found",
+					Details: err.Error(),
+				})
+				return
+			}
+			response.ModelType = detectedType
+		}
+		if response.ModelType != "openai" && response.ModelType != "traditional" {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "modelType must be 'traditional' or 'openai'",
+			})
+			return
+		}
+	}
+
+	refs := append([]PublishedResourceRef{
+		{Kind: "ConfigMap", APIVersion: "v1", Namespace: namespace, Name: fmt.Sprintf("published-model-metadata-%s", modelName)},
+	}, publishedResourceRefs(namespace, modelName, response.ModelType, mirror, cors)...)
+
+	for i := range refs {
+		err := s.k8sClient.getPublishedResource(refs[i])
+		refs[i].Exists = err == nil
+		if err != nil && !IsNotFound(err) {
+			refs[i].Error = err.Error()
+		}
+	}
+	response.Resources = refs
+
+	c.JSON(http.StatusOK, response)
+}
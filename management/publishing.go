@@ -1,23 +1,33 @@
 package main
 
 import (
-	"crypto/rand"
-	"encoding/base64"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
+	"math"
+	"net"
 	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/retry"
 )
 
 // PublishingService handles model publishing operations
 type PublishingService struct {
 	k8sClient   *K8sClient
 	authService *AuthService
-	config      *Config
 }
 
 // NewPublishingService creates a new publishing service
@@ -25,7 +35,6 @@ func NewPublishingService(k8sClient *K8sClient, authService *AuthService) *Publi
 	return &PublishingService{
 		k8sClient:   k8sClient,
 		authService: authService,
-		config:      NewConfig(),
 	}
 }
 
@@ -71,10 +80,16 @@ func (s *PublishingService) PublishModel(c *gin.Context) {
 		return
 	}
 
+	s.publishWithConfig(c, u, modelName, req.Config, "published", "Model published successfully")
+}
+
+// publishWithConfig validates config and creates every resource of a published model, rolling
+// back on failure. action is recorded in the audit log and message is returned on success.
+func (s *PublishingService) publishWithConfig(c *gin.Context, u *User, modelName string, config PublishConfig, action, message string) {
 	// Determine namespace
 	namespace := u.Tenant
-	if u.IsAdmin && req.Config.TenantID != "" {
-		namespace = req.Config.TenantID
+	if u.IsAdmin && config.TenantID != "" {
+		namespace = config.TenantID
 	}
 
 	// Validate user permissions
@@ -89,9 +104,16 @@ func (s *PublishingService) PublishModel(c *gin.Context) {
 	errorReporter := NewErrorReporter(s)
 	rollback := NewPublishingRollback(s, namespace, modelName)
 	
+	var warnings []string
+	submittedRateLimiting := config.RateLimiting
+	config.RateLimiting = applyDefaultRateLimit(config.RateLimiting, ActiveConfig().DefaultRateLimit)
+	if config.RateLimiting != submittedRateLimiting {
+		warnings = append(warnings, fmt.Sprintf("rateLimiting was not fully set; the default of %d requests per minute and %d per hour was applied", config.RateLimiting.RequestsPerMinute, config.RateLimiting.RequestsPerHour))
+	}
+	
 	// Validate publishing request
 	validator := NewPublishingValidator(s)
-	if validationErrors := validator.ValidatePublishRequest(namespace, modelName, req.Config); len(validationErrors) > 0 {
+	if validationErrors := validator.ValidatePublishRequest(namespace, modelName, config); len(validationErrors) > 0 {
 		var errorMessages []string
 		for _, err := range validationErrors {
 			errorMessages = append(errorMessages, err.Error())
@@ -113,9 +135,10 @@ func (s *PublishingService) PublishModel(c *gin.Context) {
 	}
 
 	// Detect model type if not specified
-	modelType := req.Config.ModelType
+	modelType := config.ModelType
+	modelTypeReason := "set in config.modelType"
 	if modelType == "" {
-		detectedType, err := s.detectModelType(namespace, modelName)
+		detectedType, reason, err := s.detectModelType(namespace, modelName)
 		if err != nil {
 			publishingErr := NewPublishingError(ErrModelNotFound, "Failed to detect model type", namespace, modelName, "model_detection", err)
 			errorReporter.ReportError(u, namespace, modelName, "detect_model_type", publishingErr)
@@ -126,15 +149,21 @@ func (s *PublishingService) PublishModel(c *gin.Context) {
 			return
 		}
 		modelType = detectedType
+		modelTypeReason = reason
+		warnings = append(warnings, fmt.Sprintf("Model type was auto-detected as %s (%s); set config.modelType to override", modelType, reason))
+	}
+	if modelType == "openai" {
+		config.OpenAI = s.withServedModelName(namespace, modelName, config.OpenAI)
 	}
 
 	// Apply defaults if not provided
-	if req.Config.PublicHostname == "" {
-		req.Config.PublicHostname = "api.router.inference-in-a-box"
+	if config.PublicHostname == "" {
+		config.PublicHostname = "api.router.inference-in-a-box"
 	}
+	newListener := s.hostnameNeedsListener(config.PublicHostname)
 
 	// Step 1: Generate API key
-	_, apiKey, err := s.generateAPIKey(u, modelName, namespace, modelType)
+	keyMetadata, apiKey, err := s.generateAPIKey(u, modelName, namespace, modelType)
 	if err != nil {
 		publishingErr := NewPublishingError(ErrAPIKeyGenerationFailed, "Failed to generate API key", namespace, modelName, "api_key_generation", err)
 		errorReporter.ReportError(u, namespace, modelName, "generate_api_key", publishingErr)
@@ -147,7 +176,7 @@ func (s *PublishingService) PublishModel(c *gin.Context) {
 	rollback.AddStep("api_key")
 
 	// Step 2: Create gateway configuration
-	externalURL, err := s.createGatewayConfiguration(namespace, modelName, modelType, req.Config)
+	externalURL, err := s.createGatewayConfiguration(namespace, modelName, modelType, config)
 	if err != nil {
 		publishingErr := NewPublishingError(ErrGatewayConfigFailed, "Failed to create gateway configuration", namespace, modelName, "gateway_config", err)
 		errorReporter.ReportError(u, namespace, modelName, "create_gateway_config", publishingErr)
@@ -161,7 +190,7 @@ func (s *PublishingService) PublishModel(c *gin.Context) {
 	rollback.AddStep("gateway_config")
 
 	// Step 3: Create rate limiting policy
-	if err := s.createRateLimitingPolicy(namespace, modelName, req.Config.RateLimiting); err != nil {
+	if err := s.createRateLimitingPolicy(namespace, modelName, config.RateLimiting); err != nil {
 		publishingErr := NewPublishingError(ErrRateLimitConfigFailed, "Failed to create rate limiting policy", namespace, modelName, "rate_limiting", err)
 		errorReporter.ReportError(u, namespace, modelName, "create_rate_limiting", publishingErr)
 		rollback.Execute()
@@ -174,7 +203,7 @@ func (s *PublishingService) PublishModel(c *gin.Context) {
 	rollback.AddStep("rate_limiting")
 
 	// Step 4: Generate documentation
-	documentation := s.generateAPIDocumentation(namespace, modelName, modelType, externalURL, apiKey)
+	documentation := s.generateAPIDocumentation(namespace, modelName, modelType, externalURL, apiKey, parseInputNames(config.Metadata), config.OpenAI)
 
 	// Step 5: Create published model response
 	publishedModel := PublishedModel{
@@ -183,15 +212,25 @@ func (s *PublishingService) PublishModel(c *gin.Context) {
 		TenantID:       namespace,
 		ModelType:      modelType,
 		ExternalURL:    externalURL,
-		PublicHostname: req.Config.PublicHostname,
+		PublicHostname: config.PublicHostname,
 		APIKey:         apiKey,
-		RateLimiting:   req.Config.RateLimiting,
+		APIKeyID:       keyMetadata.KeyID,
+		RateLimiting:   config.RateLimiting,
 		Status:         "active",
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 		Usage:          UsageStats{},
 		Documentation:  documentation,
+		CacheTTL:       config.CacheTTL,
+		PathMappings:   config.PathMappings,
+		Mirror:         config.Mirror,
+		PredictMethods: config.PredictMethods,
+		CORS:           config.CORS,
+		OpenAI:         config.OpenAI,
+		RequestTransform: config.RequestTransform,
+		ErrorRateThreshold: config.ErrorRateThreshold,
 	}
+	publishedModel.Config = submittedPublishConfig(config, modelType, externalURL)
 
 	// Step 6: Store published model metadata
 	if err := s.storePublishedModelMetadata(namespace, modelName, publishedModel); err != nil {
@@ -207,11 +246,18 @@ func (s *PublishingService) PublishModel(c *gin.Context) {
 	rollback.AddStep("metadata")
 
 	// Log the publishing event
-	s.logPublishingEvent(u, modelName, namespace, "published")
+	s.logPublishingEvent(u, modelName, namespace, action)
+
+	dnsInstructions := s.generateDNSInstructions(config.PublicHostname)
+	warnings = append(warnings, s.publishConfigWarnings(modelType, config, newListener, dnsInstructions)...)
+	publishedModel.InternalTarget = s.resolveInternalTarget(namespace, modelName, modelType)
 
 	c.JSON(http.StatusOK, PublishModelResponse{
-		Message:       "Model published successfully",
+		Message:       message,
 		PublishedModel: publishedModel,
+		DNSInstructions: dnsInstructions,
+		ModelTypeReason: modelTypeReason,
+		Warnings:        warnings,
 	})
 }
 
@@ -268,6 +314,16 @@ func (s *PublishingService) UpdatePublishedModel(c *gin.Context) {
 		return
 	}
 
+	// Read the version before the metadata, so a write in between fails the conditional update below
+	resourceVersion, err := s.k8sClient.GetPublishedModelMetadataVersion(namespace, modelName)
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to get current published model",
+			Details: err.Error(),
+		})
+		return
+	}
+
 	// Get current published model metadata
 	currentModel, err := s.getPublishedModelMetadata(namespace, modelName)
 	if err != nil {
@@ -278,10 +334,27 @@ func (s *PublishingService) UpdatePublishedModel(c *gin.Context) {
 		return
 	}
 
+	// Honor If-Match so concurrent editors cannot overwrite each other's changes
+	if ifMatch := c.GetHeader("If-Match"); ifMatch != "" {
+		if !publishedModelETagMatches(ifMatch, resourceVersion, currentModel.UpdatedAt) {
+			c.Header("ETag", publishedModelETag(resourceVersion))
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error:   "Published model was modified since it was read",
+				Details: fmt.Sprintf("current version is %s, updated at %s", publishedModelETag(resourceVersion), currentModel.UpdatedAt.Format(time.RFC3339Nano)),
+			})
+			return
+		}
+	} else {
+		resourceVersion = ""
+	}
+
 	// Create error reporter and rollback handler
 	errorReporter := NewErrorReporter(s)
 	rollback := NewPublishingRollback(s, namespace, modelName)
 
+	// Omitted limits keep the current ones
+	req.Config.RateLimiting = applyDefaultRateLimit(req.Config.RateLimiting, currentModel.RateLimiting)
+
 	// Validate the update request
 	validator := NewPublishingValidator(s)
 	if validationErrors := validator.ValidateUpdateRequest(namespace, modelName, req.Config, currentModel); len(validationErrors) > 0 {
@@ -302,8 +375,18 @@ func (s *PublishingService) UpdatePublishedModel(c *gin.Context) {
 		req.Config.PublicHostname = "api.router.inference-in-a-box"
 	}
 
-	// Update gateway configuration if hostname or path changed
-	if req.Config.PublicHostname != currentModel.PublicHostname || req.Config.ExternalPath != "" {
+	newListener := s.hostnameNeedsListener(req.Config.PublicHostname)
+	if currentModel.ModelType == "openai" {
+		req.Config.OpenAI = s.withServedModelName(namespace, modelName, req.Config.OpenAI)
+	}
+
+	// Update gateway configuration if hostname, path, path mappings, mirror, methods, CORS or served model name changed
+	if req.Config.PublicHostname != currentModel.PublicHostname || req.Config.ExternalPath != "" ||
+		!pathMappingsEqual(req.Config.PathMappings, currentModel.PathMappings) ||
+		!reflect.DeepEqual(req.Config.Mirror, currentModel.Mirror) ||
+		!reflect.DeepEqual(req.Config.CORS, currentModel.CORS) ||
+		servedModelName(modelName, req.Config.OpenAI) != servedModelName(modelName, currentModel.OpenAI) ||
+		!reflect.DeepEqual(effectivePredictMethods(req.Config.PredictMethods), effectivePredictMethods(currentModel.PredictMethods)) {
 		// First cleanup old gateway config
 		s.cleanupGatewayConfiguration(namespace, modelName)
 		rollback.AddStep("cleanup_old_gateway")
@@ -322,6 +405,10 @@ func (s *PublishingService) UpdatePublishedModel(c *gin.Context) {
 		}
 		currentModel.ExternalURL = externalURL
 		currentModel.PublicHostname = req.Config.PublicHostname
+		currentModel.PathMappings = req.Config.PathMappings
+		currentModel.Mirror = req.Config.Mirror
+		currentModel.PredictMethods = req.Config.PredictMethods
+		currentModel.CORS = req.Config.CORS
 		rollback.AddStep("gateway_config")
 	}
 
@@ -329,7 +416,9 @@ func (s *PublishingService) UpdatePublishedModel(c *gin.Context) {
 	if req.Config.RateLimiting.RequestsPerMinute != currentModel.RateLimiting.RequestsPerMinute ||
 		req.Config.RateLimiting.RequestsPerHour != currentModel.RateLimiting.RequestsPerHour ||
 		req.Config.RateLimiting.TokensPerHour != currentModel.RateLimiting.TokensPerHour ||
-		req.Config.RateLimiting.BurstLimit != currentModel.RateLimiting.BurstLimit {
+		req.Config.RateLimiting.BurstLimit != currentModel.RateLimiting.BurstLimit ||
+		req.Config.RateLimiting.MaxRequestBytes != currentModel.RateLimiting.MaxRequestBytes ||
+		req.Config.RateLimiting.MaxConcurrentConnections != currentModel.RateLimiting.MaxConcurrentConnections {
 		
 		// Cleanup old rate limiting policy
 		s.cleanupRateLimitingPolicy(namespace, modelName)
@@ -349,6 +438,13 @@ func (s *PublishingService) UpdatePublishedModel(c *gin.Context) {
 		rollback.AddStep("rate_limiting")
 	}
 
+	// Prediction caching and request transformation are handled by the management proxy, so no gateway changes are needed
+	currentModel.CacheTTL = req.Config.CacheTTL
+	currentModel.RequestTransform = req.Config.RequestTransform
+	currentModel.ErrorRateThreshold = req.Config.ErrorRateThreshold
+	currentModel.OpenAI = req.Config.OpenAI
+	currentModel.Config = submittedPublishConfig(req.Config, currentModel.ModelType, currentModel.ExternalURL)
+
 	// Update metadata
 	currentModel.UpdatedAt = time.Now()
 	if req.Config.Metadata != nil {
@@ -358,13 +454,21 @@ func (s *PublishingService) UpdatePublishedModel(c *gin.Context) {
 	}
 
 	// Regenerate documentation with updated URL
-	currentModel.Documentation = s.generateAPIDocumentation(namespace, modelName, currentModel.ModelType, currentModel.ExternalURL, currentModel.APIKey)
+	currentModel.Documentation = s.generateAPIDocumentation(namespace, modelName, currentModel.ModelType, currentModel.ExternalURL, currentModel.APIKey, parseInputNames(req.Config.Metadata), currentModel.OpenAI)
 
-	// Store updated metadata
-	if err := s.storePublishedModelMetadata(namespace, modelName, *currentModel); err != nil {
+	// Store updated metadata, conditional on the version the If-Match header was checked against
+	newResourceVersion, err := s.k8sClient.UpdatePublishedModelMetadataIfMatch(namespace, modelName, publishedModelMetadataMap(*currentModel), resourceVersion)
+	if err != nil {
+		rollback.Execute()
+		if IsConflict(err) {
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error:   "Published model was modified since it was read",
+				Details: err.Error(),
+			})
+			return
+		}
 		publishingErr := NewPublishingError("METADATA_UPDATE_FAILED", "Failed to update published model metadata", namespace, modelName, "metadata_update", err)
 		errorReporter.ReportError(u, namespace, modelName, "update_metadata", publishingErr)
-		rollback.Execute()
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   publishingErr.Message,
 			Details: publishingErr.Details,
@@ -375,9 +479,15 @@ func (s *PublishingService) UpdatePublishedModel(c *gin.Context) {
 	// Log the update event
 	s.logPublishingEvent(u, modelName, namespace, "updated")
 
+	c.Header("ETag", publishedModelETag(newResourceVersion))
+
+	dnsInstructions := s.generateDNSInstructions(currentModel.PublicHostname)
+	currentModel.InternalTarget = s.resolveInternalTarget(namespace, modelName, currentModel.ModelType)
 	c.JSON(http.StatusOK, PublishModelResponse{
 		Message:        "Published model updated successfully",
 		PublishedModel: *currentModel,
+		DNSInstructions: dnsInstructions,
+		Warnings:        s.publishConfigWarnings(currentModel.ModelType, req.Config, newListener, dnsInstructions),
 	})
 }
 
@@ -408,10 +518,18 @@ func (s *PublishingService) UnpublishModel(c *gin.Context) {
 		if ns := c.Query("namespace"); ns != "" {
 			namespace = ns
 		} else {
-			// If no namespace specified, find where the model is published
-			foundNamespace := s.findModelPublishedNamespace(modelName)
-			if foundNamespace != "" {
-				namespace = foundNamespace
+			// If no namespace specified, find where the model is published. A name published in
+			// several namespaces is ambiguous, so the admin has to pick one.
+			foundNamespaces := s.findModelPublishedNamespaces(modelName)
+			if len(foundNamespaces) > 1 {
+				c.JSON(http.StatusConflict, ErrorResponse{
+					Error:   fmt.Sprintf("Model %s is published in more than one namespace", modelName),
+					Details: fmt.Sprintf("Published in %s; set ?namespace= to choose one", strings.Join(foundNamespaces, ", ")),
+				})
+				return
+			}
+			if len(foundNamespaces) == 1 {
+				namespace = foundNamespaces[0]
 			}
 		}
 	}
@@ -433,10 +551,7 @@ func (s *PublishingService) UnpublishModel(c *gin.Context) {
 	}
 
 	// Clean up all resources
-	s.cleanupAPIKey(namespace, modelName)
-	s.cleanupGatewayConfiguration(namespace, modelName)
-	s.cleanupRateLimitingPolicy(namespace, modelName)
-	s.cleanupPublishedModelMetadata(namespace, modelName)
+	s.cleanupPublishedResources(namespace, modelName)
 
 	// Log the unpublishing event
 	s.logPublishingEvent(u, modelName, namespace, "unpublished")
@@ -446,8 +561,121 @@ func (s *PublishingService) UnpublishModel(c *gin.Context) {
 	})
 }
 
-// GetPublishedModel handles GET /api/models/:modelName/publish
-func (s *PublishingService) GetPublishedModel(c *gin.Context) {
+// ForceUnpublishModel handles DELETE /api/admin/publish/:modelName/force
+// It removes every resource labeled for the model in any namespace, even when the
+// published-model metadata is missing or inconsistent.
+func (s *PublishingService) ForceUnpublishModel(c *gin.Context) {
+	modelName := c.Param("modelName")
+	
+	// Get user from JWT context
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	if !u.IsAdmin {
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error: "Admin access required",
+		})
+		return
+	}
+
+	response := ForceUnpublishResponse{
+		ModelName: modelName,
+		Removed:   []RemovedResource{},
+	}
+	namespaces := make(map[string]bool)
+	modelSelector := fmt.Sprintf("app=published-model,model-name=%s", modelName)
+
+	// Gateway resources (routes, backends, policies, grants) carry the model-name label
+	gatewayResources := []struct {
+		kind string
+		gvr  schema.GroupVersionResource
+	}{
+		{"HTTPRoute", HTTPRouteGVR},
+		{"HTTPRouteFilter", HTTPRouteFilterGVR},
+		{"AIGatewayRoute", AIGatewayRouteGVR},
+		{"BackendTrafficPolicy", BackendTrafficPolicyGVR},
+		{"AIServiceBackend", AIServiceBackendGVR},
+		{"Backend", BackendGVR},
+		{"ReferenceGrant", ReferenceGrantGVR},
+		{"SecurityPolicy", SecurityPolicyGVR},
+	}
+	for _, resource := range gatewayResources {
+		items, err := s.k8sClient.ListResourcesByLabel(resource.gvr, modelSelector)
+		if err != nil {
+			if !IsNotFound(err) {
+				response.Errors = append(response.Errors, fmt.Sprintf("list %s: %v", resource.kind, err))
+			}
+			continue
+		}
+		for _, item := range items {
+			if tenant := item.GetLabels()["tenant"]; tenant != "" {
+				namespaces[tenant] = true
+			}
+			if err := s.k8sClient.DeleteResource(resource.gvr, item.GetNamespace(), item.GetName()); err != nil && !IsNotFound(err) {
+				response.Errors = append(response.Errors, fmt.Sprintf("delete %s %s/%s: %v", resource.kind, item.GetNamespace(), item.GetName(), err))
+				continue
+			}
+			response.Removed = append(response.Removed, RemovedResource{Kind: resource.kind, Namespace: item.GetNamespace(), Name: item.GetName()})
+		}
+	}
+
+	// Published-model metadata
+	configMaps, err := s.k8sClient.ListConfigMapsByLabel(modelSelector + ",type=metadata")
+	if err != nil {
+		response.Errors = append(response.Errors, fmt.Sprintf("list ConfigMap: %v", err))
+	}
+	for _, configMap := range configMaps {
+		namespaces[configMap.Namespace] = true
+		if err := s.k8sClient.DeletePublishedModelMetadata(configMap.Namespace, modelName); err != nil && !IsNotFound(err) {
+			response.Errors = append(response.Errors, fmt.Sprintf("delete ConfigMap %s/%s: %v", configMap.Namespace, configMap.Name, err))
+			continue
+		}
+		response.Removed = append(response.Removed, RemovedResource{Kind: "ConfigMap", Namespace: configMap.Namespace, Name: configMap.Name})
+	}
+
+	// API key secrets are not labeled with the model name, so match them by name
+	secretName := fmt.Sprintf("published-model-apikey-%s", modelName)
+	secrets, err := s.k8sClient.ListSecretsByLabel("app=published-model,type=apikey")
+	if err != nil {
+		response.Errors = append(response.Errors, fmt.Sprintf("list Secret: %v", err))
+	}
+	for _, secret := range secrets {
+		if secret.Name != secretName {
+			continue
+		}
+		namespaces[secret.Namespace] = true
+		if err := s.k8sClient.DeleteAPIKeySecret(secret.Namespace, secret.Name); err != nil && !IsNotFound(err) {
+			response.Errors = append(response.Errors, fmt.Sprintf("delete Secret %s/%s: %v", secret.Namespace, secret.Name, err))
+			continue
+		}
+		response.Removed = append(response.Removed, RemovedResource{Kind: "Secret", Namespace: secret.Namespace, Name: secret.Name})
+	}
+
+	// Log the event in every tenant namespace that held resources for the model
+	for namespace := range namespaces {
+		s.logPublishingEvent(u, modelName, namespace, "force-unpublished")
+	}
+
+	response.Message = fmt.Sprintf("Removed %d resource(s) for model %s", len(response.Removed), modelName)
+	c.JSON(http.StatusOK, response)
+}
+
+// RefreshPublishedDocs handles POST /api/models/:modelName/publish/refresh-docs
+// It regenerates the stored documentation from the current URL, API key and model type.
+func (s *PublishingService) RefreshPublishedDocs(c *gin.Context) {
 	modelName := c.Param("modelName")
 	
 	// Get user from JWT context
@@ -474,29 +702,62 @@ func (s *PublishingService) GetPublishedModel(c *gin.Context) {
 		}
 	}
 
-	// Validate user permissions
-	if !u.IsAdmin && u.Tenant != namespace {
-		c.JSON(http.StatusForbidden, ErrorResponse{
-			Error: "Insufficient permissions for tenant: " + namespace,
-		})
+	// Work on the raw metadata so fields the converter does not read are preserved
+	metadata, err := s.k8sClient.GetPublishedModelMetadata(namespace, modelName)
+	if err != nil {
+		if IsNotFound(err) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: "Published model not found",
+			})
+		} else {
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+				Error:   "Failed to get published model",
+				Details: err.Error(),
+			})
+		}
 		return
 	}
 
-	// Get published model metadata
-	publishedModel, err := s.getPublishedModelMetadata(namespace, modelName)
+	publishedModel, err := s.convertMetadataToModel(metadata)
 	if err != nil {
-		c.JSON(http.StatusNotFound, ErrorResponse{
-			Error:   "Published model not found",
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to read published model metadata",
 			Details: err.Error(),
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, publishedModel)
+	inputNames := parseInputNames(map[string]string{"inputs": c.Query("inputs")})
+	publishedModel.Documentation = s.generateAPIDocumentation(namespace, modelName, publishedModel.ModelType, publishedModel.ExternalURL, publishedModel.APIKey, inputNames, publishedModel.OpenAI)
+	publishedModel.UpdatedAt = time.Now()
+
+	scrubLegacyAPIKey(metadata)
+	metadata["documentation"] = storedDocumentation(*publishedModel)
+	metadata["updatedAt"] = publishedModel.UpdatedAt
+	if err := s.k8sClient.UpdatePublishedModelMetadata(namespace, modelName, metadata); err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to store refreshed documentation",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	s.logPublishingEvent(u, modelName, namespace, "docs-refreshed")
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Documentation refreshed successfully",
+		"documentation": publishedModel.Documentation,
+	})
 }
 
-// ListPublishedModels handles GET /api/published-models
-func (s *PublishingService) ListPublishedModels(c *gin.Context) {
+// Placeholder shown instead of an API key in previewed documentation
+const previewAPIKeyPlaceholder = "<your-api-key>"
+
+// PreviewPublishDocs handles GET /api/models/:modelName/publish/preview-docs
+// It generates the documentation publishing would produce, without creating any resources.
+func (s *PublishingService) PreviewPublishDocs(c *gin.Context) {
+	modelName := c.Param("modelName")
+	
 	// Get user from JWT context
 	user, exists := c.Get("user")
 	if !exists {
@@ -514,33 +775,97 @@ func (s *PublishingService) ListPublishedModels(c *gin.Context) {
 		return
 	}
 
-	var publishedModels []PublishedModel
-	var err error
-
+	namespace := u.Tenant
 	if u.IsAdmin {
-		// Admin can see all published models
-		publishedModels, err = s.listAllPublishedModels()
-	} else {
-		// Regular users see only their tenant's published models
-		publishedModels, err = s.listPublishedModelsByTenant(u.Tenant)
+		if ns := c.Query("namespace"); ns != "" {
+			namespace = ns
+		}
 	}
 
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to list published models",
-			Details: err.Error(),
+	config := PublishConfig{
+		TenantID:       namespace,
+		ModelType:      c.Query("modelType"),
+		PublicHostname: c.Query("hostname"),
+		ExternalPath:   c.Query("path"),
+	}
+
+	validator := NewPublishingValidator(s)
+	if config.ModelType != "" && config.ModelType != "traditional" && config.ModelType != "openai" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Model type must be 'traditional' or 'openai'",
+		})
+		return
+	}
+	if config.ExternalPath != "" && !strings.HasPrefix(config.ExternalPath, "/") {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "External path must start with '/'",
 		})
 		return
 	}
+	if config.PublicHostname != "" {
+		if validationErr := validator.validateHostname(config.PublicHostname); validationErr != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid hostname",
+				Details: validationErr.Error(),
+			})
+			return
+		}
+	}
 
-	c.JSON(http.StatusOK, ListPublishedModelsResponse{
-		PublishedModels: publishedModels,
-		Total:           len(publishedModels),
+	modelTypeReason := "set in modelType query parameter"
+	if config.ModelType == "" {
+		detectedType, reason, err := s.detectModelType(namespace, modelName)
+		if err != nil {
+			status := HTTPStatusForK8sError(err)
+			message := "Failed to detect model type"
+			if IsNotFound(err) {
+				message = "Model not found"
+			}
+			c.JSON(status, ErrorResponse{
+				Error:   message,
+				Details: err.Error(),
+			})
+			return
+		}
+		config.ModelType = detectedType
+		modelTypeReason = reason
+	}
+
+	externalURL := publishedExternalURL(modelName, config)
+	inputNames := parseInputNames(map[string]string{"inputs": c.Query("inputs")})
+
+	c.JSON(http.StatusOK, PublishDocsPreviewResponse{
+		ModelName:       modelName,
+		Namespace:       namespace,
+		ModelType:       config.ModelType,
+		ModelTypeReason: modelTypeReason,
+		ExternalURL:     externalURL,
+		Documentation:   s.generateAPIDocumentation(namespace, modelName, config.ModelType, externalURL, previewAPIKeyPlaceholder, inputNames, config.OpenAI),
 	})
 }
 
-// RotateAPIKey handles POST /api/models/:modelName/publish/rotate-key
-func (s *PublishingService) RotateAPIKey(c *gin.Context) {
+// publishedExternalURL returns the URL a model will be published at, using the same hostname and
+// path defaults as createHTTPRoute and createAIGatewayRoute
+func publishedExternalURL(modelName string, config PublishConfig) string {
+	hostname := config.PublicHostname
+	if hostname == "" {
+		hostname = "api.router.inference-in-a-box"
+	}
+	
+	externalPath := config.ExternalPath
+	if externalPath == "" {
+		if config.ModelType == "openai" {
+			externalPath = fmt.Sprintf("/v1/models/%s", modelName)
+		} else {
+			externalPath = fmt.Sprintf("/published/models/%s", modelName)
+		}
+	}
+	
+	return fmt.Sprintf("https://%s%s", hostname, externalPath)
+}
+
+// GetPublishedModel handles GET /api/models/:modelName/publish
+func (s *PublishingService) GetPublishedModel(c *gin.Context) {
 	modelName := c.Param("modelName")
 	
 	// Get user from JWT context
@@ -575,88 +900,688 @@ func (s *PublishingService) RotateAPIKey(c *gin.Context) {
 		return
 	}
 
-	// Check if model is published
-	if !s.isModelPublished(namespace, modelName) {
-		c.JSON(http.StatusNotFound, ErrorResponse{
-			Error: "Model is not published",
-		})
-		return
-	}
-
-	// Get current published model metadata
+	// Get published model metadata
 	publishedModel, err := s.getPublishedModelMetadata(namespace, modelName)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to get published model metadata",
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "Published model not found",
 			Details: err.Error(),
 		})
 		return
 	}
 
-	// Generate new API key
-	_, newAPIKey, err := s.generateAPIKey(u, modelName, namespace, publishedModel.ModelType)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to generate new API key",
-			Details: err.Error(),
-		})
-		return
+	if publishedModel.Config == nil {
+		config := publishConfigFromModel(*publishedModel)
+		publishedModel.Config = &config
+	}
+	publishedModel.InternalTarget = s.resolveInternalTarget(namespace, modelName, publishedModel.ModelType)
+	if errorRate, err := s.currentErrorRate(*publishedModel); err == nil {
+		publishedModel.ErrorRate = errorRate
 	}
 
-	// Update published model metadata
-	publishedModel.APIKey = newAPIKey
-	publishedModel.UpdatedAt = time.Now()
-
-	if err := s.storePublishedModelMetadata(namespace, modelName, *publishedModel); err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to update published model metadata",
-			Details: err.Error(),
-		})
-		return
+	// The ETag lets clients make their next update conditional with If-Match
+	if resourceVersion, err := s.k8sClient.GetPublishedModelMetadataVersion(namespace, modelName); err == nil {
+		c.Header("ETag", publishedModelETag(resourceVersion))
 	}
 
-	// Log the key rotation event
-	s.logPublishingEvent(u, modelName, namespace, "api_key_rotated")
+	c.JSON(http.StatusOK, publishedModel)
+}
 
-	c.JSON(http.StatusOK, RotateAPIKeyResponse{
-		Message:   "API key rotated successfully",
-		NewAPIKey: newAPIKey,
-		UpdatedAt: time.Now(),
-	})
+// publishedModelETag formats a metadata ConfigMap resourceVersion as an HTTP entity tag
+func publishedModelETag(resourceVersion string) string {
+	return fmt.Sprintf("%q", resourceVersion)
 }
 
-// ValidateAPIKey handles POST /api/validate-api-key (for gateway)
-func (s *PublishingService) ValidateAPIKey(c *gin.Context) {
-	apiKey := c.GetHeader("X-API-Key")
-	if apiKey == "" {
-		apiKey = c.GetHeader("Authorization")
-		if strings.HasPrefix(apiKey, "Bearer ") {
-			apiKey = strings.TrimPrefix(apiKey, "Bearer ")
+// publishedModelETagMatches reports whether an If-Match header still matches the published
+// model. Each listed value may be "*", the ETag returned by a previous read, or the model's
+// updatedAt timestamp.
+func publishedModelETagMatches(ifMatch, resourceVersion string, updatedAt time.Time) bool {
+	for _, value := range strings.Split(ifMatch, ",") {
+		value = strings.Trim(strings.TrimPrefix(strings.TrimSpace(value), "W/"), `"`)
+		if value == "*" || value == resourceVersion {
+			return true
+		}
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil && t.Equal(updatedAt) {
+			return true
 		}
 	}
+	return false
+}
 
-	if apiKey == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"error": "API key required",
+// ListPublishedModels handles GET /api/published-models
+func (s *PublishingService) ListPublishedModels(c *gin.Context) {
+	// Get user from JWT context
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
 		})
 		return
 	}
 
-	// Validate API key
-	metadata, err := s.validateAPIKey(apiKey)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"error": "Invalid API key",
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
 		})
 		return
 	}
 
-	// Update last used time
-	s.updateAPIKeyLastUsed(metadata.Namespace, metadata.ModelName)
-
-	// Set headers for upstream
-	c.Header("X-Tenant-ID", metadata.TenantID)
-	c.Header("X-Model-Name", metadata.ModelName)
+	sortField := c.DefaultQuery("sort", "modelName")
+	if sortField != "createdAt" && sortField != "updatedAt" && sortField != "modelName" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Sort must be one of createdAt, updatedAt or modelName",
+		})
+		return
+	}
+	order := c.DefaultQuery("order", "asc")
+	if order != "asc" && order != "desc" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Order must be asc or desc",
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 || limit > 500 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Limit must be between 1 and 500",
+		})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Offset must be a non-negative integer",
+		})
+		return
+	}
+	status := c.Query("status")
+	if status != "" && status != "active" && status != "degraded" && status != "orphaned" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Status must be one of active, degraded or orphaned",
+		})
+		return
+	}
+
+	var publishedModels []PublishedModel
+
+	if u.IsAdmin {
+		// Admin can see all published models
+		publishedModels, err = s.listAllPublishedModels()
+	} else {
+		// Regular users see only their tenant's published models
+		publishedModels, err = s.listPublishedModelsByTenant(u.Tenant)
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to list published models",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if status != "" {
+		filtered := []PublishedModel{}
+		for _, model := range publishedModels {
+			if model.Status == status {
+				filtered = append(filtered, model)
+			}
+		}
+		publishedModels = filtered
+	}
+
+	sortPublishedModels(publishedModels, sortField, order == "desc")
+
+	total := len(publishedModels)
+	page := []PublishedModel{}
+	if offset < total {
+		end := offset + limit
+		if end > total {
+			end = total
+		}
+		page = publishedModels[offset:end]
+	}
+
+	c.JSON(http.StatusOK, ListPublishedModelsResponse{
+		PublishedModels: page,
+		Total:           total,
+		Limit:           limit,
+		Offset:          offset,
+	})
+}
+
+// LookupPublishedModel handles GET /api/published-models/lookup
+// It reports which published model serves a public hostname and path.
+func (s *PublishingService) LookupPublishedModel(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	host := c.Query("host")
+	if host == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Query parameter host is required",
+		})
+		return
+	}
+	path := c.DefaultQuery("path", "/")
+	if !strings.HasPrefix(path, "/") {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Path must start with '/'",
+		})
+		return
+	}
+
+	metadata, err := s.k8sClient.FindPublishedModelByHostnamePath(host, path)
+	if err != nil {
+		if IsNotFound(err) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: fmt.Sprintf("No published model serves %s%s", host, path),
+			})
+		} else {
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+				Error:   "Failed to look up published model",
+				Details: err.Error(),
+			})
+		}
+		return
+	}
+
+	publishedModel, err := s.convertMetadataToModel(metadata)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to read published model metadata",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Models of other tenants are reported as not found so the lookup does not reveal them
+	if !u.IsAdmin && publishedModel.Namespace != u.Tenant {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: fmt.Sprintf("No published model serves %s%s", host, path),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, publishedModel)
+}
+
+// RotateAPIKey handles POST /api/models/:modelName/publish/rotate-key
+func (s *PublishingService) RotateAPIKey(c *gin.Context) {
+	modelName := c.Param("modelName")
+	
+	// Get user from JWT context
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin {
+		if ns := c.Query("namespace"); ns != "" {
+			namespace = ns
+		}
+	}
+
+	// Validate user permissions
+	if !u.IsAdmin && u.Tenant != namespace {
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error: "Insufficient permissions for tenant: " + namespace,
+		})
+		return
+	}
+
+	// Check if model is published
+	if !s.isModelPublished(namespace, modelName) {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Model is not published",
+		})
+		return
+	}
+
+	// Get current published model metadata
+	publishedModel, err := s.getPublishedModelMetadata(namespace, modelName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to get published model metadata",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Generate new API key
+	keyMetadata, newAPIKey, err := s.generateAPIKey(u, modelName, namespace, publishedModel.ModelType)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to generate new API key",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Update published model metadata
+	publishedModel.APIKey = newAPIKey
+	publishedModel.APIKeyID = keyMetadata.KeyID
+	publishedModel.APIKeyMasked = ""
+	publishedModel.UpdatedAt = time.Now()
+
+	if err := s.storePublishedModelMetadata(namespace, modelName, *publishedModel); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to update published model metadata",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Log the key rotation event
+	s.logPublishingEvent(u, modelName, namespace, "api_key_rotated")
+
+	c.JSON(http.StatusOK, RotateAPIKeyResponse{
+		Message:   "API key rotated successfully",
+		NewAPIKey: newAPIKey,
+		UpdatedAt: time.Now(),
+	})
+}
+
+// GetRateLimitStatus handles GET /api/models/:modelName/publish/rate-limit-status
+// GetModelPublishErrors handles GET /api/models/:modelName/publish/errors
+func (s *PublishingService) GetModelPublishErrors(c *gin.Context) {
+	s.listPublishErrors(c, c.Param("modelName"))
+}
+
+// GetPublishErrors handles GET /api/publish/errors
+// It lists publishing errors for every model of the tenant
+func (s *PublishingService) GetPublishErrors(c *gin.Context) {
+	s.listPublishErrors(c, c.Query("model"))
+}
+
+// listPublishErrors reads the daily publishing-errors logs of a tenant and writes a page of
+// the entries in the requested time range, newest first. An empty modelName lists all models.
+func (s *PublishingService) listPublishErrors(c *gin.Context, modelName string) {
+	// Get user from JWT context
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin && c.Query("namespace") != "" {
+		namespace = c.Query("namespace")
+	}
+
+	// Default to the last 7 days
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -7)
+	if start := c.Query("start"); start != "" {
+		t, err := parseHistoryTime(start, false)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid start time",
+				Details: err.Error(),
+			})
+			return
+		}
+		startTime = t
+	}
+	if end := c.Query("end"); end != "" {
+		t, err := parseHistoryTime(end, true)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid end time",
+				Details: err.Error(),
+			})
+			return
+		}
+		endTime = t
+	}
+	if endTime.Before(startTime) {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "End time must not be before start time",
+		})
+		return
+	}
+	if endTime.Sub(startTime) > 90*24*time.Hour {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Time range cannot exceed 90 days",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 || limit > 500 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Limit must be between 1 and 500",
+		})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Offset must be a non-negative integer",
+		})
+		return
+	}
+
+	publishErrors := []PublishingErrorEntry{}
+	for _, day := range logDays(startTime, endTime) {
+		errorLogName := fmt.Sprintf("publishing-errors-%s", day)
+		errorLog, err := readDailyLog(s.k8sClient, namespace, errorLogName)
+		if err != nil {
+			continue // Skip days without errors
+		}
+
+		entries, _ := errorLog["entries"].([]interface{})
+		for _, entry := range entries {
+			entryMap, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			publishErr := convertPublishingErrorEntry(entryMap)
+			if publishErr.Timestamp.Before(startTime) || publishErr.Timestamp.After(endTime) {
+				continue
+			}
+			if modelName != "" && publishErr.ModelName != modelName {
+				continue
+			}
+			publishErrors = append(publishErrors, publishErr)
+		}
+	}
+
+	// Newest first
+	sort.SliceStable(publishErrors, func(i, j int) bool {
+		return publishErrors[i].Timestamp.After(publishErrors[j].Timestamp)
+	})
+
+	total := len(publishErrors)
+	page := []PublishingErrorEntry{}
+	if offset < total {
+		end := offset + limit
+		if end > total {
+			end = total
+		}
+		page = publishErrors[offset:end]
+	}
+
+	c.JSON(http.StatusOK, PublishingErrorsResponse{
+		Errors: page,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+// convertPublishingErrorEntry converts a stored publishing-errors entry
+func convertPublishingErrorEntry(entry map[string]interface{}) PublishingErrorEntry {
+	publishErr := PublishingErrorEntry{}
+
+	if v, ok := entry["timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			publishErr.Timestamp = t
+		}
+	}
+	publishErr.ModelName, _ = entry["model"].(string)
+	publishErr.Namespace, _ = entry["namespace"].(string)
+	publishErr.User, _ = entry["user"].(string)
+	publishErr.Operation, _ = entry["operation"].(string)
+	publishErr.Code, _ = entry["code"].(string)
+	publishErr.Step, _ = entry["step"].(string)
+	publishErr.Message, _ = entry["message"].(string)
+	publishErr.Details, _ = entry["details"].(string)
+	publishErr.Error, _ = entry["error"].(string)
+
+	return publishErr
+}
+
+// Bounds for the days query parameter of the tenant usage rollup
+const (
+	defaultTenantUsageDays = 7
+	maxTenantUsageDays     = 90
+)
+
+// GetTenantUsage handles GET /api/tenant/usage
+// Sums the usage of every published model in the tenant over the last N days, with a per-model breakdown
+func (s *PublishingService) GetTenantUsage(c *gin.Context) {
+	// Get user from JWT context
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin {
+		if ns := c.Query("namespace"); ns != "" {
+			namespace = ns
+		}
+	}
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", strconv.Itoa(defaultTenantUsageDays)))
+	if err != nil || days < 1 || days > maxTenantUsageDays {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: fmt.Sprintf("Days must be between 1 and %d", maxTenantUsageDays),
+		})
+		return
+	}
+
+	granularity := c.DefaultQuery("granularity", "total")
+	if granularity != "total" && granularity != "daily" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Granularity must be total or daily",
+		})
+		return
+	}
+
+	publishedModels, err := s.listPublishedModelsByTenant(namespace)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to list published models",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	sortPublishedModels(publishedModels, "modelName", false)
+	modelNames := make([]string, len(publishedModels))
+	for i, model := range publishedModels {
+		modelNames[i] = model.ModelName
+	}
+
+	report, err := NewUsageTracker(s.k8sClient).GetTenantUsage(namespace, modelNames, days, granularity == "daily")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to get tenant usage",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func (s *PublishingService) GetRateLimitStatus(c *gin.Context) {
+	modelName := c.Param("modelName")
+
+	// Get user from JWT context
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin {
+		if ns := c.Query("namespace"); ns != "" {
+			namespace = ns
+		}
+	}
+
+	// Validate user permissions
+	if !u.IsAdmin && u.Tenant != namespace {
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error: "Insufficient permissions for tenant: " + namespace,
+		})
+		return
+	}
+
+	// Get published model metadata for the configured limits
+	publishedModel, err := s.getPublishedModelMetadata(namespace, modelName)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "Published model not found",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Envoy Gateway does not expose live rate-limit counters, so approximate
+	// them from the usage log entries recorded within each window
+	usageTracker := NewUsageTracker(s.k8sClient)
+	now := time.Now().Truncate(time.Second)
+
+	minuteStart := now.Add(-time.Minute)
+	minuteCount, err := usageTracker.CountRequestsSince(namespace, modelName, minuteStart)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to read usage counters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	hourStart := now.Add(-time.Hour)
+	hourCount, err := usageTracker.CountRequestsSince(namespace, modelName, hourStart)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to read usage counters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	minuteStatus := buildRateLimitWindowStatus(publishedModel.RateLimiting.RequestsPerMinute, minuteCount, minuteStart, time.Minute)
+	hourStatus := buildRateLimitWindowStatus(publishedModel.RateLimiting.RequestsPerHour, hourCount, hourStart, time.Hour)
+
+	c.JSON(http.StatusOK, RateLimitStatusResponse{
+		ModelName:         modelName,
+		Namespace:         namespace,
+		Source:            "usage-log",
+		RequestsPerMinute: minuteStatus,
+		RequestsPerHour:   hourStatus,
+		Throttled:         minuteStatus.Throttled || hourStatus.Throttled,
+		CheckedAt:         now,
+	})
+}
+
+// buildRateLimitWindowStatus compares a window's request count against its configured limit
+func buildRateLimitWindowStatus(limit int, current int64, windowStart time.Time, window time.Duration) RateLimitWindowStatus {
+	status := RateLimitWindowStatus{
+		Limit:       limit,
+		Current:     current,
+		WindowStart: windowStart,
+		ResetAt:     windowStart.Add(window),
+	}
+
+	// A limit of zero means the window is not enforced
+	if limit > 0 {
+		status.Remaining = int64(limit) - current
+		if status.Remaining < 0 {
+			status.Remaining = 0
+		}
+		status.Throttled = current >= int64(limit)
+	}
+
+	return status
+}
+
+// ValidateAPIKey handles POST /api/validate-api-key (for gateway)
+func (s *PublishingService) ValidateAPIKey(c *gin.Context) {
+	apiKey := c.GetHeader("X-API-Key")
+	if apiKey == "" {
+		apiKey = c.GetHeader("Authorization")
+		if strings.HasPrefix(apiKey, "Bearer ") {
+			apiKey = strings.TrimPrefix(apiKey, "Bearer ")
+		}
+	}
+
+	if apiKey == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "API key required",
+		})
+		return
+	}
+
+	// Validate API key
+	metadata, err := s.validateAPIKey(apiKey)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "Invalid API key",
+		})
+		return
+	}
+
+	// Update last used time
+	s.updateAPIKeyLastUsed(metadata.Namespace, metadata.ModelName)
+
+	// Set headers for upstream
+	c.Header("X-Tenant-ID", metadata.TenantID)
+	c.Header("X-Model-Name", metadata.ModelName)
 	c.Header("X-Model-Type", metadata.ModelType)
 	
 	c.JSON(http.StatusOK, gin.H{
@@ -703,7 +1628,16 @@ func (s *PublishingService) isModelPublished(namespace, modelName string) bool {
 	return err == nil
 }
 
-func (s *PublishingService) findModelPublishedNamespace(modelName string) string {
+// cleanupPublishedResources removes every resource created when the model was published
+func (s *PublishingService) cleanupPublishedResources(namespace, modelName string) {
+	s.cleanupAPIKey(namespace, modelName)
+	s.cleanupGatewayConfiguration(namespace, modelName)
+	s.cleanupRateLimitingPolicy(namespace, modelName)
+	s.cleanupPublishedModelMetadata(namespace, modelName)
+}
+
+// findModelPublishedNamespaces returns every tenant namespace the model name is published in
+func (s *PublishingService) findModelPublishedNamespaces(modelName string) []string {
 	// Search across all tenant namespaces to find where the model is published
 	namespaces, err := s.k8sClient.GetTenantNamespaces()
 	if err != nil {
@@ -712,40 +1646,45 @@ func (s *PublishingService) findModelPublishedNamespace(modelName string) string
 		namespaces = []string{"tenant-a", "tenant-b", "tenant-c"}
 	}
 	
+	var found []string
 	for _, namespace := range namespaces {
 		if s.isModelPublished(namespace, modelName) {
-			return namespace
+			found = append(found, namespace)
 		}
 	}
 	
-	return ""
+	return found
 }
 
-func (s *PublishingService) detectModelType(namespace, modelName string) (string, error) {
+func (s *PublishingService) detectModelType(namespace, modelName string) (string, string, error) {
 	// Get the InferenceService to analyze its configuration
 	inferenceService, err := s.k8sClient.GetInferenceService(namespace, modelName)
 	if err != nil {
-		return "", fmt.Errorf("failed to get inference service: %w", err)
+		return "", "", fmt.Errorf("failed to get inference service: %w", err)
 	}
 	
+	modelType, reason := detectModelTypeFromSpec(inferenceService, ActiveConfig().ModelTypeDetection)
+	return modelType, reason, nil
+}
+
+// detectModelTypeFromSpec classifies an InferenceService as "openai" or "traditional" and
+// returns a description of the rule that decided it
+func detectModelTypeFromSpec(inferenceService map[string]interface{}, rules ModelTypeDetectionRules) (string, string) {
 	// Check spec for model type indicators
 	spec, ok := inferenceService["spec"].(map[string]interface{})
 	if !ok {
-		return "traditional", nil
+		return "traditional", "InferenceService has no spec"
 	}
 	
 	// Check for OpenAI-compatible annotations or labels first (explicit configuration)
 	metadata, ok := inferenceService["metadata"].(map[string]interface{})
 	if ok {
 		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
-			if modelType, exists := annotations["serving.kserve.io/api-type"]; exists {
-				if strings.ToLower(fmt.Sprintf("%v", modelType)) == "openai" {
-					return "openai", nil
-				}
-			}
-			if modelType, exists := annotations["model.type"]; exists {
-				if strings.ToLower(fmt.Sprintf("%v", modelType)) == "openai" {
-					return "openai", nil
+			for _, annotation := range []string{"serving.kserve.io/api-type", "model.type"} {
+				if modelType, exists := annotations[annotation]; exists {
+					if strings.ToLower(fmt.Sprintf("%v", modelType)) == "openai" {
+						return "openai", fmt.Sprintf("annotation %s is openai", annotation)
+					}
 				}
 			}
 		}
@@ -759,30 +1698,11 @@ func (s *PublishingService) detectModelType(namespace, modelName string) (string
 				if c, ok := container.(map[string]interface{}); ok {
 					if image, ok := c["image"].(string); ok {
 						imageLower := strings.ToLower(image)
-						// Check for common OpenAI-compatible images
-						openaiImages := []string{
-							"vllm/vllm-openai",
-							"ghcr.io/huggingface/text-generation-inference",
-							"openai/triton-inference-server",
-							"nvidia/tritonserver",
-							"text-generation-inference",
-							"vllm",
-						}
-						for _, openaiImage := range openaiImages {
-							if strings.Contains(imageLower, openaiImage) {
-								return "openai", nil
-							}
-						}
-						
-						// Check for LLM model names in image
-						llmIndicators := []string{
-							"llama", "mistral", "falcon", "vicuna", "alpaca",
-							"gpt", "bert", "t5", "bloom", "opt",
+						if match := firstSubstringMatch(imageLower, rules.Images); match != "" {
+							return "openai", fmt.Sprintf("container image %s matches OpenAI-compatible image %q", image, match)
 						}
-						for _, indicator := range llmIndicators {
-							if strings.Contains(imageLower, indicator) {
-								return "openai", nil
-							}
+						if match := firstSubstringMatch(imageLower, rules.ImageIndicators); match != "" {
+							return "openai", fmt.Sprintf("container image %s contains LLM indicator %q", image, match)
 						}
 					}
 				}
@@ -792,31 +1712,15 @@ func (s *PublishingService) detectModelType(namespace, modelName string) (string
 		// 2. Check for HuggingFace models with text generation capability
 		if huggingface, ok := predictor["huggingface"].(map[string]interface{}); ok {
 			if task, ok := huggingface["task"].(string); ok {
-				openaiTasks := []string{
-					"text-generation",
-					"text2text-generation", 
-					"conversational",
-					"feature-extraction",
-				}
-				taskLower := strings.ToLower(task)
-				for _, openaiTask := range openaiTasks {
-					if strings.Contains(taskLower, openaiTask) {
-						return "openai", nil
-					}
+				if modelType, match := modelTypeForTask(task, rules); modelType == "openai" {
+					return "openai", fmt.Sprintf("huggingface task %s matches %q", task, match)
 				}
 			}
 			
 			// Check model URI for transformer indicators
 			if modelUri, ok := huggingface["modelUri"].(string); ok {
-				modelUriLower := strings.ToLower(modelUri)
-				transformerIndicators := []string{
-					"transformer", "llama", "mistral", "falcon", "vicuna",
-					"gpt", "bert", "t5", "bloom", "opt", "alpaca",
-				}
-				for _, indicator := range transformerIndicators {
-					if strings.Contains(modelUriLower, indicator) {
-						return "openai", nil
-					}
+				if match := firstSubstringMatch(strings.ToLower(modelUri), rules.URIIndicators); match != "" {
+					return "openai", fmt.Sprintf("huggingface model URI %s contains transformer indicator %q", modelUri, match)
 				}
 			}
 		}
@@ -824,36 +1728,41 @@ func (s *PublishingService) detectModelType(namespace, modelName string) (string
 		// 3. Check for PyTorch models with transformer architecture
 		if pytorch, ok := predictor["pytorch"].(map[string]interface{}); ok {
 			if modelUri, ok := pytorch["modelUri"].(string); ok {
-				modelUriLower := strings.ToLower(modelUri)
-				transformerIndicators := []string{
-					"transformer", "llama", "mistral", "falcon", "vicuna",
-					"gpt", "bert", "t5", "bloom", "opt", "alpaca",
-				}
-				for _, indicator := range transformerIndicators {
-					if strings.Contains(modelUriLower, indicator) {
-						return "openai", nil
-					}
+				if match := firstSubstringMatch(strings.ToLower(modelUri), rules.URIIndicators); match != "" {
+					return "openai", fmt.Sprintf("pytorch model URI %s contains transformer indicator %q", modelUri, match)
 				}
 			}
 		}
 	}
 	
 	// Default to traditional inference
-	return "traditional", nil
+	return "traditional", "no OpenAI-compatible annotation, image, task or model URI matched"
+}
+
+// firstSubstringMatch returns the first non-empty candidate contained in value
+func firstSubstringMatch(value string, candidates []string) string {
+	for _, candidate := range candidates {
+		if candidate != "" && strings.Contains(value, candidate) {
+			return candidate
+		}
+	}
+	return ""
 }
 
 func (s *PublishingService) generateAPIKey(user *User, modelName, namespace, modelType string) (*APIKeyMetadata, string, error) {
 	// Generate cryptographically secure API key
-	keyBytes := make([]byte, 32)
-	if _, err := rand.Read(keyBytes); err != nil {
+	config := ActiveConfig()
+	secret, err := generateAPIKeySecret(config.APIKeyBytes, config.APIKeyEncoding)
+	if err != nil {
 		return nil, "", err
 	}
 	
-	apiKey := base64.URLEncoding.EncodeToString(keyBytes)
-	
 	// Create metadata
+	keyID := generateKeyID()
+	apiKey := formatAPIKey(namespace, keyID, secret)
+	
 	metadata := &APIKeyMetadata{
-		KeyID:       generateKeyID(),
+		KeyID:       keyID,
 		ModelName:   modelName,
 		Namespace:   namespace,
 		TenantID:    user.Tenant,
@@ -862,172 +1771,553 @@ func (s *PublishingService) generateAPIKey(user *User, modelName, namespace, mod
 		IsActive:    true,
 		Permissions: []string{"inference"},
 	}
-	
-	// Store API key
-	if err := s.storeAPIKey(namespace, modelName, apiKey, metadata); err != nil {
-		return nil, "", err
+	
+	// Store API key
+	if err := s.storeAPIKey(namespace, modelName, apiKey, metadata); err != nil {
+		return nil, "", err
+	}
+	
+	return metadata, apiKey, nil
+}
+
+func (s *PublishingService) createGatewayConfiguration(namespace, modelName, modelType string, config PublishConfig) (string, error) {
+	// Generate route name
+	routeName := fmt.Sprintf("published-model-%s-%s", namespace, modelName)
+	
+	// Create the appropriate gateway configuration based on model type
+	if modelType == "openai" {
+		return s.createAIGatewayRoute(namespace, modelName, routeName, config)
+	} else {
+		return s.createHTTPRoute(namespace, modelName, routeName, config)
+	}
+}
+
+func (s *PublishingService) createHTTPRoute(namespace, modelName, routeName string, config PublishConfig) (string, error) {
+	// Generate external path
+	externalPath := config.ExternalPath
+	if externalPath == "" {
+		externalPath = fmt.Sprintf("/published/models/%s", modelName)
+	}
+	
+	// Determine hostname
+	hostname := config.PublicHostname
+	if hostname == "" {
+		hostname = "api.router.inference-in-a-box"
+	}
+	
+	// Get KServe hostname from InferenceService
+	kserveHostname, err := s.generateKServeHostname(modelName, namespace)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate KServe hostname: %w", err)
+	}
+	
+	// The base rule rewrites to the predict path; each path mapping adds a rule of its own
+	predictMethods := effectivePredictMethods(config.PredictMethods)
+	baseRule := s.publishedRouteRule(namespace, modelName, hostname, kserveHostname, externalPath, s.generateKServeModelPath(modelName), predictMethods)
+	if config.Mirror != nil {
+		mirrorFilter, err := s.createMirrorBackend(namespace, modelName, *config.Mirror)
+		if err != nil {
+			return "", err
+		}
+		baseRule["filters"] = append(baseRule["filters"].([]interface{}), mirrorFilter)
+	}
+	rules := []interface{}{baseRule}
+	for _, mapping := range config.PathMappings {
+		matchPath := strings.TrimSuffix(externalPath, "/") + mapping.ExternalPath
+		rules = append(rules, s.publishedRouteRule(namespace, modelName, hostname, kserveHostname, matchPath, mapping.InternalPath, mapping.Methods))
+	}
+	
+	// Rules with a method match take precedence, so this rule only sees disallowed methods
+	methodNotAllowedRule, err := s.createMethodNotAllowedRule(namespace, modelName, routeName, externalPath, predictMethods)
+	if err != nil {
+		return "", err
+	}
+	rules = append(rules, methodNotAllowedRule)
+	
+	// Preflights carry no API key, so they get a rule of their own that is answered at the gateway
+	if config.CORS != nil {
+		preflightRule, err := s.createCORSPreflightRule(namespace, modelName, routeName, externalPath)
+		if err != nil {
+			return "", err
+		}
+		rules = append(rules, preflightRule)
+	}
+	
+	// Create HTTPRoute configuration
+	httpRoute := map[string]interface{}{
+		"apiVersion": "gateway.networking.k8s.io/v1",
+		"kind":       "HTTPRoute",
+		"metadata": map[string]interface{}{
+			"name":      routeName,
+			"namespace": "envoy-gateway-system",
+			"labels": map[string]interface{}{
+				"app":        "published-model",
+				"model-name": modelName,
+				"tenant":     namespace,
+				"hostname":   hostname,
+			},
+		},
+		"spec": map[string]interface{}{
+			"hostnames": []interface{}{hostname}, // Add hostname specification
+			"parentRefs": []interface{}{
+				map[string]interface{}{
+					"name":      "ai-inference-gateway",
+					"namespace": "envoy-gateway-system",
+				},
+			},
+			"rules": rules,
+		},
+	}
+	
+	// Update Gateway to include this hostname
+	if err := s.updateGatewayForHostname(hostname); err != nil {
+		return "", fmt.Errorf("failed to update gateway for hostname %s: %w", hostname, err)
+	}
+	
+	// Create the HTTPRoute
+	if err := s.k8sClient.CreateHTTPRoute("envoy-gateway-system", httpRoute); err != nil {
+		return "", fmt.Errorf("failed to create HTTPRoute: %w", err)
+	}
+	
+	if config.CORS != nil {
+		if err := s.createCORSSecurityPolicy(namespace, modelName, routeName, *config.CORS, predictMethods); err != nil {
+			return "", err
+		}
+	}
+	
+	// Return the external URL using the configured hostname
+	return fmt.Sprintf("https://%s%s", hostname, externalPath), nil
+}
+
+// defaultPredictMethods are the methods accepted on a traditional model's predict path by default
+var defaultPredictMethods = []string{"POST"}
+
+// effectivePredictMethods returns the configured predict methods, or the default when none are set
+func effectivePredictMethods(methods []string) []string {
+	if len(methods) == 0 {
+		return defaultPredictMethods
+	}
+	return methods
+}
+
+// methodNotAllowedFilterName is the HTTPRouteFilter answering disallowed methods on a published route
+func methodNotAllowedFilterName(routeName string) string {
+	return routeName + "-method-not-allowed"
+}
+
+// createMethodNotAllowedRule creates the HTTPRouteFilter that answers 405 and returns the catch-all
+// rule using it. The rule matches every method under externalPath, so any request not taken by a
+// method-specific rule is rejected at the gateway instead of reaching the model.
+func (s *PublishingService) createMethodNotAllowedRule(namespace, modelName, routeName, externalPath string, predictMethods []string) (map[string]interface{}, error) {
+	filterName := methodNotAllowedFilterName(routeName)
+	filter := map[string]interface{}{
+		"apiVersion": "gateway.envoyproxy.io/v1alpha1",
+		"kind":       "HTTPRouteFilter",
+		"metadata": map[string]interface{}{
+			"name":      filterName,
+			"namespace": "envoy-gateway-system",
+			"labels": map[string]interface{}{
+				"app":        "published-model",
+				"model-name": modelName,
+				"tenant":     namespace,
+			},
+		},
+		"spec": map[string]interface{}{
+			"directResponse": map[string]interface{}{
+				"contentType": "application/json",
+				"statusCode":  http.StatusMethodNotAllowed,
+				"body": map[string]interface{}{
+					"type":   "Inline",
+					"inline": `{"error":"Method not allowed"}`,
+				},
+			},
+		},
+	}
+	if err := s.k8sClient.CreateHTTPRouteFilter("envoy-gateway-system", filter); err != nil {
+		return nil, fmt.Errorf("failed to create method restriction filter: %w", err)
 	}
-	
-	return metadata, apiKey, nil
-}
 
-func (s *PublishingService) createGatewayConfiguration(namespace, modelName, modelType string, config PublishConfig) (string, error) {
-	// Generate route name
-	routeName := fmt.Sprintf("published-model-%s-%s", namespace, modelName)
-	
-	// Create the appropriate gateway configuration based on model type
-	if modelType == "openai" {
-		return s.createAIGatewayRoute(namespace, modelName, routeName, config)
-	} else {
-		return s.createHTTPRoute(namespace, modelName, routeName, config)
+	var matches []interface{}
+	for _, keyHeader := range apiKeyHeaderMatches() {
+		matches = append(matches, map[string]interface{}{
+			"path": map[string]interface{}{
+				"type":  "PathPrefix",
+				"value": externalPath,
+			},
+			"headers": []interface{}{keyHeader},
+		})
 	}
+
+	return map[string]interface{}{
+		"matches": matches,
+		"filters": []interface{}{
+			map[string]interface{}{
+				"type": "ResponseHeaderModifier",
+				"responseHeaderModifier": map[string]interface{}{
+					"set": []interface{}{
+						map[string]interface{}{
+							"name":  "allow",
+							"value": strings.Join(predictMethods, ", "),
+						},
+					},
+				},
+			},
+			map[string]interface{}{
+				"type": "ExtensionRef",
+				"extensionRef": map[string]interface{}{
+					"group": "gateway.envoyproxy.io",
+					"kind":  "HTTPRouteFilter",
+					"name":  filterName,
+				},
+			},
+		},
+	}, nil
 }
 
-func (s *PublishingService) createHTTPRoute(namespace, modelName, routeName string, config PublishConfig) (string, error) {
-	// Generate external path
-	externalPath := config.ExternalPath
-	if externalPath == "" {
-		externalPath = fmt.Sprintf("/published/models/%s", modelName)
-	}
-	
-	// Determine hostname
-	hostname := config.PublicHostname
-	if hostname == "" {
-		hostname = "api.router.inference-in-a-box"
-	}
-	
-	// Get KServe hostname from InferenceService
-	kserveHostname, err := s.generateKServeHostname(modelName, namespace)
+// mirrorBackendName is the Backend receiving mirrored traffic for a published model
+func mirrorBackendName(modelName string) string {
+	return fmt.Sprintf("%s-mirror-backend", modelName)
+}
+
+// createMirrorBackend creates the Backend for the shadow model and returns the RequestMirror
+// filter that points at it. The shadow is reached on its cluster-local address so mirrored
+// requests skip the ingress gateway; envoy sends them fire-and-forget and drops the responses.
+func (s *PublishingService) createMirrorBackend(namespace, modelName string, mirror MirrorConfig) (map[string]interface{}, error) {
+	shadowHostname, err := s.clusterLocalHostname(namespace, mirror.ModelName)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate KServe hostname: %w", err)
+		return nil, fmt.Errorf("failed to resolve mirror model %s: %w", mirror.ModelName, err)
 	}
-	
-	// Create HTTPRoute configuration
-	httpRoute := map[string]interface{}{
-		"apiVersion": "gateway.networking.k8s.io/v1",
-		"kind":       "HTTPRoute",
+
+	backendName := mirrorBackendName(modelName)
+	backend := map[string]interface{}{
+		"apiVersion": "gateway.envoyproxy.io/v1alpha1",
+		"kind":       "Backend",
 		"metadata": map[string]interface{}{
-			"name":      routeName,
+			"name":      backendName,
 			"namespace": "envoy-gateway-system",
 			"labels": map[string]interface{}{
-				"app":        "published-model",
-				"model-name": modelName,
-				"tenant":     namespace,
-				"hostname":   hostname,
+				"app":          "published-model",
+				"model-name":   modelName,
+				"tenant":       namespace,
+				"mirror-model": mirror.ModelName,
 			},
 		},
 		"spec": map[string]interface{}{
-			"hostnames": []interface{}{hostname}, // Add hostname specification
-			"parentRefs": []interface{}{
+			"endpoints": []interface{}{
 				map[string]interface{}{
-					"name":      "ai-inference-gateway",
-					"namespace": "envoy-gateway-system",
+					"fqdn": map[string]interface{}{
+						"hostname": shadowHostname,
+						"port":     80,
+					},
 				},
 			},
-			"rules": []interface{}{
-				map[string]interface{}{
-					"matches": []interface{}{
+		},
+	}
+	if err := s.k8sClient.CreateBackend("envoy-gateway-system", backend); err != nil {
+		return nil, fmt.Errorf("failed to create mirror Backend: %w", err)
+	}
+
+	return map[string]interface{}{
+		"type": "RequestMirror",
+		"requestMirror": map[string]interface{}{
+			"backendRef": map[string]interface{}{
+				"group":     "gateway.envoyproxy.io",
+				"kind":      "Backend",
+				"name":      backendName,
+				"namespace": "envoy-gateway-system",
+			},
+			"percent": mirror.Percent,
+		},
+	}, nil
+}
+
+// clusterLocalHostname returns the in-cluster hostname of an InferenceService, falling back to
+// the predictor service name when the status address has not been populated yet
+func (s *PublishingService) clusterLocalHostname(namespace, modelName string) (string, error) {
+	inferenceService, err := s.k8sClient.GetInferenceService(namespace, modelName)
+	if err != nil {
+		return "", err
+	}
+
+	if status, ok := inferenceService["status"].(map[string]interface{}); ok {
+		if address, ok := status["address"].(map[string]interface{}); ok {
+			if addressURL, ok := address["url"].(string); ok {
+				if parsed, err := url.Parse(addressURL); err == nil && parsed.Hostname() != "" {
+					return parsed.Hostname(), nil
+				}
+			}
+		}
+	}
+
+	return fmt.Sprintf("%s-predictor.%s.svc.cluster.local", modelName, namespace), nil
+}
+
+// publishedRouteRule builds an HTTPRoute rule that forwards API-key requests under matchPath to
+// rewritePath on the model. An empty methods list matches every method.
+// apiKeyHeaderMatches returns the header matches for the two ways a caller can present an API key:
+// the X-API-Key header or an OpenAI-style "Authorization: Bearer" header
+func apiKeyHeaderMatches() []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"name":  "x-api-key",
+			"type":  "RegularExpression",
+			"value": ".*",
+		},
+		{
+			"name":  "authorization",
+			"type":  "RegularExpression",
+			"value": "^Bearer .+",
+		},
+	}
+}
+
+func (s *PublishingService) publishedRouteRule(namespace, modelName, hostname, kserveHostname, matchPath, rewritePath string, methods []string) map[string]interface{} {
+	// Headers within a match are ANDed, so each accepted API key header gets its own match
+	newMatch := func(keyHeader map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"path": map[string]interface{}{
+				"type":  "PathPrefix",
+				"value": matchPath,
+			},
+			"headers": []interface{}{keyHeader},
+		}
+	}
+	
+	var matches []interface{}
+	for _, keyHeader := range apiKeyHeaderMatches() {
+		if len(methods) == 0 {
+			matches = append(matches, newMatch(keyHeader))
+		}
+		for _, method := range methods {
+			match := newMatch(keyHeader)
+			match["method"] = method
+			matches = append(matches, match)
+		}
+	}
+	
+	return map[string]interface{}{
+		"matches": matches,
+		"filters": []interface{}{
+			map[string]interface{}{
+				"type": "URLRewrite",
+				"urlRewrite": map[string]interface{}{
+					"hostname": kserveHostname,
+					"path": map[string]interface{}{
+						"type":            "ReplaceFullPath",
+						"replaceFullPath": rewritePath,
+					},
+				},
+			},
+			map[string]interface{}{
+				"type": "RequestHeaderModifier",
+				"requestHeaderModifier": map[string]interface{}{
+					"set": []interface{}{
 						map[string]interface{}{
-							"path": map[string]interface{}{
-								"type":  "PathPrefix",
-								"value": externalPath,
-							},
-							"headers": []interface{}{
-								map[string]interface{}{
-									"name": "x-api-key",
-									"type":  "RegularExpression",
-									"value": ".*",
-								},
-							},
+							"name":  "x-tenant",
+							"value": namespace,
 						},
-					},
-					"filters": []interface{}{
 						map[string]interface{}{
-							"type": "URLRewrite",
-							"urlRewrite": map[string]interface{}{
-								"hostname": kserveHostname,
-								"path": map[string]interface{}{
-									"type":            "ReplaceFullPath",
-									"replaceFullPath": s.generateKServeModelPath(modelName),
-								},
-							},
+							"name":  "x-model-name",
+							"value": modelName,
 						},
 						map[string]interface{}{
-							"type": "RequestHeaderModifier",
-							"requestHeaderModifier": map[string]interface{}{
-								"set": []interface{}{
-									map[string]interface{}{
-										"name":  "x-tenant",
-										"value": namespace,
-									},
-									map[string]interface{}{
-										"name":  "x-model-name",
-										"value": modelName,
-									},
-									map[string]interface{}{
-										"name":  "x-gateway",
-										"value": "published-model",
-									},
-									map[string]interface{}{
-										"name":  "x-hostname",
-										"value": hostname,
-									},
-								},
-							},
+							"name":  "x-gateway",
+							"value": "published-model",
 						},
-					},
-					"backendRefs": []interface{}{
 						map[string]interface{}{
-							"name":      "istio-ingressgateway",
-							"namespace": "istio-system",
-							"port":      80,
+							"name":  "x-hostname",
+							"value": hostname,
 						},
 					},
 				},
 			},
 		},
+		"backendRefs": []interface{}{
+			map[string]interface{}{
+				"name":      "istio-ingressgateway",
+				"namespace": "istio-system",
+				"port":      80,
+			},
+		},
 	}
-	
-	// Update Gateway to include this hostname
-	if err := s.updateGatewayForHostname(hostname); err != nil {
-		return "", fmt.Errorf("failed to update gateway for hostname %s: %w", hostname, err)
+}
+
+// generateDNSInstructions tells the user which DNS record points a custom hostname at the gateway.
+// The target is read from the ingress LoadBalancer status, preferring istio-ingressgateway
+// over envoy-gateway in the same way as the admin AI gateway service lookup.
+func (s *PublishingService) generateDNSInstructions(hostname string) *DNSInstructions {
+	if hostname == "" || hostname == "api.router.inference-in-a-box" {
+		return nil
 	}
-	
-	// Create the HTTPRoute
-	if err := s.k8sClient.CreateHTTPRoute("envoy-gateway-system", httpRoute); err != nil {
-		return "", fmt.Errorf("failed to create HTTPRoute: %w", err)
+
+	instructions := &DNSInstructions{
+		Hostname: hostname,
 	}
-	
-	// Return the external URL using the configured hostname
-	return fmt.Sprintf("https://%s%s", hostname, externalPath), nil
+
+	if strings.HasPrefix(hostname, "*.") {
+		instructions.Note = "Wildcard hostnames need a wildcard DNS record"
+	}
+
+	gateways := []struct {
+		namespace string
+		name      string
+	}{
+		{"istio-system", "istio-ingressgateway"},
+		{"envoy-gateway-system", "envoy-gateway"},
+	}
+
+	for _, gateway := range gateways {
+		service, err := s.k8sClient.GetService(gateway.namespace, gateway.name)
+		if err != nil || len(service.Status.LoadBalancer.Ingress) == 0 {
+			continue
+		}
+
+		ingress := service.Status.LoadBalancer.Ingress[0]
+		instructions.Gateway = gateway.name
+		if ingress.IP != "" {
+			instructions.RecordType = "A"
+			instructions.Target = ingress.IP
+			return instructions
+		}
+		if ingress.Hostname != "" {
+			instructions.RecordType = "CNAME"
+			instructions.Target = ingress.Hostname
+			return instructions
+		}
+	}
+
+	instructions.Note = "The gateway has no external address yet; create the DNS record once its LoadBalancer is provisioned"
+	return instructions
+}
+
+// Time allowed for the DNS lookup behind the unresolved-hostname publish warning
+const publishDNSLookupTimeout = 2 * time.Second
+
+// hostnameNeedsListener reports whether publishing on hostname adds a new listener to the shared
+// gateway, which Envoy Gateway has to program before the hostname serves traffic
+func (s *PublishingService) hostnameNeedsListener(hostname string) bool {
+	if s.isHostnameCoveredByWildcard(hostname) {
+		return false
+	}
+	_, exists, err := s.gatewayListenerUsage(hostname)
+	return err == nil && !exists
+}
+
+// gatewayListenerUsage returns the number of listeners on the shared gateway and whether one of
+// them already serves hostname
+func (s *PublishingService) gatewayListenerUsage(hostname string) (int, bool, error) {
+	gateway, err := s.k8sClient.GetGateway("envoy-gateway-system", "ai-inference-gateway")
+	if err != nil {
+		return 0, false, err
+	}
+	spec, _ := gateway["spec"].(map[string]interface{})
+	listeners, _ := spec["listeners"].([]interface{})
+	return len(listeners), s.hostnameExistsInListeners(listeners, hostname), nil
+}
+
+// listenerCapacityWarning warns when the shared gateway is close to MAX_GATEWAY_LISTENERS after a
+// custom hostname added its listeners
+func (s *PublishingService) listenerCapacityWarning(hostname string) string {
+	max := ActiveConfig().MaxGatewayListeners
+	if max <= 0 {
+		return ""
+	}
+	count, _, err := s.gatewayListenerUsage(hostname)
+	if err != nil || count*100 < max*listenerCapacityWarningPercent {
+		return ""
+	}
+	return fmt.Sprintf("The shared gateway now has %d of at most %d listeners; publish further models under *.inference-in-a-box, which needs no new listeners", count, max)
+}
+
+// publishConfigWarnings lists caveats of a publish config that do not fail the operation
+// but leave the model behaving differently from what was asked for
+func (s *PublishingService) publishConfigWarnings(modelType string, config PublishConfig, newListener bool, dnsInstructions *DNSInstructions) []string {
+	var warnings []string
+
+	if newListener {
+		warnings = append(warnings, fmt.Sprintf("Hostname %s was added to the gateway listeners and may take a moment to be programmed", config.PublicHostname))
+		if warning := s.listenerCapacityWarning(config.PublicHostname); warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	if dnsInstructions != nil && !strings.HasPrefix(dnsInstructions.Hostname, "*.") {
+		ctx, cancel := context.WithTimeout(context.Background(), publishDNSLookupTimeout)
+		defer cancel()
+		if _, err := net.DefaultResolver.LookupHost(ctx, dnsInstructions.Hostname); err != nil {
+			if dnsInstructions.Target != "" {
+				warnings = append(warnings, fmt.Sprintf("Hostname %s does not resolve yet; create the %s record pointing to %s", dnsInstructions.Hostname, dnsInstructions.RecordType, dnsInstructions.Target))
+			} else {
+				warnings = append(warnings, fmt.Sprintf("Hostname %s does not resolve yet and the gateway has no external address", dnsInstructions.Hostname))
+			}
+		}
+	}
+
+	// The token rule of the rate-limit policy only matches OpenAI traffic
+	if config.RateLimiting.TokensPerHour > 0 && modelType != "openai" {
+		warnings = append(warnings, fmt.Sprintf("rateLimiting.tokensPerHour is ignored for %s models", modelType))
+	}
+
+	return warnings
 }
 
 // generateKServeHostname generates the KServe predictor hostname for a model by looking up the InferenceService
 func (s *PublishingService) generateKServeHostname(modelName, namespace string) (string, error) {
+	hostname, _, err := s.resolveKServeHostname(modelName, namespace)
+	return hostname, err
+}
+
+// resolveKServeHostname returns the KServe predictor hostname of a model and where it came from:
+// "status" when read from the InferenceService URL, "fallback" when constructed from the name
+func (s *PublishingService) resolveKServeHostname(modelName, namespace string) (string, string, error) {
 	// Get the InferenceService to extract the URL
 	inferenceService, err := s.k8sClient.GetInferenceService(namespace, modelName)
 	if err != nil {
-		return "", fmt.Errorf("failed to get InferenceService: %w", err)
+		return "", "", fmt.Errorf("failed to get InferenceService: %w", err)
 	}
 	
 	// Extract URL from status
 	if status, ok := inferenceService["status"].(map[string]interface{}); ok {
-		if url, ok := status["url"].(string); ok {
-			// Parse the URL to extract hostname
-			// Format: http://model-name.namespace.127.0.0.1.sslip.io
-			// We need to remove the protocol and return just the hostname
-			if len(url) > 7 && url[:7] == "http://" {
-				return url[7:], nil
+		if statusURL, ok := status["url"].(string); ok {
+			if hostname := hostnameFromURL(statusURL); hostname != "" {
+				return hostname, "status", nil
 			}
-			if len(url) > 8 && url[:8] == "https://" {
-				return url[8:], nil
-			}
-			return url, nil
 		}
 	}
 	
 	// Fallback to constructed hostname if status URL is not available
-	return fmt.Sprintf("%s-predictor.%s.127.0.0.1.sslip.io", modelName, namespace), nil
+	return fmt.Sprintf("%s-predictor.%s.%s", modelName, namespace, ActiveConfig().KServeDomainSuffix), "fallback", nil
+}
+
+// hostnameFromURL returns the lowercase hostname of a URL such as
+// http://my-model.tenant-a.example.com:8080/v1, dropping the scheme, port and path. URLs without
+// a scheme are accepted. It returns "" when no hostname can be found.
+func hostnameFromURL(rawURL string) string {
+	rawURL = strings.TrimSpace(rawURL)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Hostname())
+}
+
+// resolveInternalTarget returns the KServe hostname and path the gateway sends a published
+// model's requests to, resolved the same way as when its routes are created. It returns nil
+// when the InferenceService cannot be read.
+func (s *PublishingService) resolveInternalTarget(namespace, modelName, modelType string) *InternalTarget {
+	hostname, source, err := s.resolveKServeHostname(modelName, namespace)
+	if err != nil {
+		return nil
+	}
+	target := &InternalTarget{Hostname: hostname, Source: source}
+	// OpenAI routes only rewrite the host, the request path is forwarded unchanged
+	if modelType != "openai" {
+		target.Path = s.generateKServeModelPath(modelName)
+	}
+	return target
 }
 
 // generateKServeModelPath generates the KServe model endpoint path for a model
@@ -1035,6 +2325,37 @@ func (s *PublishingService) generateKServeModelPath(modelName string) string {
 	return fmt.Sprintf("/v1/models/%s:predict", modelName)
 }
 
+// servedModelName returns the model identifier clients send for an OpenAI-compatible model: the
+// configured served model name, or the InferenceService name when none is set
+func servedModelName(modelName string, openai *OpenAIConfig) string {
+	if openai != nil && openai.ServedModelName != "" {
+		return openai.ServedModelName
+	}
+	return modelName
+}
+
+// aiGatewayRouteMatches matches requests for the served model that carry an API key in either
+// accepted header; key validation itself is done by the gateway's external auth. OpenAI clients
+// send the model in the request body, and the AI Gateway copies that "model" field into the
+// x-ai-eg-model header before routing, so the match must use the served model name rather than
+// the InferenceService name.
+func aiGatewayRouteMatches(modelName string) []interface{} {
+	var matches []interface{}
+	for _, keyHeader := range apiKeyHeaderMatches() {
+		matches = append(matches, map[string]interface{}{
+			"headers": []interface{}{
+				map[string]interface{}{
+					"type":  "Exact",
+					"name":  "x-ai-eg-model",
+					"value": modelName,
+				},
+				keyHeader,
+			},
+		})
+	}
+	return matches
+}
+
 func (s *PublishingService) createAIGatewayRoute(namespace, modelName, routeName string, config PublishConfig) (string, error) {
 	// Generate external path for OpenAI compatibility
 	externalPath := config.ExternalPath
@@ -1106,19 +2427,7 @@ func (s *PublishingService) createAIGatewayRoute(namespace, modelName, routeName
 			"hostnames": []interface{}{hostname},
 			"rules": []interface{}{
 				map[string]interface{}{
-					"matches": []interface{}{
-						map[string]interface{}{
-							"headers": []interface{}{
-								map[string]interface{}{
-									"type":  "Exact",
-									"name":  "x-ai-eg-model",
-									"value": modelName,
-								},
-								// Removed x-api-key header match - AIGateway handles auth differently
-								// Authentication is handled at the gateway level
-							},
-						},
-					},
+					"matches": aiGatewayRouteMatches(servedModelName(modelName, config.OpenAI)),
 					// AIGatewayRoute relies on the AI Gateway to handle OpenAI protocol transformation
 					// The AIServiceBackend references a Backend resource with fqdn for host header rewriting
 					// Backend fqdn automatically handles host header rewriting to KServe hostname
@@ -1156,6 +2465,43 @@ func (s *PublishingService) createAIGatewayRoute(namespace, modelName, routeName
 	return fmt.Sprintf("https://%s%s", hostname, externalPath), nil
 }
 
+// applyDefaultRateLimit fills in rate limiting a request left out. An omitted rateLimiting object
+// takes all of defaults; otherwise only the required requestsPerMinute and requestsPerHour are
+// filled in when zero, since zero disables the other limits. Explicit values are kept as sent
+// and left to validation.
+func applyDefaultRateLimit(rateLimiting, defaults RateLimitConfig) RateLimitConfig {
+	if rateLimiting == (RateLimitConfig{}) {
+		return defaults
+	}
+	if rateLimiting.RequestsPerMinute == 0 {
+		rateLimiting.RequestsPerMinute = defaults.RequestsPerMinute
+	}
+	if rateLimiting.RequestsPerHour == 0 {
+		rateLimiting.RequestsPerHour = defaults.RequestsPerHour
+	}
+	return rateLimiting
+}
+
+// apiKeyRateLimitRules applies the per-minute limit to requests authenticated by either API key header.
+// Client selectors are ANDed, so each header needs its own rule.
+func apiKeyRateLimitRules(requestsPerMinute int) []interface{} {
+	var rules []interface{}
+	for _, keyHeader := range apiKeyHeaderMatches() {
+		rules = append(rules, map[string]interface{}{
+			"clientSelectors": []interface{}{
+				map[string]interface{}{
+					"headers": []interface{}{keyHeader},
+				},
+			},
+			"limit": map[string]interface{}{
+				"requests": requestsPerMinute,
+				"unit":     "Minute",
+			},
+		})
+	}
+	return rules
+}
+
 func (s *PublishingService) createRateLimitingPolicy(namespace, modelName string, rateLimiting RateLimitConfig) error {
 	// Generate policy name
 	policyName := fmt.Sprintf("published-model-rate-limit-%s-%s", namespace, modelName)
@@ -1185,25 +2531,7 @@ func (s *PublishingService) createRateLimitingPolicy(namespace, modelName string
 			"rateLimit": map[string]interface{}{
 				"type": "Global",
 				"global": map[string]interface{}{
-					"rules": []interface{}{
-						map[string]interface{}{
-							"clientSelectors": []interface{}{
-								map[string]interface{}{
-									"headers": []interface{}{
-										map[string]interface{}{
-											"name":  "x-api-key",
-											"type":  "RegularExpression",
-											"value": ".*",
-										},
-									},
-								},
-							},
-							"limit": map[string]interface{}{
-								"requests": rateLimiting.RequestsPerMinute,
-								"unit":     "Minute",
-							},
-						},
-					},
+					"rules": apiKeyRateLimitRules(rateLimiting.RequestsPerMinute),
 				},
 			},
 		},
@@ -1235,39 +2563,197 @@ func (s *PublishingService) createRateLimitingPolicy(namespace, modelName string
 		policy["spec"].(map[string]interface{})["rateLimit"].(map[string]interface{})["global"].(map[string]interface{})["rules"] = rules
 	}
 	
+	// Payload and connection limits live on the same per-route policy; a ClientTrafficPolicy
+	// would apply to the whole shared gateway rather than to this model
+	if rateLimiting.MaxRequestBytes > 0 {
+		policy["spec"].(map[string]interface{})["requestBuffer"] = map[string]interface{}{
+			"limit": strconv.Itoa(rateLimiting.MaxRequestBytes),
+		}
+	}
+	if rateLimiting.MaxConcurrentConnections > 0 {
+		policy["spec"].(map[string]interface{})["circuitBreaker"] = map[string]interface{}{
+			"maxConnections": rateLimiting.MaxConcurrentConnections,
+		}
+	}
+	
 	// Create the BackendTrafficPolicy
 	if err := s.k8sClient.CreateBackendTrafficPolicy("envoy-gateway-system", policy); err != nil {
 		return fmt.Errorf("failed to create rate limiting policy: %w", err)
 	}
 	
-	return nil
+	return nil
+}
+
+func (s *PublishingService) generateAPIDocumentation(namespace, modelName, modelType, externalURL, apiKey string, inputNames []string, openai *OpenAIConfig) APIDocumentation {
+	docGenerator := NewDocumentationGenerator(ActiveConfig())
+	return docGenerator.GenerateAPIDocumentation(namespace, modelName, modelType, externalURL, apiKey, inputNames, openai)
+}
+
+// parseInputNames reads the model's named inputs from the comma-separated "inputs" metadata key
+func parseInputNames(metadata map[string]string) []string {
+	var inputNames []string
+	for _, name := range strings.Split(metadata["inputs"], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			inputNames = append(inputNames, name)
+		}
+	}
+	return inputNames
+}
+
+func (s *PublishingService) storePublishedModelMetadata(namespace, modelName string, model PublishedModel) error {
+	// Store the metadata using K8s client
+	return s.k8sClient.CreatePublishedModelMetadata(namespace, modelName, publishedModelMetadataMap(model))
+}
+
+// publishedModelMetadataMap converts a PublishedModel to the map stored in its metadata ConfigMap
+func publishedModelMetadataMap(model PublishedModel) map[string]interface{} {
+	modelMap := map[string]interface{}{
+		"modelName":      model.ModelName,
+		"namespace":      model.Namespace,
+		"tenantId":       model.TenantID,
+		"modelType":      model.ModelType,
+		"externalUrl":    model.ExternalURL,
+		"publicHostname": model.PublicHostname,
+		"apiKeyId":       model.APIKeyID,
+		"apiKeyMasked":   publishedModelKeyMask(model),
+		"rateLimiting":   model.RateLimiting,
+		"status":         model.Status,
+		"createdAt":      model.CreatedAt,
+		"updatedAt":      model.UpdatedAt,
+		"usage":          model.Usage,
+		"documentation":  storedDocumentation(model),
+	}
+	if len(model.MissingResources) > 0 {
+		modelMap["missingResources"] = model.MissingResources
+	}
+	if model.OrphanedAt != nil {
+		modelMap["orphanedAt"] = model.OrphanedAt
+	}
+	if model.CacheTTL != "" {
+		modelMap["cacheTTL"] = model.CacheTTL
+	}
+	if len(model.PathMappings) > 0 {
+		modelMap["pathMappings"] = model.PathMappings
+	}
+	if model.Mirror != nil {
+		modelMap["mirror"] = model.Mirror
+	}
+	if len(model.PredictMethods) > 0 {
+		modelMap["predictMethods"] = model.PredictMethods
+	}
+	if model.CORS != nil {
+		modelMap["cors"] = model.CORS
+	}
+	if model.OpenAI != nil {
+		modelMap["openai"] = model.OpenAI
+	}
+	if model.RequestTransform != nil {
+		modelMap["requestTransform"] = model.RequestTransform
+	}
+	if model.ErrorRateThreshold > 0 {
+		modelMap["errorRateThreshold"] = model.ErrorRateThreshold
+	}
+	if model.ErrorRateExceeded {
+		modelMap["errorRateExceeded"] = true
+	}
+	if model.Config != nil {
+		modelMap["config"] = model.Config
+	}
+	
+	return modelMap
 }
 
-func (s *PublishingService) generateAPIDocumentation(namespace, modelName, modelType, externalURL, apiKey string) APIDocumentation {
-	docGenerator := NewDocumentationGenerator(s.config)
-	return docGenerator.GenerateAPIDocumentation(namespace, modelName, modelType, externalURL, apiKey)
+// publishedModelKeyMask returns the masked form of the model's API key kept in its metadata
+func publishedModelKeyMask(model PublishedModel) string {
+	if model.APIKey == "" {
+		return model.APIKeyMasked
+	}
+	return maskUsageAPIKey(model.APIKey)
 }
 
-func (s *PublishingService) storePublishedModelMetadata(namespace, modelName string, model PublishedModel) error {
-	// Convert PublishedModel to map for storage
-	modelMap := map[string]interface{}{
-		"modelName":      model.ModelName,
-		"namespace":      model.Namespace,
-		"tenantId":       model.TenantID,
-		"modelType":      model.ModelType,
-		"externalUrl":    model.ExternalURL,
-		"publicHostname": model.PublicHostname,
-		"apiKey":         model.APIKey,
-		"rateLimiting":   model.RateLimiting,
-		"status":         model.Status,
-		"createdAt":      model.CreatedAt,
-		"updatedAt":      model.UpdatedAt,
-		"usage":          model.Usage,
-		"documentation":  model.Documentation,
+// storedDocumentation returns the model's documentation with the API key in its examples
+// replaced by the placeholder, so the key is only ever stored in its Secret
+func storedDocumentation(model PublishedModel) APIDocumentation {
+	documentation, err := replaceDocumentationAPIKey(model.Documentation, model.APIKey, previewAPIKeyPlaceholder)
+	if err != nil {
+		log.Printf("Failed to redact API key from documentation of %s/%s: %v", model.Namespace, model.ModelName, err)
+		return APIDocumentation{}
+	}
+	return documentation
+}
+
+// replaceDocumentationAPIKey replaces every copy of oldKey in the documentation with newKey
+func replaceDocumentationAPIKey(documentation APIDocumentation, oldKey, newKey string) (APIDocumentation, error) {
+	if oldKey == "" {
+		return documentation, nil
+	}
+	// Keep <, > and & unescaped so the placeholder can be found in the encoded form
+	var data strings.Builder
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(documentation); err != nil {
+		return APIDocumentation{}, err
+	}
+	var replaced APIDocumentation
+	if err := json.Unmarshal([]byte(strings.ReplaceAll(data.String(), oldKey, newKey)), &replaced); err != nil {
+		return APIDocumentation{}, err
+	}
+	return replaced, nil
+}
+
+// scrubLegacyAPIKey removes the plaintext API key that metadata written by older versions
+// carries, both as a field and in the documentation examples
+func scrubLegacyAPIKey(metadata map[string]interface{}) {
+	apiKey, _ := metadata["apiKey"].(string)
+	delete(metadata, "apiKey")
+	if apiKey == "" {
+		return
+	}
+	if _, ok := metadata["apiKeyMasked"]; !ok {
+		metadata["apiKeyMasked"] = maskUsageAPIKey(apiKey)
+	}
+
+	data, err := json.Marshal(metadata["documentation"])
+	if err != nil {
+		delete(metadata, "documentation")
+		return
+	}
+	var documentation interface{}
+	if err := json.Unmarshal([]byte(strings.ReplaceAll(string(data), apiKey, previewAPIKeyPlaceholder)), &documentation); err != nil {
+		delete(metadata, "documentation")
+		return
+	}
+	metadata["documentation"] = documentation
+}
+
+// loadStoredAPIKey reads the model's API key from its Secret and puts it back into the
+// documentation examples, which are stored with the placeholder
+func (s *PublishingService) loadStoredAPIKey(model *PublishedModel) {
+	secretName := fmt.Sprintf("published-model-apikey-%s", model.ModelName)
+	secret, err := s.k8sClient.GetAPIKeySecret(model.Namespace, secretName)
+	if err != nil {
+		if !IsNotFound(err) {
+			log.Printf("Failed to get API key secret of %s/%s: %v", model.Namespace, model.ModelName, err)
+		}
+		return
+	}
+
+	stored, _ := secret["apiKey"].(string)
+	apiKey, err := decryptAPIKey(ActiveConfig(), model.Namespace, model.ModelName, stored)
+	if err != nil {
+		log.Printf("Failed to read API key of %s/%s: %v", model.Namespace, model.ModelName, err)
+		return
+	}
+	model.APIKey = apiKey
+	if model.APIKeyID == "" {
+		model.APIKeyID, _ = secret["keyId"].(string)
+	}
+	if model.APIKeyMasked == "" {
+		model.APIKeyMasked = maskUsageAPIKey(apiKey)
+	}
+	if documentation, err := replaceDocumentationAPIKey(model.Documentation, previewAPIKeyPlaceholder, apiKey); err == nil {
+		model.Documentation = documentation
 	}
-	
-	// Store the metadata using K8s client
-	return s.k8sClient.CreatePublishedModelMetadata(namespace, modelName, modelMap)
 }
 
 func (s *PublishingService) getPublishedModelMetadata(namespace, modelName string) (*PublishedModel, error) {
@@ -1298,12 +2784,61 @@ func (s *PublishingService) getPublishedModelMetadata(namespace, modelName strin
 	if v, ok := metadata["publicHostname"].(string); ok {
 		model.PublicHostname = v
 	}
-	if v, ok := metadata["apiKey"].(string); ok {
-		model.APIKey = v
+	if v, ok := metadata["apiKeyId"].(string); ok {
+		model.APIKeyID = v
+	}
+	if v, ok := metadata["apiKeyMasked"].(string); ok {
+		model.APIKeyMasked = v
 	}
 	if v, ok := metadata["status"].(string); ok {
 		model.Status = v
 	}
+	if v, ok := metadata["missingResources"].([]interface{}); ok {
+		for _, item := range v {
+			if resource, ok := item.(string); ok {
+				model.MissingResources = append(model.MissingResources, resource)
+			}
+		}
+	}
+	if v, ok := metadata["orphanedAt"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			model.OrphanedAt = &t
+		}
+	}
+	if v, ok := metadata["errorRateThreshold"].(float64); ok {
+		model.ErrorRateThreshold = v
+	}
+	if v, ok := metadata["errorRateExceeded"].(bool); ok {
+		model.ErrorRateExceeded = v
+	}
+	if v, ok := metadata["cacheTTL"].(string); ok {
+		model.CacheTTL = v
+	}
+	if v, ok := metadata["pathMappings"]; ok {
+		model.PathMappings = parsePathMappings(v)
+	}
+	if v, ok := metadata["mirror"]; ok {
+		model.Mirror = parseMirrorConfig(v)
+	}
+	if v, ok := metadata["cors"]; ok {
+		model.CORS = parseCORSConfig(v)
+	}
+	if v, ok := metadata["openai"]; ok {
+		model.OpenAI = parseOpenAIConfig(v)
+	}
+	if v, ok := metadata["requestTransform"]; ok {
+		model.RequestTransform = parseRequestTransformConfig(v)
+	}
+	if v, ok := metadata["predictMethods"].([]interface{}); ok {
+		for _, item := range v {
+			if method, ok := item.(string); ok {
+				model.PredictMethods = append(model.PredictMethods, method)
+			}
+		}
+	}
+	if v, ok := metadata["config"]; ok {
+		model.Config = parsePublishConfig(v)
+	}
 	
 	// Handle time fields
 	if v, ok := metadata["createdAt"].(string); ok {
@@ -1316,22 +2851,8 @@ func (s *PublishingService) getPublishedModelMetadata(namespace, modelName strin
 			model.UpdatedAt = t
 		}
 	}
-	
-	// Handle nested structures (simplified for now)
-	if v, ok := metadata["rateLimiting"].(map[string]interface{}); ok {
-		if rpm, ok := v["requestsPerMinute"].(float64); ok {
-			model.RateLimiting.RequestsPerMinute = int(rpm)
-		}
-		if rph, ok := v["requestsPerHour"].(float64); ok {
-			model.RateLimiting.RequestsPerHour = int(rph)
-		}
-		if tph, ok := v["tokensPerHour"].(float64); ok {
-			model.RateLimiting.TokensPerHour = int(tph)
-		}
-		if bl, ok := v["burstLimit"].(float64); ok {
-			model.RateLimiting.BurstLimit = int(bl)
-		}
-	}
+	model.RateLimiting = parseRateLimitConfig(model.Namespace+"/"+model.ModelName, metadata["rateLimiting"])
+	s.loadStoredAPIKey(model)
 	
 	return model, nil
 }
@@ -1350,6 +2871,8 @@ func (s *PublishingService) listAllPublishedModels() ([]PublishedModel, error) {
 		}
 	}
 	
+	sortPublishedModels(models, "modelName", false)
+	
 	return models, nil
 }
 
@@ -1367,9 +2890,211 @@ func (s *PublishingService) listPublishedModelsByTenant(tenantID string) ([]Publ
 		}
 	}
 	
+	sortPublishedModels(models, "modelName", false)
+	
 	return models, nil
 }
 
+// sortPublishedModels orders published models by modelName, createdAt or updatedAt. Ties are
+// broken by namespace and model name so the order does not depend on ConfigMap listing order.
+func sortPublishedModels(models []PublishedModel, field string, descending bool) {
+	sort.SliceStable(models, func(i, j int) bool {
+		a, b := models[i], models[j]
+		if descending {
+			a, b = b, a
+		}
+		switch field {
+		case "createdAt":
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+		case "updatedAt":
+			if !a.UpdatedAt.Equal(b.UpdatedAt) {
+				return a.UpdatedAt.Before(b.UpdatedAt)
+			}
+		}
+		if a.ModelName != b.ModelName {
+			return a.ModelName < b.ModelName
+		}
+		return a.Namespace < b.Namespace
+	})
+}
+
+// parsePathMappings converts stored path mappings back from their generic JSON form
+func parsePathMappings(value interface{}) []PathMapping {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var mappings []PathMapping
+	if err := json.Unmarshal(data, &mappings); err != nil {
+		return nil
+	}
+	return mappings
+}
+
+// submittedPublishConfig returns the config to store with a published model. The model type and
+// external path are resolved to what was actually published, so the config can be resubmitted as is.
+func submittedPublishConfig(config PublishConfig, modelType, externalURL string) *PublishConfig {
+	config.ModelType = modelType
+	if parsed, err := url.Parse(externalURL); err == nil && parsed.Path != "" {
+		config.ExternalPath = parsed.Path
+	}
+	return &config
+}
+
+// publishConfigFromModel returns the stored publish config of a model. Models published before the
+// config was stored get one rebuilt from the fields kept in their metadata.
+func publishConfigFromModel(model PublishedModel) PublishConfig {
+	if model.Config != nil {
+		return *model.Config
+	}
+
+	config := PublishConfig{
+		TenantID:       model.TenantID,
+		ModelType:      model.ModelType,
+		PublicHostname: model.PublicHostname,
+		RateLimiting:   model.RateLimiting,
+		Authentication: AuthConfig{RequireAPIKey: true},
+		CacheTTL:       model.CacheTTL,
+		PathMappings:   model.PathMappings,
+		Mirror:         model.Mirror,
+		PredictMethods: model.PredictMethods,
+		CORS:           model.CORS,
+		OpenAI:         model.OpenAI,
+		RequestTransform: model.RequestTransform,
+		ErrorRateThreshold: model.ErrorRateThreshold,
+	}
+	if externalURL, err := url.Parse(model.ExternalURL); err == nil {
+		config.ExternalPath = externalURL.Path
+	}
+	return config
+}
+
+// parsePublishConfig converts a stored publish config back from its generic JSON form
+func parsePublishConfig(value interface{}) *PublishConfig {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var config PublishConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil
+	}
+	return &config
+}
+
+// Largest rate-limit value accepted from stored metadata. Envoy Gateway limits are 32-bit, so
+// anything larger can only come from corrupted metadata.
+const maxStoredRateLimitValue = math.MaxInt32
+
+// parseRateLimitConfig converts stored rate limits back from their generic form. Decoded JSON holds
+// float64 values while metadata built in memory holds ints or the struct itself, so all of these
+// are accepted. Missing or invalid values read as 0 and out-of-range values are clamped, with a
+// warning naming the model, so corrupted metadata never fails the whole model.
+func parseRateLimitConfig(model string, value interface{}) RateLimitConfig {
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		fields = map[string]interface{}{}
+		if value == nil {
+			log.Printf("Warning: published model %s has no stored rate limits", model)
+		} else if data, err := json.Marshal(value); err != nil || json.Unmarshal(data, &fields) != nil {
+			log.Printf("Warning: published model %s has unreadable rate limits of type %T", model, value)
+		}
+	}
+	
+	field := func(name string, required bool) int {
+		raw, exists := fields[name]
+		if !exists || raw == nil {
+			// Limits added after the first release are missing from older metadata and mean no limit
+			if required && len(fields) > 0 {
+				log.Printf("Warning: published model %s is missing rateLimiting.%s, using 0", model, name)
+			}
+			return 0
+		}
+		
+		var number float64
+		switch n := raw.(type) {
+		case float64:
+			number = n
+		case float32:
+			number = float64(n)
+		case int:
+			number = float64(n)
+		case int32:
+			number = float64(n)
+		case int64:
+			number = float64(n)
+		case json.Number:
+			parsed, err := n.Float64()
+			if err != nil {
+				log.Printf("Warning: published model %s has invalid rateLimiting.%s %q, using 0", model, name, n)
+				return 0
+			}
+			number = parsed
+		default:
+			log.Printf("Warning: published model %s has non-numeric rateLimiting.%s of type %T, using 0", model, name, raw)
+			return 0
+		}
+		
+		switch {
+		case math.IsNaN(number):
+			log.Printf("Warning: published model %s has invalid rateLimiting.%s, using 0", model, name)
+			return 0
+		case number < 0:
+			log.Printf("Warning: published model %s has negative rateLimiting.%s %v, using 0", model, name, number)
+			return 0
+		case number > maxStoredRateLimitValue:
+			log.Printf("Warning: published model %s has out-of-range rateLimiting.%s %v, using %d", model, name, number, maxStoredRateLimitValue)
+			return maxStoredRateLimitValue
+		}
+		return int(number)
+	}
+	
+	return RateLimitConfig{
+		RequestsPerMinute:        field("requestsPerMinute", true),
+		RequestsPerHour:          field("requestsPerHour", true),
+		TokensPerHour:            field("tokensPerHour", false),
+		BurstLimit:               field("burstLimit", false),
+		MaxRequestBytes:          field("maxRequestBytes", false),
+		MaxConcurrentConnections: field("maxConcurrentConnections", false),
+	}
+}
+
+// parseMirrorConfig converts a stored mirror target back from its generic JSON form
+func parseMirrorConfig(value interface{}) *MirrorConfig {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var mirror MirrorConfig
+	if err := json.Unmarshal(data, &mirror); err != nil || mirror.ModelName == "" {
+		return nil
+	}
+	return &mirror
+}
+
+// parseOpenAIConfig converts stored OpenAI settings back from their generic JSON form
+func parseOpenAIConfig(value interface{}) *OpenAIConfig {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var openai OpenAIConfig
+	if err := json.Unmarshal(data, &openai); err != nil {
+		return nil
+	}
+	return &openai
+}
+
+// pathMappingsEqual reports whether two path mapping lists are the same, treating nil and empty as equal
+func pathMappingsEqual(a, b []PathMapping) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
 func (s *PublishingService) convertMetadataToModel(metadata map[string]interface{}) (*PublishedModel, error) {
 	model := &PublishedModel{}
 	
@@ -1391,12 +3116,61 @@ func (s *PublishingService) convertMetadataToModel(metadata map[string]interface
 	if v, ok := metadata["publicHostname"].(string); ok {
 		model.PublicHostname = v
 	}
-	if v, ok := metadata["apiKey"].(string); ok {
-		model.APIKey = v
+	if v, ok := metadata["apiKeyId"].(string); ok {
+		model.APIKeyID = v
+	}
+	if v, ok := metadata["apiKeyMasked"].(string); ok {
+		model.APIKeyMasked = v
 	}
 	if v, ok := metadata["status"].(string); ok {
 		model.Status = v
 	}
+	if v, ok := metadata["missingResources"].([]interface{}); ok {
+		for _, item := range v {
+			if resource, ok := item.(string); ok {
+				model.MissingResources = append(model.MissingResources, resource)
+			}
+		}
+	}
+	if v, ok := metadata["orphanedAt"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			model.OrphanedAt = &t
+		}
+	}
+	if v, ok := metadata["errorRateThreshold"].(float64); ok {
+		model.ErrorRateThreshold = v
+	}
+	if v, ok := metadata["errorRateExceeded"].(bool); ok {
+		model.ErrorRateExceeded = v
+	}
+	if v, ok := metadata["cacheTTL"].(string); ok {
+		model.CacheTTL = v
+	}
+	if v, ok := metadata["pathMappings"]; ok {
+		model.PathMappings = parsePathMappings(v)
+	}
+	if v, ok := metadata["mirror"]; ok {
+		model.Mirror = parseMirrorConfig(v)
+	}
+	if v, ok := metadata["cors"]; ok {
+		model.CORS = parseCORSConfig(v)
+	}
+	if v, ok := metadata["openai"]; ok {
+		model.OpenAI = parseOpenAIConfig(v)
+	}
+	if v, ok := metadata["requestTransform"]; ok {
+		model.RequestTransform = parseRequestTransformConfig(v)
+	}
+	if v, ok := metadata["predictMethods"].([]interface{}); ok {
+		for _, item := range v {
+			if method, ok := item.(string); ok {
+				model.PredictMethods = append(model.PredictMethods, method)
+			}
+		}
+	}
+	if v, ok := metadata["config"]; ok {
+		model.Config = parsePublishConfig(v)
+	}
 	
 	// Handle time fields
 	if v, ok := metadata["createdAt"].(string); ok {
@@ -1409,6 +3183,8 @@ func (s *PublishingService) convertMetadataToModel(metadata map[string]interface
 			model.UpdatedAt = t
 		}
 	}
+	model.RateLimiting = parseRateLimitConfig(model.Namespace+"/"+model.ModelName, metadata["rateLimiting"])
+	s.loadStoredAPIKey(model)
 	
 	return model, nil
 }
@@ -1417,9 +3193,15 @@ func (s *PublishingService) storeAPIKey(namespace, modelName, apiKey string, met
 	// Store API key in Kubernetes secret
 	secretName := fmt.Sprintf("published-model-apikey-%s", modelName)
 	
+	// The key value is encrypted when API_KEY_ENCRYPTION_KEY is set; metadata stays readable
+	storedKey, err := encryptAPIKey(ActiveConfig(), namespace, modelName, apiKey)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt API key: %w", err)
+	}
+	
 	// Create secret data
 	secretData := map[string]interface{}{
-		"apiKey": apiKey,
+		"apiKey": storedKey,
 		"keyId": metadata.KeyID,
 		"modelName": metadata.ModelName,
 		"namespace": metadata.Namespace,
@@ -1440,12 +3222,20 @@ func (s *PublishingService) storeAPIKey(namespace, modelName, apiKey string, met
 }
 
 func (s *PublishingService) validateAPIKey(apiKey string) (*APIKeyMetadata, error) {
-	// Dynamically discover tenant namespaces
-	namespaces, err := s.k8sClient.GetTenantNamespaces()
-	if err != nil {
-		log.Printf("Failed to get tenant namespaces, falling back to hardcoded list: %v", err)
-		// Fallback to hardcoded list if discovery fails
-		namespaces = []string{"tenant-a", "tenant-b", "tenant-c"}
+	var namespaces []string
+	if namespace, shortID, ok := parseAPIKeyPrefix(apiKey); ok {
+		// Prefixed keys name their namespace, so only that namespace is searched
+		log.Printf("Validating API key %s for namespace %s", shortID, namespace)
+		namespaces = []string{namespace}
+	} else {
+		// Dynamically discover tenant namespaces
+		discovered, err := s.k8sClient.GetTenantNamespaces()
+		if err != nil {
+			log.Printf("Failed to get tenant namespaces, falling back to hardcoded list: %v", err)
+			// Fallback to hardcoded list if discovery fails
+			discovered = []string{"tenant-a", "tenant-b", "tenant-c"}
+		}
+		namespaces = discovered
 	}
 	
 	for _, namespace := range namespaces {
@@ -1457,7 +3247,7 @@ func (s *PublishingService) validateAPIKey(apiKey string) (*APIKeyMetadata, erro
 		
 		for _, secret := range secrets {
 			// Check if this secret contains the API key
-			if storedKey, ok := secret["apiKey"].(string); ok && storedKey == apiKey {
+			if storedAPIKeyMatches(ActiveConfig(), namespace, secret, apiKey) {
 				// Found matching API key, construct metadata
 				metadata := &APIKeyMetadata{
 					Namespace: namespace,
@@ -1515,6 +3305,38 @@ func (s *PublishingService) updateAPIKeyLastUsed(namespace, modelName string) {
 }
 
 // generateKeyID generates a unique key ID
+// Marker of API keys that carry a readable namespace and key id prefix
+const apiKeyPrefix = "iib"
+
+// formatAPIKey builds an API key of the form iib_<namespace>_<shortid>_<secret>. Only the
+// secret part is random; the prefix attributes a leaked key to its namespace and key record.
+func formatAPIKey(namespace, keyID, secret string) string {
+	return fmt.Sprintf("%s_%s_%s_%s", apiKeyPrefix, namespace, apiKeyShortID(keyID), secret)
+}
+
+// apiKeyShortID shortens a key id to the 8 hex characters embedded in API keys
+func apiKeyShortID(keyID string) string {
+	shortID := strings.ReplaceAll(keyID, "-", "")
+	if len(shortID) > 8 {
+		shortID = shortID[:8]
+	}
+	return shortID
+}
+
+// parseAPIKeyPrefix extracts the namespace and short key id from a prefixed API key.
+// Keys issued before prefixes were introduced report ok=false.
+func parseAPIKeyPrefix(apiKey string) (namespace, shortID string, ok bool) {
+	parts := strings.SplitN(apiKey, "_", 4)
+	if len(parts) != 4 || parts[0] != apiKeyPrefix || parts[3] == "" {
+		return "", "", false
+	}
+	// Namespaces are DNS labels, so a malformed prefix cannot be mistaken for one
+	if errs := validation.IsDNS1123Label(parts[1]); len(errs) > 0 || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
 func generateKeyID() string {
 	return uuid.New().String()
 }
@@ -1531,24 +3353,14 @@ func (s *PublishingService) logPublishingEvent(user *User, modelName, namespace,
 		"userAgent": "management-service",
 	}
 	
+	// Forward to the external sink, if configured
+	exportLogEntry("audit", namespace, logEntry)
+	
 	// Store in ConfigMap for audit trail
 	auditLogName := fmt.Sprintf("publishing-audit-%s", time.Now().Format("2006-01-02"))
 	
-	// Try to get existing audit log for today
-	existingLog, err := s.k8sClient.GetConfigMap(namespace, auditLogName)
-	if err != nil {
-		// Create new audit log
-		auditData := map[string]interface{}{
-			"entries": []interface{}{logEntry},
-		}
-		s.k8sClient.CreateConfigMap(namespace, auditLogName, auditData)
-	} else {
-		// Append to existing audit log
-		if entries, ok := existingLog["entries"].([]interface{}); ok {
-			entries = append(entries, logEntry)
-			existingLog["entries"] = entries
-			s.k8sClient.UpdateConfigMap(namespace, auditLogName, existingLog)
-		}
+	if err := appendLogEntry(s.k8sClient, namespace, auditLogName, logEntry, newEntriesLog, nil); err != nil {
+		log.Printf("Failed to write audit log %s/%s: %v", namespace, auditLogName, err)
 	}
 }
 
@@ -1587,6 +3399,24 @@ func (s *PublishingService) cleanupGatewayConfiguration(namespace, modelName str
 		log.Printf("Failed to cleanup Backend %s: %v", backendName, err)
 	}
 	
+	// Delete the 405 filter of traditional routes
+	if err := s.k8sClient.DeleteHTTPRouteFilter("envoy-gateway-system", methodNotAllowedFilterName(routeName)); err != nil && !IsNotFound(err) {
+		log.Printf("Failed to cleanup HTTPRouteFilter %s: %v", methodNotAllowedFilterName(routeName), err)
+	}
+	
+	// Delete mirror Backend
+	if err := s.k8sClient.DeleteBackend("envoy-gateway-system", mirrorBackendName(modelName)); err != nil && !IsNotFound(err) {
+		log.Printf("Failed to cleanup mirror Backend %s: %v", mirrorBackendName(modelName), err)
+	}
+	
+	// Delete CORS preflight filter and SecurityPolicy
+	if err := s.k8sClient.DeleteHTTPRouteFilter("envoy-gateway-system", corsPreflightFilterName(routeName)); err != nil && !IsNotFound(err) {
+		log.Printf("Failed to cleanup HTTPRouteFilter %s: %v", corsPreflightFilterName(routeName), err)
+	}
+	if err := s.k8sClient.DeleteSecurityPolicy("envoy-gateway-system", corsSecurityPolicyName(routeName)); err != nil && !IsNotFound(err) {
+		log.Printf("Failed to cleanup SecurityPolicy %s: %v", corsSecurityPolicyName(routeName), err)
+	}
+	
 	
 	// Delete ReferenceGrant (now in istio-system)
 	if err := s.k8sClient.DeleteReferenceGrant("istio-system", grantName); err != nil {
@@ -1756,46 +3586,158 @@ func (s *PublishingService) updateGatewayForHostname(hostname string) error {
 		return nil
 	}
 	
-	// Get the current Gateway configuration
+	// The Gateway is shared by every tenant, so concurrent publishes can race on the
+	// read-modify-write. The update carries the fetched resourceVersion, and on a
+	// conflict the Gateway is refetched and the listener change re-applied.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Get the current Gateway configuration
+		gateway, err := s.k8sClient.GetGateway(gatewayNamespace, gatewayName)
+		if err != nil {
+			return fmt.Errorf("failed to get gateway %s/%s: %w", gatewayNamespace, gatewayName, err)
+		}
+		
+		// Extract the spec
+		spec, ok := gateway["spec"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("gateway spec is not a map")
+		}
+		
+		// Extract listeners
+		listeners, ok := spec["listeners"].([]interface{})
+		if !ok {
+			return fmt.Errorf("gateway listeners is not an array")
+		}
+		
+		// Check if hostname already exists in any listener
+		if s.hostnameExistsInListeners(listeners, hostname) {
+			log.Printf("Hostname %s already exists in gateway listeners", hostname)
+			return nil
+		}
+		
+		// Add hostname to appropriate listeners if needed
+		updatedListeners, updated, err := s.addHostnameToListeners(listeners, hostname)
+		if err != nil {
+			return err
+		}
+		
+		if updated {
+			// Update the listeners in the spec
+			spec["listeners"] = updatedListeners
+			
+			// Update the Gateway resource
+			if err := s.k8sClient.UpdateGateway(gatewayNamespace, gateway); err != nil {
+				if IsConflict(err) {
+					log.Printf("Conflict updating Gateway %s/%s for hostname %s, retrying", gatewayNamespace, gatewayName, hostname)
+				}
+				return err
+			}
+			
+			log.Printf("Updated Gateway %s/%s to include hostname: %s", gatewayNamespace, gatewayName, hostname)
+		}
+		
+		return nil
+	})
+}
+
+// GetGatewayHostnames handles GET /api/admin/gateway/hostnames
+// It reports the listener hostnames of the shared gateway and the published models each one serves.
+func (s *PublishingService) GetGatewayHostnames(c *gin.Context) {
+	gatewayNamespace := "envoy-gateway-system"
+	gatewayName := "ai-inference-gateway"
+	
 	gateway, err := s.k8sClient.GetGateway(gatewayNamespace, gatewayName)
 	if err != nil {
-		return fmt.Errorf("failed to get gateway %s/%s: %w", gatewayNamespace, gatewayName, err)
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to get gateway",
+			Details: err.Error(),
+		})
+		return
 	}
 	
-	// Extract the spec
-	spec, ok := gateway["spec"].(map[string]interface{})
-	if !ok {
-		return fmt.Errorf("gateway spec is not a map")
+	spec, _ := gateway["spec"].(map[string]interface{})
+	listeners, _ := spec["listeners"].([]interface{})
+	
+	// Group listeners by hostname, keeping the order they appear in the Gateway
+	var hostnames []*GatewayHostname
+	byHostname := make(map[string]*GatewayHostname)
+	for _, item := range listeners {
+		listener, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		hostname, _ := listener["hostname"].(string)
+		if hostname == "" {
+			hostname = "*"
+		}
+		entry, exists := byHostname[hostname]
+		if !exists {
+			entry = &GatewayHostname{Hostname: hostname, Listeners: []GatewayListener{}, PublishedModels: []string{}}
+			byHostname[hostname] = entry
+			hostnames = append(hostnames, entry)
+		}
+		
+		name, _ := listener["name"].(string)
+		protocol, _ := listener["protocol"].(string)
+		port, _ := listener["port"].(int64)
+		entry.Listeners = append(entry.Listeners, GatewayListener{Name: name, Protocol: protocol, Port: port})
 	}
 	
-	// Extract listeners
-	listeners, ok := spec["listeners"].([]interface{})
-	if !ok {
-		return fmt.Errorf("gateway listeners is not an array")
+	publishedModels, err := s.listAllPublishedModels()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to list published models",
+			Details: err.Error(),
+		})
+		return
 	}
 	
-	// Check if hostname already exists in any listener
-	if s.hostnameExistsInListeners(listeners, hostname) {
-		log.Printf("Hostname %s already exists in gateway listeners", hostname)
-		return nil
+	response := GatewayHostnamesResponse{
+		Gateway:         gatewayNamespace + "/" + gatewayName,
+		Hostnames:       []GatewayHostname{},
+		UnmatchedModels: []string{},
+	}
+	for _, model := range publishedModels {
+		modelRef := model.Namespace + "/" + model.ModelName
+		hostname := model.PublicHostname
+		if hostname == "" {
+			hostname = "api.router.inference-in-a-box"
+		}
+		if entry := matchListenerHostname(byHostname, hostname); entry != nil {
+			entry.PublishedModels = append(entry.PublishedModels, modelRef)
+		} else {
+			response.UnmatchedModels = append(response.UnmatchedModels, modelRef)
+		}
 	}
 	
-	// Add hostname to appropriate listeners if needed
-	updatedListeners, updated := s.addHostnameToListeners(listeners, hostname)
+	for _, entry := range hostnames {
+		response.Hostnames = append(response.Hostnames, *entry)
+	}
 	
-	if updated {
-		// Update the listeners in the spec
-		spec["listeners"] = updatedListeners
-		
-		// Update the Gateway resource
-		if err := s.k8sClient.UpdateGateway(gatewayNamespace, gateway); err != nil {
-			return fmt.Errorf("failed to update gateway: %w", err)
+	c.JSON(http.StatusOK, response)
+}
+
+// matchListenerHostname returns the most specific listener hostname serving host: an exact
+// match, then the longest matching wildcard, then a listener without a hostname
+func matchListenerHostname(byHostname map[string]*GatewayHostname, host string) *GatewayHostname {
+	host = strings.ToLower(host)
+	if entry, ok := byHostname[host]; ok {
+		return entry
+	}
+	
+	var best *GatewayHostname
+	for hostname, entry := range byHostname {
+		if !strings.HasPrefix(hostname, "*.") {
+			continue
+		}
+		if strings.HasSuffix(host, hostname[1:]) && (best == nil || len(hostname) > len(best.Hostname)) {
+			best = entry
 		}
-		
-		log.Printf("Updated Gateway %s/%s to include hostname: %s", gatewayNamespace, gatewayName, hostname)
+	}
+	if best != nil {
+		return best
 	}
 	
-	return nil
+	return byHostname["*"]
 }
 
 // isHostnameCoveredByWildcard checks if hostname is covered by existing wildcard patterns
@@ -1827,15 +3769,26 @@ func (s *PublishingService) hostnameExistsInListeners(listeners []interface{}, h
 	return false
 }
 
+// Listeners added to the shared gateway for each custom hostname, one HTTP and one HTTPS
+const listenersPerCustomHostname = 2
+
+// Share of MAX_GATEWAY_LISTENERS in use above which publishing a custom hostname warns
+const listenerCapacityWarningPercent = 80
+
 // addHostnameToListeners adds hostname to listeners if needed, returns updated listeners and bool if updated
-func (s *PublishingService) addHostnameToListeners(listeners []interface{}, hostname string) ([]interface{}, bool) {
+func (s *PublishingService) addHostnameToListeners(listeners []interface{}, hostname string) ([]interface{}, bool, error) {
 	updated := false
 	
 	// For custom hostnames that don't match our patterns, add specific listeners
 	if !s.isHostnameCoveredByWildcard(hostname) {
+		listenerName, err := s.sanitizeHostnameForName(hostname)
+		if err != nil {
+			return listeners, false, err
+		}
+		
 		// Add to both HTTP and HTTPS listeners as new listeners
 		httpListener := map[string]interface{}{
-			"name":     fmt.Sprintf("http-custom-%s", s.sanitizeHostnameForName(hostname)),
+			"name":     fmt.Sprintf("http-custom-%s", listenerName),
 			"protocol": "HTTP",
 			"port":     80,
 			"hostname": hostname,
@@ -1847,7 +3800,7 @@ func (s *PublishingService) addHostnameToListeners(listeners []interface{}, host
 		}
 		
 		httpsListener := map[string]interface{}{
-			"name":     fmt.Sprintf("https-custom-%s", s.sanitizeHostnameForName(hostname)),
+			"name":     fmt.Sprintf("https-custom-%s", listenerName),
 			"protocol": "HTTPS",
 			"port":     443,
 			"hostname": hostname,
@@ -1877,20 +3830,36 @@ func (s *PublishingService) addHostnameToListeners(listeners []interface{}, host
 		updated = true
 	}
 	
-	return listeners, updated
+	return listeners, updated, nil
 }
 
-// sanitizeHostnameForName converts hostname to valid Kubernetes name format
-func (s *PublishingService) sanitizeHostnameForName(hostname string) string {
+// Listener name suffixes are limited to this length, including the hostname hash
+const (
+	maxListenerNameSuffix = 40
+	listenerNameHashLen   = 8
+)
+
+// sanitizeHostnameForName converts hostname to a unique RFC 1123 label for listener names.
+// Dots, dashes and underscores all become dashes and long names are truncated, so distinct
+// hostnames can map to the same readable part. A short hash of the full hostname is appended
+// to keep the names unique.
+func (s *PublishingService) sanitizeHostnameForName(hostname string) (string, error) {
+	hostname = strings.ToLower(hostname)
+	if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(hostname, "*.")); len(errs) > 0 {
+		return "", fmt.Errorf("hostname %q cannot be used as a gateway listener: %s", hostname, strings.Join(errs, "; "))
+	}
+	
 	// Replace dots and other invalid characters with dashes
-	sanitized := strings.ReplaceAll(hostname, ".", "-")
-	sanitized = strings.ReplaceAll(sanitized, "_", "-")
+	sanitized := strings.NewReplacer("*.", "wildcard-", ".", "-", "_", "-").Replace(hostname)
 	
-	// Ensure it's not too long and is valid
-	if len(sanitized) > 40 {
-		sanitized = sanitized[:40]
+	// Leave room for the hash and keep the label ending in an alphanumeric character
+	readableLen := maxListenerNameSuffix - listenerNameHashLen - 1
+	if len(sanitized) > readableLen {
+		sanitized = sanitized[:readableLen]
 	}
+	sanitized = strings.TrimRight(sanitized, "-")
 	
-	return strings.ToLower(sanitized)
+	hash := sha256.Sum256([]byte(hostname))
+	return fmt.Sprintf("%s-%s", sanitized, hex.EncodeToString(hash[:])[:listenerNameHashLen]), nil
 }
 
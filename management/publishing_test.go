@@ -0,0 +1,257 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func TestSanitizeHostnameForName(t *testing.T) {
+	s := &PublishingService{}
+	hashSuffix := regexp.MustCompile(`-[0-9a-f]{8}$`)
+
+	tests := []struct {
+		name      string
+		hostnames []string // Hostnames that must all get valid, distinct listener names
+	}{
+		{
+			name: "same 31-character prefix",
+			hostnames: []string{
+				"models-tenant-a.inference.example.com",
+				"models-tenant-a.inference.example.org",
+				"models-tenant-a.inference.example.com.internal",
+			},
+		},
+		{
+			name: "dot versus dash",
+			hostnames: []string{
+				"api.router.example.com",
+				"api-router.example.com",
+				"api.router-example.com",
+			},
+		},
+		{
+			name: "wildcard versus literal prefix",
+			hostnames: []string{
+				"*.example.com",
+				"wildcard.example.com",
+				"wildcard-example.com",
+			},
+		},
+		{
+			name: "case only",
+			hostnames: []string{
+				"api.example.com",
+				"API.example.com",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := make(map[string]string)
+			for _, hostname := range tt.hostnames {
+				name, err := s.sanitizeHostnameForName(hostname)
+				if err != nil {
+					t.Fatalf("sanitizeHostnameForName(%q) returned error: %v", hostname, err)
+				}
+				if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+					t.Errorf("sanitizeHostnameForName(%q) = %q, not an RFC 1123 label: %s", hostname, name, strings.Join(errs, "; "))
+				}
+				if len(name) > maxListenerNameSuffix {
+					t.Errorf("sanitizeHostnameForName(%q) = %q, longer than %d", hostname, name, maxListenerNameSuffix)
+				}
+				if !hashSuffix.MatchString(name) {
+					t.Errorf("sanitizeHostnameForName(%q) = %q, missing the hostname hash", hostname, name)
+				}
+
+				// Hostnames differing only in case are the same host and may share a name
+				key := strings.ToLower(hostname)
+				if other, ok := seen[name]; ok && other != key {
+					t.Errorf("%q and %q both map to %q", other, hostname, name)
+				}
+				seen[name] = key
+			}
+		})
+	}
+
+	t.Run("stable", func(t *testing.T) {
+		first, _ := s.sanitizeHostnameForName("api.router.inference-in-a-box")
+		second, _ := s.sanitizeHostnameForName("api.router.inference-in-a-box")
+		if first != second {
+			t.Errorf("names differ between calls: %q and %q", first, second)
+		}
+	})
+
+	t.Run("wildcard prefix", func(t *testing.T) {
+		name, err := s.sanitizeHostnameForName("*.models.example.com")
+		if err != nil {
+			t.Fatalf("returned error: %v", err)
+		}
+		if !strings.HasPrefix(name, "wildcard-models-example-com-") {
+			t.Errorf("name = %q, want the wildcard-models-example-com prefix", name)
+		}
+	})
+}
+
+func TestSanitizeHostnameForNameRejectsInvalidHostnames(t *testing.T) {
+	s := &PublishingService{}
+	for _, hostname := range []string{
+		"",
+		"api_router.example.com", // Would sanitize like api.router and api-router, but is not a hostname
+		"-api.example.com",
+		"api.example.com-",
+		"api..example.com",
+		"api example.com",
+		"*.*.example.com",
+		"api.*.example.com",
+		strings.Repeat("a", 254),
+	} {
+		if name, err := s.sanitizeHostnameForName(hostname); err == nil {
+			t.Errorf("sanitizeHostnameForName(%q) = %q, want an error", hostname, name)
+		}
+	}
+}
+
+func TestParseRateLimitConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  RateLimitConfig
+	}{
+		{
+			name:  "decoded JSON floats",
+			value: map[string]interface{}{"requestsPerMinute": float64(100), "requestsPerHour": float64(1000), "tokensPerHour": float64(5000), "burstLimit": float64(10)},
+			want:  RateLimitConfig{RequestsPerMinute: 100, RequestsPerHour: 1000, TokensPerHour: 5000, BurstLimit: 10},
+		},
+		{
+			name:  "fractional float truncated",
+			value: map[string]interface{}{"requestsPerMinute": 99.9, "requestsPerHour": float64(1000)},
+			want:  RateLimitConfig{RequestsPerMinute: 99, RequestsPerHour: 1000},
+		},
+		{
+			name:  "ints built in memory",
+			value: map[string]interface{}{"requestsPerMinute": 60, "requestsPerHour": int64(3600), "burstLimit": int32(5)},
+			want:  RateLimitConfig{RequestsPerMinute: 60, RequestsPerHour: 3600, BurstLimit: 5},
+		},
+		{
+			name:  "json.Number",
+			value: map[string]interface{}{"requestsPerMinute": json.Number("120"), "requestsPerHour": json.Number("not-a-number")},
+			want:  RateLimitConfig{RequestsPerMinute: 120},
+		},
+		{
+			name:  "numeric strings",
+			value: map[string]interface{}{"requestsPerMinute": "100", "requestsPerHour": float64(1000)},
+			want:  RateLimitConfig{RequestsPerHour: 1000},
+		},
+		{
+			name:  "negative",
+			value: map[string]interface{}{"requestsPerMinute": float64(-1), "requestsPerHour": -50},
+			want:  RateLimitConfig{},
+		},
+		{
+			name:  "NaN",
+			value: map[string]interface{}{"requestsPerMinute": math.NaN(), "requestsPerHour": float64(1000)},
+			want:  RateLimitConfig{RequestsPerHour: 1000},
+		},
+		{
+			name:  "over MaxInt32 clamped",
+			value: map[string]interface{}{"requestsPerMinute": float64(math.MaxInt32) + 1, "requestsPerHour": math.Inf(1), "tokensPerHour": int64(math.MaxInt64)},
+			want:  RateLimitConfig{RequestsPerMinute: math.MaxInt32, RequestsPerHour: math.MaxInt32, TokensPerHour: math.MaxInt32},
+		},
+		{
+			name:  "missing and null fields",
+			value: map[string]interface{}{"requestsPerMinute": nil},
+			want:  RateLimitConfig{},
+		},
+		{
+			name:  "nil map",
+			value: map[string]interface{}(nil),
+			want:  RateLimitConfig{},
+		},
+		{
+			name:  "nil",
+			value: nil,
+			want:  RateLimitConfig{},
+		},
+		{
+			name:  "struct value",
+			value: RateLimitConfig{RequestsPerMinute: 30, RequestsPerHour: 300, MaxRequestBytes: 1024},
+			want:  RateLimitConfig{RequestsPerMinute: 30, RequestsPerHour: 300, MaxRequestBytes: 1024},
+		},
+		{
+			name:  "string value",
+			value: "100 per minute",
+			want:  RateLimitConfig{},
+		},
+		{
+			name:  "slice value",
+			value: []interface{}{float64(100), float64(1000)},
+			want:  RateLimitConfig{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRateLimitConfig("my-model", tt.value); got != tt.want {
+				t.Errorf("parseRateLimitConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScrubLegacyAPIKey(t *testing.T) {
+	apiKey := "iib_tenant-a_3f2a9c1d_c2VjcmV0LXBhcnQ"
+	metadata := map[string]interface{}{
+		"modelName": "my-model",
+		"apiKey":    apiKey,
+		"documentation": map[string]interface{}{
+			"examples": []interface{}{"curl -H 'X-API-Key: " + apiKey + "' https://api.example.com"},
+		},
+	}
+
+	scrubLegacyAPIKey(metadata)
+
+	if _, ok := metadata["apiKey"]; ok {
+		t.Error("apiKey is still stored")
+	}
+	if got := metadata["apiKeyMasked"]; got != "iib_tenant-a_3f2a9c1d_..." {
+		t.Errorf("apiKeyMasked = %v, want iib_tenant-a_3f2a9c1d_...", got)
+	}
+	data, _ := json.Marshal(metadata)
+	if strings.Contains(string(data), "c2VjcmV0LXBhcnQ") {
+		t.Errorf("metadata still contains the key: %s", data)
+	}
+	documentation, _ := metadata["documentation"].(map[string]interface{})
+	examples, _ := documentation["examples"].([]interface{})
+	if len(examples) != 1 || !strings.Contains(examples[0].(string), previewAPIKeyPlaceholder) {
+		t.Errorf("documentation = %v, want the example with the placeholder", metadata["documentation"])
+	}
+}
+
+func TestStoredDocumentationRoundTrip(t *testing.T) {
+	apiKey := "iib_tenant-a_3f2a9c1d_c2VjcmV0LXBhcnQ"
+	model := PublishedModel{
+		APIKey:        apiKey,
+		Documentation: APIDocumentation{AuthHeaders: map[string]string{"X-API-Key": apiKey}},
+	}
+
+	stored := storedDocumentation(model)
+	data, _ := json.Marshal(stored)
+	if strings.Contains(string(data), apiKey) {
+		t.Fatalf("stored documentation contains the key: %s", data)
+	}
+
+	restored, err := replaceDocumentationAPIKey(stored, previewAPIKeyPlaceholder, apiKey)
+	if err != nil {
+		t.Fatalf("replaceDocumentationAPIKey returned error: %v", err)
+	}
+	if !reflect.DeepEqual(restored, model.Documentation) {
+		t.Errorf("restored = %+v, want %+v", restored, model.Documentation)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Number of tracked windows above which expired ones are swept on the next increment
+const requestWindowSweepThreshold = 1024
+
+// RequestWindowCounter counts requests per key in fixed windows aligned to the window size,
+// matching the per-minute windows the gateway enforces. It only sees requests made through
+// this service, so it is an approximation of the gateway's own counters.
+type RequestWindowCounter struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	windows map[string]*requestWindow
+}
+
+type requestWindow struct {
+	start time.Time
+	count int64
+}
+
+// NewRequestWindowCounter creates a new counter with the given window size
+func NewRequestWindowCounter(window time.Duration) *RequestWindowCounter {
+	return &RequestWindowCounter{
+		window:  window,
+		windows: make(map[string]*requestWindow),
+	}
+}
+
+// Increment records a request for key and returns the count in the current window together
+// with the time the window resets
+func (r *RequestWindowCounter) Increment(key string, now time.Time) (int64, time.Time) {
+	start := now.Truncate(r.window)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.windows[key]
+	if !ok || current.start.Before(start) {
+		if !ok && len(r.windows) >= requestWindowSweepThreshold {
+			r.sweep(start)
+		}
+		current = &requestWindow{start: start}
+		r.windows[key] = current
+	}
+	current.count++
+	return current.count, current.start.Add(r.window)
+}
+
+// sweep drops windows that ended before start. Callers must hold r.mu.
+func (r *RequestWindowCounter) sweep(start time.Time) {
+	for key, window := range r.windows {
+		if window.start.Before(start) {
+			delete(r.windows, key)
+		}
+	}
+}
+
+// setRateLimitHeaders records a gateway prediction for the API key and model and sets the
+// X-RateLimit-* headers from the current minute's count. Headers sent by the gateway itself take
+// precedence, since they reflect its actual counters.
+func (s *ModelService) setRateLimitHeaders(c *gin.Context, upstream http.Header, namespace, modelName, apiKey string, requestsPerMinute int) {
+	if upstream.Get("X-RateLimit-Limit") != "" {
+		for _, name := range []string{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset"} {
+			if value := upstream.Get(name); value != "" {
+				c.Header(name, value)
+			}
+		}
+		return
+	}
+	if requestsPerMinute <= 0 {
+		return
+	}
+
+	now := time.Now()
+	count, resetAt := s.requestWindows.Increment(namespace+"/"+modelName+"/"+maskUsageAPIKey(apiKey), now)
+
+	remaining := int64(requestsPerMinute) - count
+	if remaining < 0 {
+		remaining = 0
+	}
+	c.Header("X-RateLimit-Limit", strconv.Itoa(requestsPerMinute))
+	c.Header("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+	c.Header("X-RateLimit-Reset", strconv.Itoa(int(math.Ceil(resetAt.Sub(now).Seconds()))))
+}
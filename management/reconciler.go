@@ -0,0 +1,392 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PublishingReconciler periodically compares published-model metadata with the live gateway resources
+type PublishingReconciler struct {
+	publishingService *PublishingService
+	k8sClient         *K8sClient
+	interval          time.Duration
+	recreate          bool
+	orphanGracePeriod time.Duration // Zero keeps orphaned models until they are unpublished by hand
+
+	mu      sync.RWMutex
+	lastRun *ReconcileRunResult
+}
+
+// NewPublishingReconciler creates a new reconciler for published models
+func NewPublishingReconciler(publishingService *PublishingService, config *Config) *PublishingReconciler {
+	interval, err := parseDuration(config.ReconcileInterval, 10*time.Second, 24*time.Hour)
+	if err != nil {
+		log.Printf("Invalid RECONCILE_INTERVAL: %v, using 5m", err)
+		interval = 5 * time.Minute
+	}
+
+	var orphanGracePeriod time.Duration
+	if config.ReconcileOrphanGracePeriod != "" {
+		orphanGracePeriod, err = parseDuration(config.ReconcileOrphanGracePeriod, time.Minute, 30*24*time.Hour)
+		if err != nil {
+			log.Printf("Invalid RECONCILE_ORPHAN_GRACE_PERIOD: %v, orphaned models will not be unpublished", err)
+			orphanGracePeriod = 0
+		}
+	}
+
+	return &PublishingReconciler{
+		publishingService: publishingService,
+		k8sClient:         publishingService.k8sClient,
+		interval:          interval,
+		recreate:          config.ReconcileRecreate,
+		orphanGracePeriod: orphanGracePeriod,
+	}
+}
+
+// Start runs the reconciler loop until ctx is cancelled
+func (r *PublishingReconciler) Start(ctx context.Context) {
+	log.Printf("Starting published model reconciler (interval: %s, recreate: %t)", r.interval, r.recreate)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.RunOnce()
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			log.Println("Stopping published model reconciler")
+			return
+		}
+	}
+}
+
+// RunOnce performs a single reconciliation pass over all published models
+func (r *PublishingReconciler) RunOnce() ReconcileRunResult {
+	result := ReconcileRunResult{
+		StartedAt: time.Now(),
+		Recreate:  r.recreate,
+		Models:    []ReconcileModelResult{},
+	}
+
+	models, err := r.publishingService.listAllPublishedModels()
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	for _, model := range models {
+		modelResult := r.reconcileModel(model)
+		switch modelResult.Status {
+		case "degraded":
+			result.Degraded++
+		case "orphaned":
+			result.Orphaned++
+		case "unpublished":
+			result.Unpublished++
+		}
+		result.Models = append(result.Models, modelResult)
+	}
+
+	result.Checked = len(result.Models)
+	result.CompletedAt = time.Now()
+
+	if result.Degraded > 0 {
+		log.Printf("Reconciler found %d degraded published model(s) out of %d", result.Degraded, result.Checked)
+	}
+	if result.Orphaned > 0 {
+		log.Printf("Reconciler found %d orphaned published model(s) out of %d", result.Orphaned, result.Checked)
+	}
+
+	r.mu.Lock()
+	r.lastRun = &result
+	r.mu.Unlock()
+
+	return result
+}
+
+// reconcileModel verifies a published model's resources and records the outcome in its metadata
+func (r *PublishingReconciler) reconcileModel(model PublishedModel) ReconcileModelResult {
+	namespace := model.Namespace
+	modelName := model.ModelName
+
+	result := ReconcileModelResult{
+		ModelName: modelName,
+		Namespace: namespace,
+	}
+
+	// A published model without its InferenceService is a dead endpoint, whatever its gateway state
+	if _, err := r.k8sClient.GetInferenceService(namespace, modelName); err != nil && IsNotFound(err) {
+		return r.reconcileOrphanedModel(model, result)
+	}
+
+	missing := r.findMissingResources(model)
+	if len(missing) > 0 && r.recreate {
+		result.Recreated = r.recreateResources(model, missing)
+		missing = r.findMissingResources(model)
+	}
+
+	result.MissingResources = missing
+	result.ErrorRateExceeded = r.checkErrorRate(model)
+	result.Status = "active"
+	if len(missing) > 0 || result.ErrorRateExceeded {
+		result.Status = "degraded"
+	}
+
+	// Only touch the stored metadata when the declared state actually changed
+	if result.Status != model.Status || model.OrphanedAt != nil || !sameResources(missing, model.MissingResources) ||
+		result.ErrorRateExceeded != model.ErrorRateExceeded {
+		metadata, err := r.k8sClient.GetPublishedModelMetadata(namespace, modelName)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+
+		metadata["status"] = result.Status
+		delete(metadata, "orphanedAt")
+		if len(missing) > 0 {
+			metadata["missingResources"] = missing
+		} else {
+			delete(metadata, "missingResources")
+		}
+		if result.ErrorRateExceeded {
+			metadata["errorRateExceeded"] = true
+		} else {
+			delete(metadata, "errorRateExceeded")
+		}
+		metadata["updatedAt"] = time.Now()
+		scrubLegacyAPIKey(metadata)
+
+		if err := r.k8sClient.UpdatePublishedModelMetadata(namespace, modelName, metadata); err != nil {
+			result.Error = err.Error()
+		}
+	}
+
+	return result
+}
+
+// checkErrorRate reports whether a published model's error rate today is above its threshold,
+// and alerts when it has just crossed it
+func (r *PublishingReconciler) checkErrorRate(model PublishedModel) bool {
+	if model.ErrorRateThreshold <= 0 {
+		return false
+	}
+
+	rate, err := r.publishingService.currentErrorRate(model)
+	if err != nil {
+		log.Printf("Failed to check error rate of %s/%s: %v", model.Namespace, model.ModelName, err)
+		return model.ErrorRateExceeded
+	}
+	if rate.Exceeded && !model.ErrorRateExceeded {
+		alertErrorRateExceeded(model, rate)
+	}
+	return rate.Exceeded
+}
+
+// reconcileOrphanedModel marks a published model whose InferenceService was deleted as orphaned,
+// and unpublishes it once it has been orphaned for longer than the grace period
+func (r *PublishingReconciler) reconcileOrphanedModel(model PublishedModel, result ReconcileModelResult) ReconcileModelResult {
+	namespace := model.Namespace
+	modelName := model.ModelName
+
+	orphanedAt := time.Now()
+	if model.OrphanedAt != nil {
+		orphanedAt = *model.OrphanedAt
+	}
+	result.OrphanedAt = &orphanedAt
+
+	if r.orphanGracePeriod > 0 && time.Since(orphanedAt) >= r.orphanGracePeriod {
+		log.Printf("Unpublishing orphaned model %s/%s, orphaned since %s", namespace, modelName, orphanedAt.Format(time.RFC3339))
+		r.publishingService.cleanupPublishedResources(namespace, modelName)
+		r.publishingService.logPublishingEvent(reconcilerUser, modelName, namespace, "auto-unpublished")
+		result.Status = "unpublished"
+		return result
+	}
+
+	result.Status = "orphaned"
+	if model.Status == "orphaned" && model.OrphanedAt != nil {
+		return result
+	}
+
+	log.Printf("ALERT: published model %s/%s is orphaned, its InferenceService no longer exists", namespace, modelName)
+	metadata, err := r.k8sClient.GetPublishedModelMetadata(namespace, modelName)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	metadata["status"] = result.Status
+	metadata["orphanedAt"] = orphanedAt
+	delete(metadata, "missingResources")
+	metadata["updatedAt"] = time.Now()
+	scrubLegacyAPIKey(metadata)
+
+	if err := r.k8sClient.UpdatePublishedModelMetadata(namespace, modelName, metadata); err != nil {
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// rotateLostAPIKey issues a new API key for a published model whose key Secret was deleted and
+// records its id and masked form in the model's metadata. Clients must be given the new key.
+func (r *PublishingReconciler) rotateLostAPIKey(model PublishedModel) error {
+	namespace := model.Namespace
+	modelName := model.ModelName
+
+	owner := *reconcilerUser
+	owner.Tenant = model.TenantID
+	keyMetadata, apiKey, err := r.publishingService.generateAPIKey(&owner, modelName, namespace, model.ModelType)
+	if err != nil {
+		return err
+	}
+	log.Printf("ALERT: API key Secret of published model %s/%s was lost, issued new key %s", namespace, modelName, maskUsageAPIKey(apiKey))
+	r.publishingService.logPublishingEvent(reconcilerUser, modelName, namespace, "api_key_rotated")
+
+	metadata, err := r.k8sClient.GetPublishedModelMetadata(namespace, modelName)
+	if err != nil {
+		return err
+	}
+	scrubLegacyAPIKey(metadata)
+	metadata["apiKeyId"] = keyMetadata.KeyID
+	metadata["apiKeyMasked"] = maskUsageAPIKey(apiKey)
+	metadata["updatedAt"] = time.Now()
+	return r.k8sClient.UpdatePublishedModelMetadata(namespace, modelName, metadata)
+}
+
+// reconcilerUser is recorded in the audit log for changes the reconciler makes on its own
+var reconcilerUser = &User{
+	Tenant:  "admin",
+	Name:    "reconciler",
+	IsAdmin: true,
+}
+
+// findMissingResources returns the resources created at publish time that no longer exist
+func (r *PublishingReconciler) findMissingResources(model PublishedModel) []string {
+	var missing []string
+	for _, ref := range publishedResourceRefs(model.Namespace, model.ModelName, model.ModelType, model.Mirror != nil, model.CORS != nil) {
+		if err := r.k8sClient.getPublishedResource(ref); err != nil && IsNotFound(err) {
+			missing = append(missing, ref.Kind+"/"+ref.Name)
+		}
+	}
+	return missing
+}
+
+// recreateResources re-creates missing resources from the stored published-model metadata
+func (r *PublishingReconciler) recreateResources(model PublishedModel, missing []string) []string {
+	namespace := model.Namespace
+	modelName := model.ModelName
+
+	var recreated []string
+	gatewayMissing := false
+	for _, resource := range missing {
+		switch {
+		case strings.HasPrefix(resource, "BackendTrafficPolicy/"):
+			if err := r.publishingService.createRateLimitingPolicy(namespace, modelName, model.RateLimiting); err != nil {
+				log.Printf("Reconciler failed to recreate %s for %s/%s: %v", resource, namespace, modelName, err)
+				continue
+			}
+			recreated = append(recreated, resource)
+		case strings.HasPrefix(resource, "Secret/"):
+			// The key only ever lived in the Secret, so it cannot be rebuilt: issue a new one
+			if err := r.rotateLostAPIKey(model); err != nil {
+				log.Printf("Reconciler failed to recreate %s for %s/%s: %v", resource, namespace, modelName, err)
+				continue
+			}
+			recreated = append(recreated, resource)
+		default:
+			gatewayMissing = true
+		}
+	}
+
+	if gatewayMissing {
+		// Gateway resources depend on each other, so rebuild the whole set
+		config := publishConfigFromModel(model)
+
+		r.publishingService.cleanupGatewayConfiguration(namespace, modelName)
+		if _, err := r.publishingService.createGatewayConfiguration(namespace, modelName, model.ModelType, config); err != nil {
+			log.Printf("Reconciler failed to recreate gateway configuration for %s/%s: %v", namespace, modelName, err)
+		} else {
+			recreated = append(recreated, "gateway-configuration")
+		}
+	}
+
+	return recreated
+}
+
+// GetStatus handles GET /api/admin/reconciler
+func (r *PublishingReconciler) GetStatus(c *gin.Context) {
+	r.mu.RLock()
+	lastRun := r.lastRun
+	r.mu.RUnlock()
+
+	orphanGracePeriod := ""
+	if r.orphanGracePeriod > 0 {
+		orphanGracePeriod = r.orphanGracePeriod.String()
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"interval":          r.interval.String(),
+		"recreate":          r.recreate,
+		"orphanGracePeriod": orphanGracePeriod,
+		"lastRun":           lastRun,
+	})
+}
+
+// GetOrphanedModels handles GET /api/admin/published-models/orphaned
+// It lists published models the reconciler found without an InferenceService.
+func (r *PublishingReconciler) GetOrphanedModels(c *gin.Context) {
+	models, err := r.publishingService.listAllPublishedModels()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to list published models",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	response := OrphanedPublishedModelsResponse{
+		Models: []OrphanedPublishedModel{},
+	}
+	if r.orphanGracePeriod > 0 {
+		response.GracePeriod = r.orphanGracePeriod.String()
+	}
+
+	for _, model := range models {
+		if model.Status != "orphaned" || model.OrphanedAt == nil {
+			continue
+		}
+		orphaned := OrphanedPublishedModel{
+			ModelName:   model.ModelName,
+			Namespace:   model.Namespace,
+			ExternalURL: model.ExternalURL,
+			OrphanedAt:  *model.OrphanedAt,
+		}
+		if r.orphanGracePeriod > 0 {
+			autoUnpublishAt := model.OrphanedAt.Add(r.orphanGracePeriod)
+			orphaned.AutoUnpublishAt = &autoUnpublishAt
+		}
+		response.Models = append(response.Models, orphaned)
+	}
+	response.Total = len(response.Models)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func sameResources(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Bounds for request transformations of a published model
+const (
+	maxRequestTransformRemaps       = 20
+	maxRequestTransformTemplateSize = 16 * 1024
+)
+
+// requestTransformFuncs are the helpers available to transformation templates
+var requestTransformFuncs = template.FuncMap{
+	"json": func(value interface{}) (string, error) {
+		data, err := json.Marshal(value)
+		return string(data), err
+	},
+}
+
+// compileRequestTransformTemplate parses a transformation template. Missing keys fail the
+// transformation instead of rendering "<no value>" into the forwarded body.
+func compileRequestTransformTemplate(text string) (*template.Template, error) {
+	return template.New("requestTransform").Funcs(requestTransformFuncs).Option("missingkey=error").Parse(text)
+}
+
+// splitJSONPath splits a dot-separated field path, rejecting empty segments
+func splitJSONPath(path string) ([]string, error) {
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid field path %q", path)
+		}
+	}
+	return segments, nil
+}
+
+// applyRequestTransform reshapes a prediction input with the published model's transformation.
+// The result is always valid JSON.
+func applyRequestTransform(transform *RequestTransformConfig, input json.RawMessage) (json.RawMessage, error) {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("input is not valid JSON: %w", err)
+	}
+
+	if transform.Template != "" {
+		tmpl, err := compileRequestTransformTemplate(transform.Template)
+		if err != nil {
+			return nil, err
+		}
+		var out bytes.Buffer
+		if err := tmpl.Execute(&out, data); err != nil {
+			return nil, err
+		}
+		if !json.Valid(out.Bytes()) {
+			return nil, fmt.Errorf("template output is not valid JSON")
+		}
+		return out.Bytes(), nil
+	}
+
+	object, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("field remapping requires a JSON object input")
+	}
+	for _, remap := range transform.Remap {
+		from, err := splitJSONPath(remap.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := splitJSONPath(remap.To)
+		if err != nil {
+			return nil, err
+		}
+		// Inputs already in the model's shape pass through unchanged
+		value, found := takeJSONPath(object, from)
+		if !found {
+			continue
+		}
+		if err := setJSONPath(object, to, value); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(object)
+}
+
+// takeJSONPath removes the value at path and returns it
+func takeJSONPath(object map[string]interface{}, path []string) (interface{}, bool) {
+	for _, segment := range path[:len(path)-1] {
+		next, ok := object[segment].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		object = next
+	}
+	last := path[len(path)-1]
+	value, ok := object[last]
+	if ok {
+		delete(object, last)
+	}
+	return value, ok
+}
+
+// setJSONPath stores value at path, creating intermediate objects as needed
+func setJSONPath(object map[string]interface{}, path []string, value interface{}) error {
+	for i, segment := range path[:len(path)-1] {
+		next, exists := object[segment]
+		if !exists {
+			created := make(map[string]interface{})
+			object[segment] = created
+			object = created
+			continue
+		}
+		nested, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("cannot set %s: %s is not an object", strings.Join(path, "."), strings.Join(path[:i+1], "."))
+		}
+		object = nested
+	}
+	object[path[len(path)-1]] = value
+	return nil
+}
+
+// parseRequestTransformConfig converts stored transformation settings back from their generic JSON form
+func parseRequestTransformConfig(value interface{}) *RequestTransformConfig {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var transform RequestTransformConfig
+	if err := json.Unmarshal(data, &transform); err != nil || (transform.Template == "" && len(transform.Remap) == 0) {
+		return nil
+	}
+	return &transform
+}
+
+// requestTransform returns the transformation a published model applies to prediction inputs,
+// or nil when it has none
+func (s *ModelService) requestTransform(namespace, modelName string) *RequestTransformConfig {
+	if s.publishingService == nil {
+		return nil
+	}
+
+	publishedModel, err := s.publishingService.getPublishedModelMetadata(namespace, modelName)
+	if err != nil {
+		return nil
+	}
+	return publishedModel.RequestTransform
+}
@@ -0,0 +1,713 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"go/format"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Environment variable the generated clients read the API key from. Keys are never written
+// into a scaffold, so it can be shared or committed.
+const sdkAPIKeyEnvVar = "MODEL_API_KEY"
+
+// sdkScaffoldFile is one file of a generated client project. {package} in the path is replaced
+// with the package name.
+type sdkScaffoldFile struct {
+	path     string
+	template *template.Template
+}
+
+// sdkScaffoldData is what the client project templates are rendered from
+type sdkScaffoldData struct {
+	Language    string
+	ModelName   string
+	Namespace   string
+	BaseURL     string
+	OpenAI      bool
+	InputNames  []string
+	ServedModel string
+	MaxTokens   int
+	MinTokens   int
+	Temperature string
+	PackageName string // Python package or Go module name
+	APIKeyEnv   string
+	GeneratedAt string
+}
+
+var sdkScaffoldFuncs = template.FuncMap{
+	// tag writes a Go struct tag, which cannot appear inside the raw strings holding the templates
+	"tag": func(json string) string {
+		return "`json:\"" + json + "\"`"
+	},
+	"quote": strconv.Quote,
+}
+
+func sdkTemplate(name, text string) *template.Template {
+	return template.Must(template.New(name).Funcs(sdkScaffoldFuncs).Parse(text))
+}
+
+// sdkScaffolds lists the files of each supported client language
+var sdkScaffolds = map[string][]sdkScaffoldFile{
+	"python": {
+		{"pyproject.toml", sdkTemplate("pyproject", pythonPyprojectTemplate)},
+		{"README.md", sdkTemplate("readme", sdkReadmeTemplate)},
+		{"{package}/__init__.py", sdkTemplate("init", pythonInitTemplate)},
+		{"{package}/client.py", sdkTemplate("client", pythonClientTemplate)},
+		{"example.py", sdkTemplate("example", pythonExampleTemplate)},
+	},
+	"go": {
+		{"go.mod", sdkTemplate("gomod", goModTemplate)},
+		{"README.md", sdkTemplate("readme", sdkReadmeTemplate)},
+		{"client/client.go", sdkTemplate("client", goClientTemplate)},
+		{"cmd/example/main.go", sdkTemplate("example", goExampleTemplate)},
+	},
+}
+
+// GetClientSDK handles GET /api/models/:modelName/publish/sdk/:language
+// It returns a ready-to-run client project for a published model as a zip or tar.gz archive.
+func (s *PublishingService) GetClientSDK(c *gin.Context) {
+	modelName := c.Param("modelName")
+	language := c.Param("language")
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	files, ok := sdkScaffolds[language]
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Unsupported SDK language",
+			Details: "Language must be python or go",
+		})
+		return
+	}
+	format := c.DefaultQuery("format", "zip")
+	if format != "zip" && format != "tar.gz" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Format must be zip or tar.gz",
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin {
+		if ns := c.Query("namespace"); ns != "" {
+			namespace = ns
+		}
+	}
+
+	publishedModel, err := s.getPublishedModelMetadata(namespace, modelName)
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Published model not found",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	data := newSDKScaffoldData(*publishedModel, language, parseInputNames(map[string]string{"inputs": c.Query("inputs")}))
+	root := fmt.Sprintf("%s-client-%s", modelName, language)
+	archive, err := renderSDKScaffold(root, files, data, format)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to generate SDK",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	contentType := "application/zip"
+	if format == "tar.gz" {
+		contentType = "application/gzip"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, root, format))
+	c.Data(http.StatusOK, contentType, archive)
+}
+
+// newSDKScaffoldData collects what the templates need from a published model. The served model
+// name and sampling defaults are the ones the documentation examples use.
+func newSDKScaffoldData(model PublishedModel, language string, inputNames []string) sdkScaffoldData {
+	params := newOpenAIExampleParams(model.ModelName, model.OpenAI)
+	data := sdkScaffoldData{
+		Language:    language,
+		ModelName:   model.ModelName,
+		Namespace:   model.Namespace,
+		BaseURL:     strings.TrimSuffix(model.ExternalURL, "/"),
+		OpenAI:      model.ModelType == "openai",
+		InputNames:  inputNames,
+		ServedModel: params.model,
+		MaxTokens:   params.maxTokens,
+		MinTokens:   params.minTokens,
+		Temperature: strconv.FormatFloat(params.temperature, 'f', -1, 64),
+		APIKeyEnv:   sdkAPIKeyEnvVar,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if language == "python" {
+		data.PackageName = pythonPackageName(model.ModelName)
+	} else {
+		data.PackageName = model.ModelName + "-client"
+	}
+	return data
+}
+
+// pythonPackageName turns a model name into an importable package name, such as my_model_client
+func pythonPackageName(modelName string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, modelName) + "_client"
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "model_" + name
+	}
+	return name
+}
+
+// renderSDKScaffold renders the project files under root and packs them in the requested format
+func renderSDKScaffold(root string, files []sdkScaffoldFile, data sdkScaffoldData, archiveFormat string) ([]byte, error) {
+	type renderedFile struct {
+		path    string
+		content []byte
+	}
+	var rendered []renderedFile
+	for _, file := range files {
+		var content bytes.Buffer
+		if err := file.template.Execute(&content, data); err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", file.path, err)
+		}
+		source := content.Bytes()
+		// Go files are formatted after rendering, since alignment depends on the input names
+		if strings.HasSuffix(file.path, ".go") {
+			formatted, err := format.Source(source)
+			if err != nil {
+				return nil, fmt.Errorf("failed to format %s: %w", file.path, err)
+			}
+			source = formatted
+		}
+		path := strings.ReplaceAll(file.path, "{package}", data.PackageName)
+		rendered = append(rendered, renderedFile{path: root + "/" + path, content: source})
+	}
+
+	var out bytes.Buffer
+	modified := time.Now()
+	if archiveFormat == "tar.gz" {
+		gz := gzip.NewWriter(&out)
+		tw := tar.NewWriter(gz)
+		for _, file := range rendered {
+			header := &tar.Header{Name: file.path, Mode: 0644, Size: int64(len(file.content)), ModTime: modified}
+			if err := tw.WriteHeader(header); err != nil {
+				return nil, err
+			}
+			if _, err := tw.Write(file.content); err != nil {
+				return nil, err
+			}
+		}
+		if err := tw.Close(); err != nil {
+			return nil, err
+		}
+		if err := gz.Close(); err != nil {
+			return nil, err
+		}
+		return out.Bytes(), nil
+	}
+
+	zw := zip.NewWriter(&out)
+	for _, file := range rendered {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: file.path, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(file.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+const sdkReadmeTemplate = `# {{.ModelName}} client
+
+Client for the published model ` + "`{{.Namespace}}/{{.ModelName}}`" + `, generated by the inference-in-a-box management service on {{.GeneratedAt}}.
+
+Base URL: {{.BaseURL}}
+
+The API key is read from the ` + "`{{.APIKeyEnv}}`" + ` environment variable and is never stored in this project:
+
+` + "```" + `
+export {{.APIKeyEnv}}=<your-api-key>
+` + "```" + `
+{{if eq .Language "go"}}
+Run the example with ` + "`go run ./cmd/example`" + `. The client in ` + "`client/`" + ` only uses the standard library.
+{{else}}
+Install the package with ` + "`pip install .`" + ` and run ` + "`python example.py`" + `. The client only depends on ` + "`requests`" + `.
+{{end}}
+{{- if .OpenAI}}
+The model is OpenAI-compatible, so any OpenAI client also works with the base URL above.
+{{- end}}
+`
+
+const pythonPyprojectTemplate = `[build-system]
+requires = ["setuptools>=61"]
+build-backend = "setuptools.build_meta"
+
+[project]
+name = "{{.ModelName}}-client"
+version = "0.1.0"
+description = "Client for the published model {{.Namespace}}/{{.ModelName}}"
+requires-python = ">=3.8"
+dependencies = ["requests>=2.28"]
+
+[tool.setuptools]
+packages = ["{{.PackageName}}"]
+`
+
+const pythonInitTemplate = `from .client import BASE_URL, Client, ModelClientError
+
+__all__ = ["BASE_URL", "Client", "ModelClientError"]
+`
+
+const pythonClientTemplate = `"""Client for the published model {{.Namespace}}/{{.ModelName}}."""
+
+import os
+from typing import Any, Dict, List, Optional, TypedDict
+
+import requests
+
+BASE_URL = {{quote .BaseURL}}
+MODEL_NAME = {{quote .ModelName}}
+API_KEY_ENV = {{quote .APIKeyEnv}}
+
+
+class ModelClientError(Exception):
+    """Raised when the model endpoint answers with an error status."""
+
+    def __init__(self, status_code: int, body: str):
+        super().__init__(f"request failed with status {status_code}: {body}")
+        self.status_code = status_code
+        self.body = body
+
+{{if .OpenAI}}
+SERVED_MODEL = {{quote .ServedModel}}
+
+
+class ChatMessage(TypedDict):
+    role: str
+    content: str
+
+
+class ChatChoice(TypedDict):
+    index: int
+    message: ChatMessage
+    finish_reason: Optional[str]
+
+
+class ChatCompletionResponse(TypedDict):
+    id: str
+    model: str
+    choices: List[ChatChoice]
+    usage: Dict[str, int]
+
+
+class Embedding(TypedDict):
+    index: int
+    embedding: List[float]
+
+
+class EmbeddingResponse(TypedDict):
+    model: str
+    data: List[Embedding]
+    usage: Dict[str, int]
+{{else}}
+class PredictResponse(TypedDict):
+    predictions: List[Any]
+{{if .InputNames}}
+
+class V2Tensor(TypedDict):
+    name: str
+    shape: List[int]
+    datatype: str
+    data: List[Any]
+
+
+class V2InferResponse(TypedDict):
+    model_name: str
+    outputs: List[V2Tensor]
+{{end}}{{end}}
+
+class Client:
+    """Calls the model through the published gateway endpoint."""
+
+    def __init__(self, api_key: Optional[str] = None, base_url: str = BASE_URL, timeout: float = 30.0):
+        self.api_key = api_key or os.environ.get(API_KEY_ENV)
+        if not self.api_key:
+            raise ValueError(f"set the {API_KEY_ENV} environment variable or pass api_key")
+        self.base_url = base_url.rstrip("/")
+        self.timeout = timeout
+        self.session = requests.Session()
+        self.session.headers.update({"X-API-Key": self.api_key})
+
+    def _request(self, method: str, path: str, payload: Optional[Dict[str, Any]] = None) -> Any:
+        response = self.session.request(method, self.base_url + path, json=payload, timeout=self.timeout)
+        if response.status_code >= 400:
+            raise ModelClientError(response.status_code, response.text)
+        return response.json()
+{{if .OpenAI}}
+    def chat(
+        self,
+        messages: List[ChatMessage],
+        max_tokens: int = {{.MaxTokens}},
+        temperature: float = {{.Temperature}},
+        **extra: Any,
+    ) -> ChatCompletionResponse:
+        """Creates a chat completion."""
+        payload: Dict[str, Any] = {
+            "model": SERVED_MODEL,
+            "messages": messages,
+            "max_tokens": max_tokens,
+            "temperature": temperature,
+        }
+{{- if .MinTokens}}
+        payload["min_tokens"] = {{.MinTokens}}
+{{- end}}
+        payload.update(extra)
+        return self._request("POST", "/chat/completions", payload)
+
+    def embeddings(self, input: Any) -> EmbeddingResponse:
+        """Embeds a string or a list of strings."""
+        return self._request("POST", "/embeddings", {"model": SERVED_MODEL, "input": input})
+
+    def list_models(self) -> Dict[str, Any]:
+        """Lists the models served behind the endpoint."""
+        return self._request("GET", "/models")
+{{else}}
+    def predict(self, instances: List[Any]) -> PredictResponse:
+        """Sends a KServe v1 prediction request."""
+        return self._request("POST", f"/v1/models/{MODEL_NAME}:predict", {"instances": instances})
+{{if .InputNames}}
+    def predict_named(self, inputs: Dict[str, Any]) -> PredictResponse:
+        """Sends named inputs: {{range $i, $name := .InputNames}}{{if $i}}, {{end}}{{$name}}{{end}}."""
+        return self._request("POST", f"/v1/models/{MODEL_NAME}:predict", {"inputs": inputs})
+
+    def infer_v2(self, inputs: List[V2Tensor]) -> V2InferResponse:
+        """Sends a KServe v2 inference request with named tensors."""
+        return self._request("POST", f"/v2/models/{MODEL_NAME}/infer", {"inputs": inputs})
+{{end}}
+    def metadata(self) -> Dict[str, Any]:
+        """Returns the model metadata."""
+        return self._request("GET", f"/v1/models/{MODEL_NAME}")
+{{end}}`
+
+const pythonExampleTemplate = `from {{.PackageName}} import Client
+
+client = Client()
+{{if .OpenAI}}
+response = client.chat([{"role": "user", "content": "Hello, how are you?"}])
+print(response["choices"][0]["message"]["content"])
+
+embedding = client.embeddings("The quick brown fox jumps over the lazy dog")
+print(len(embedding["data"][0]["embedding"]), "dimensions")
+{{else if .InputNames}}
+print(client.predict_named({ {{- range $i, $name := .InputNames}}{{if $i}}, {{end}}{{quote $name}}: [[1.0, 2.0, 3.0, 4.0]]{{end -}} }))
+{{else}}
+print(client.predict([[1.0, 2.0, 3.0, 4.0]]))
+{{end}}`
+
+const goModTemplate = `module {{.PackageName}}
+
+go 1.21
+`
+
+const goClientTemplate = `// Package client calls the published model {{.Namespace}}/{{.ModelName}}.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// BaseURL is the published endpoint of the model
+	BaseURL = {{quote .BaseURL}}
+	// ModelName is the name of the model
+	ModelName = {{quote .ModelName}}
+	// APIKeyEnv is the environment variable NewClient reads the API key from
+	APIKeyEnv = {{quote .APIKeyEnv}}
+{{- if .OpenAI}}
+	// ServedModel is the model identifier sent in OpenAI requests
+	ServedModel = {{quote .ServedModel}}
+{{- end}}
+)
+
+// Client calls the model through the published gateway endpoint
+type Client struct {
+	BaseURL    string
+	APIKey     string
+	HTTPClient *http.Client
+}
+
+// NewClient creates a client that reads its API key from APIKeyEnv
+func NewClient() (*Client, error) {
+	apiKey := os.Getenv(APIKeyEnv)
+	if apiKey == "" {
+		return nil, fmt.Errorf("set the %s environment variable", APIKeyEnv)
+	}
+	return &Client{
+		BaseURL:    BaseURL,
+		APIKey:     apiKey,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// APIError is returned when the model endpoint answers with an error status
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, payload, result interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.BaseURL, "/")+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-API-Key", c.APIKey)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return &APIError{StatusCode: resp.StatusCode, Body: string(data)}
+	}
+	return json.Unmarshal(data, result)
+}
+{{if .OpenAI}}
+// ChatMessage is one message of a chat conversation
+type ChatMessage struct {
+	Role    string {{tag "role"}}
+	Content string {{tag "content"}}
+}
+
+// ChatCompletionRequest is an OpenAI chat completion request
+type ChatCompletionRequest struct {
+	Model       string        {{tag "model"}}
+	Messages    []ChatMessage {{tag "messages"}}
+	MaxTokens   int           {{tag "max_tokens,omitempty"}}
+	MinTokens   int           {{tag "min_tokens,omitempty"}}
+	Temperature float64       {{tag "temperature"}}
+}
+
+// ChatChoice is one generated message
+type ChatChoice struct {
+	Index        int         {{tag "index"}}
+	Message      ChatMessage {{tag "message"}}
+	FinishReason string      {{tag "finish_reason"}}
+}
+
+// ChatCompletionResponse is an OpenAI chat completion response
+type ChatCompletionResponse struct {
+	ID      string         {{tag "id"}}
+	Model   string         {{tag "model"}}
+	Choices []ChatChoice   {{tag "choices"}}
+	Usage   map[string]int {{tag "usage"}}
+}
+
+// Embedding is the embedding of one input
+type Embedding struct {
+	Index     int       {{tag "index"}}
+	Embedding []float64 {{tag "embedding"}}
+}
+
+// EmbeddingResponse is an OpenAI embeddings response
+type EmbeddingResponse struct {
+	Model string         {{tag "model"}}
+	Data  []Embedding    {{tag "data"}}
+	Usage map[string]int {{tag "usage"}}
+}
+
+// NewChatCompletionRequest returns a request with the model's default sampling parameters
+func NewChatCompletionRequest(messages ...ChatMessage) ChatCompletionRequest {
+	return ChatCompletionRequest{
+		Model:       ServedModel,
+		Messages:    messages,
+		MaxTokens:   {{.MaxTokens}},
+		MinTokens:   {{.MinTokens}},
+		Temperature: {{.Temperature}},
+	}
+}
+
+// Chat creates a chat completion
+func (c *Client) Chat(ctx context.Context, request ChatCompletionRequest) (*ChatCompletionResponse, error) {
+	var response ChatCompletionResponse
+	if err := c.do(ctx, http.MethodPost, "/chat/completions", request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Embeddings embeds the given inputs
+func (c *Client) Embeddings(ctx context.Context, input ...string) (*EmbeddingResponse, error) {
+	var response EmbeddingResponse
+	payload := map[string]interface{}{"model": ServedModel, "input": input}
+	if err := c.do(ctx, http.MethodPost, "/embeddings", payload, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+{{else}}
+// PredictRequest is a KServe v1 prediction request
+type PredictRequest struct {
+	Instances []interface{}          {{tag "instances,omitempty"}}
+	Inputs    map[string]interface{} {{tag "inputs,omitempty"}} // Named inputs
+}
+
+// PredictResponse is a KServe v1 prediction response
+type PredictResponse struct {
+	Predictions []interface{} {{tag "predictions"}}
+}
+
+// Predict sends a KServe v1 prediction request
+func (c *Client) Predict(ctx context.Context, request PredictRequest) (*PredictResponse, error) {
+	var response PredictResponse
+	if err := c.do(ctx, http.MethodPost, "/v1/models/"+ModelName+":predict", request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+{{if .InputNames}}
+// InputNames lists the named inputs of the model
+var InputNames = []string{ {{- range $i, $name := .InputNames}}{{if $i}}, {{end}}{{quote $name}}{{end -}} }
+
+// V2Tensor is a named tensor of a KServe v2 inference request or response
+type V2Tensor struct {
+	Name     string        {{tag "name"}}
+	Shape    []int         {{tag "shape"}}
+	Datatype string        {{tag "datatype"}}
+	Data     []interface{} {{tag "data"}}
+}
+
+// V2InferResponse is a KServe v2 inference response
+type V2InferResponse struct {
+	ModelName string     {{tag "model_name"}}
+	Outputs   []V2Tensor {{tag "outputs"}}
+}
+
+// InferV2 sends a KServe v2 inference request with named tensors
+func (c *Client) InferV2(ctx context.Context, inputs []V2Tensor) (*V2InferResponse, error) {
+	var response V2InferResponse
+	payload := map[string]interface{}{"inputs": inputs}
+	if err := c.do(ctx, http.MethodPost, "/v2/models/"+ModelName+"/infer", payload, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+{{end}}
+// Metadata returns the model metadata
+func (c *Client) Metadata(ctx context.Context) (map[string]interface{}, error) {
+	var response map[string]interface{}
+	if err := c.do(ctx, http.MethodGet, "/v1/models/"+ModelName, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+{{end}}`
+
+const goExampleTemplate = `package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"{{.PackageName}}/client"
+)
+
+func main() {
+	c, err := client.NewClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := context.Background()
+{{if .OpenAI}}
+	response, err := c.Chat(ctx, client.NewChatCompletionRequest(client.ChatMessage{Role: "user", Content: "Hello, how are you?"}))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(response.Choices) > 0 {
+		fmt.Println(response.Choices[0].Message.Content)
+	}
+{{else if .InputNames}}
+	response, err := c.Predict(ctx, client.PredictRequest{Inputs: map[string]interface{}{
+{{- range .InputNames}}
+		{{quote .}}: [][]float64{{"{{"}}1.0, 2.0, 3.0, 4.0{{"}}"}},
+{{- end}}
+	}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(response.Predictions)
+{{else}}
+	response, err := c.Predict(ctx, client.PredictRequest{Instances: []interface{}{
+		[]float64{1.0, 2.0, 3.0, 4.0},
+	}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(response.Predictions)
+{{end -}}
+}
+`
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"strings"
+)
+
+// Runtime flags that set the model identifier served on the OpenAI API: vLLM's
+// --served-model-name and the KServe HuggingFace server's --model_name
+var servedModelNameFlags = map[string]bool{
+	"--served-model-name": true,
+	"--model_name":        true,
+	"--model-name":        true,
+}
+
+// declaredServedModelNames returns the served model names passed to the predictor's runtime, in
+// the order given. It is empty when the args do not set one, in which case runtimes serve the
+// model under the InferenceService name.
+func declaredServedModelNames(inferenceService map[string]interface{}) []string {
+	spec, _ := inferenceService["spec"].(map[string]interface{})
+	predictor, _ := spec["predictor"].(map[string]interface{})
+	if predictor == nil {
+		return nil
+	}
+	return servedModelNamesFromArgs(runtimeInfoFromSpec(predictor).Args)
+}
+
+// servedModelNamesFromArgs reads "--flag=value" and "--flag value [value...]" forms. vLLM accepts
+// several names after --served-model-name, so values are collected up to the next flag.
+func servedModelNamesFromArgs(args []string) []string {
+	var names []string
+	for i := 0; i < len(args); i++ {
+		flag, value, hasValue := strings.Cut(args[i], "=")
+		if !servedModelNameFlags[flag] {
+			continue
+		}
+		if hasValue {
+			names = append(names, value)
+			continue
+		}
+		for i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+			i++
+			names = append(names, args[i])
+		}
+	}
+	return names
+}
+
+// withServedModelName fills in the served model name of an OpenAI model from its runtime args
+// when the publisher did not set one, so the route matches what clients send in "model"
+func (s *PublishingService) withServedModelName(namespace, modelName string, openai *OpenAIConfig) *OpenAIConfig {
+	if openai != nil && openai.ServedModelName != "" {
+		return openai
+	}
+
+	inferenceService, err := s.k8sClient.GetInferenceService(namespace, modelName)
+	if err != nil {
+		return openai
+	}
+	declared := declaredServedModelNames(inferenceService)
+	if len(declared) == 0 {
+		return openai
+	}
+
+	resolved := OpenAIConfig{}
+	if openai != nil {
+		resolved = *openai
+	}
+	resolved.ServedModelName = declared[0]
+	return &resolved
+}
@@ -2,13 +2,17 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 type Server struct {
@@ -19,9 +23,11 @@ type Server struct {
 	adminService      *AdminService
 	publishingService *PublishingService
 	testExecutionService *TestExecutionService
+	reconciler        *PublishingReconciler
+	logPruner         *LogPruner
 }
 
-func NewServer(config *Config, authService *AuthService, modelService *ModelService, adminService *AdminService, publishingService *PublishingService, testExecutionService *TestExecutionService) *Server {
+func NewServer(config *Config, authService *AuthService, modelService *ModelService, adminService *AdminService, publishingService *PublishingService, testExecutionService *TestExecutionService, reconciler *PublishingReconciler, logPruner *LogPruner) *Server {
 	// Set Gin mode based on environment
 	if config.NodeEnv == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -37,7 +43,7 @@ func NewServer(config *Config, authService *AuthService, modelService *ModelServ
 	switch logLevel {
 	case LogLevelDetailed, LogLevelDebug:
 		// Detailed logging with request/response bodies
-		router.Use(DetailedRequestResponseLogger())
+		router.Use(DetailedRequestResponseLogger(config))
 		router.Use(gin.Recovery())
 	default:
 		// Basic logging
@@ -59,6 +65,65 @@ func NewServer(config *Config, authService *AuthService, modelService *ModelServ
 		adminService:      adminService,
 		publishingService: publishingService,
 		testExecutionService: testExecutionService,
+		reconciler:        reconciler,
+		logPruner:         logPruner,
+	}
+}
+
+// NewHTTPServer returns the HTTP server for handler with the transport timeouts and limits of
+// config. Long-running routes extend their own deadlines, see longRunningRoute.
+func NewHTTPServer(config *Config, handler http.Handler) *http.Server {
+	serverTimeout := func(name, value string, fallback time.Duration) time.Duration {
+		timeout, err := parseDuration(value, time.Second, time.Hour)
+		if err != nil {
+			log.Printf("Invalid %s: %v, using %s", name, err, fallback)
+			return fallback
+		}
+		return timeout
+	}
+
+	idleTimeout := serverTimeout("SERVER_IDLE_TIMEOUT", config.ServerIdleTimeout, 120*time.Second)
+	if config.ServerH2C {
+		// Clients that speak HTTP/2 without TLS upgrade to it; everyone else keeps HTTP/1.1
+		handler = h2c.NewHandler(handler, &http2.Server{IdleTimeout: idleTimeout})
+	}
+
+	maxHeaderBytes := config.ServerMaxHeaderBytes
+	if maxHeaderBytes <= 0 {
+		maxHeaderBytes = http.DefaultMaxHeaderBytes
+	}
+
+	return &http.Server{
+		Addr:              ":" + config.Port,
+		Handler:           handler,
+		ReadHeaderTimeout: serverTimeout("SERVER_READ_HEADER_TIMEOUT", config.ServerReadHeaderTimeout, 10*time.Second),
+		ReadTimeout:       serverTimeout("SERVER_READ_TIMEOUT", config.ServerReadTimeout, 60*time.Second),
+		WriteTimeout:      serverTimeout("SERVER_WRITE_TIMEOUT", config.ServerWriteTimeout, 60*time.Second),
+		IdleTimeout:       idleTimeout,
+		MaxHeaderBytes:    maxHeaderBytes,
+	}
+}
+
+// longRunningRoute extends the connection's read and write deadlines to SERVER_LONG_WRITE_TIMEOUT
+// for routes that can outlast the server-wide timeouts, such as predictions waiting on a cold
+// start or log collection
+func longRunningRoute() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout, err := parseDuration(ActiveConfig().ServerLongWriteTimeout, time.Second, 2*time.Hour)
+		if err != nil {
+			log.Printf("Invalid SERVER_LONG_WRITE_TIMEOUT: %v, using 10m", err)
+			timeout = 10 * time.Minute
+		}
+
+		deadline := time.Now().Add(timeout)
+		controller := http.NewResponseController(c.Writer)
+		if err := controller.SetWriteDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			log.Printf("Failed to extend write deadline for %s: %v", c.Request.URL.Path, err)
+		}
+		if err := controller.SetReadDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			log.Printf("Failed to extend read deadline for %s: %v", c.Request.URL.Path, err)
+		}
+		c.Next()
 	}
 }
 
@@ -73,6 +138,7 @@ func (s *Server) SetupRoutes() {
 		api.POST("/admin/login", s.authService.AdminLogin)
 		api.GET("/tokens", s.authService.GetTokens)
 		api.GET("/frameworks", s.modelService.GetFrameworks)
+		api.GET("/model-tasks", s.modelService.GetModelTasks)
 		api.POST("/validate-api-key", s.publishingService.ValidateAPIKey)
 
 		// Protected endpoints
@@ -83,25 +149,47 @@ func (s *Server) SetupRoutes() {
 			protected.GET("/models", s.modelService.ListModels)
 			protected.GET("/models/:modelName", s.modelService.GetModel)
 			protected.POST("/models", s.modelService.CreateModel)
+			protected.POST("/models/import", s.modelService.ImportModel)
+			protected.POST("/models/validate", s.modelService.ValidateModel)
 			protected.PUT("/models/:modelName", s.modelService.UpdateModel)
+			protected.POST("/models/:modelName/diff", s.modelService.DiffModel)
 			protected.DELETE("/models/:modelName", s.modelService.DeleteModel)
-			protected.POST("/models/:modelName/predict", s.modelService.PredictModel)
-			protected.GET("/models/:modelName/logs", s.modelService.GetModelLogs)
+			protected.GET("/models/:modelName/versions", s.modelService.ListModelVersions)
+			protected.DELETE("/models/:modelName/versions/:id", s.modelService.DeleteModelVersion)
+			protected.POST("/models/:modelName/disable", s.modelService.DisableModel)
+			protected.POST("/models/:modelName/enable", s.modelService.EnableModel)
+			protected.POST("/models/:modelName/predict", longRunningRoute(), s.modelService.PredictModel)
+			protected.POST("/models/:modelName/explain", longRunningRoute(), s.modelService.ExplainModel)
+			protected.GET("/models/:modelName/logs", longRunningRoute(), s.modelService.GetModelLogs)
+			protected.GET("/models/:modelName/metrics", s.modelService.GetModelMetrics)
+			protected.GET("/serving-runtimes/:name/formats", s.modelService.GetServingRuntimeFormats)
+			protected.GET("/cluster-serving-runtimes/:name/formats", s.modelService.GetClusterServingRuntimeFormats)
 
 			// Model publishing
 			protected.POST("/models/:modelName/publish", s.publishingService.PublishModel)
 			protected.PUT("/models/:modelName/publish", s.publishingService.UpdatePublishedModel)
 			protected.DELETE("/models/:modelName/publish", s.publishingService.UnpublishModel)
 			protected.GET("/models/:modelName/publish", s.publishingService.GetPublishedModel)
+			protected.GET("/models/:modelName/publish/preview-docs", s.publishingService.PreviewPublishDocs)
+			protected.POST("/models/:modelName/publish/refresh-docs", s.publishingService.RefreshPublishedDocs)
+			protected.GET("/models/:modelName/publish/export", s.publishingService.ExportPublishedModel)
+			protected.GET("/models/:modelName/publish/sdk/:language", s.publishingService.GetClientSDK)
+			protected.GET("/models/:modelName/publish/resources", s.publishingService.GetPublishResources)
+			protected.POST("/models/:modelName/publish/import", s.publishingService.ImportPublishedModel)
 			protected.POST("/models/:modelName/publish/rotate-key", s.publishingService.RotateAPIKey)
+			protected.GET("/models/:modelName/publish/rate-limit-status", s.publishingService.GetRateLimitStatus)
+			protected.GET("/models/:modelName/publish/errors", s.publishingService.GetModelPublishErrors)
 			protected.GET("/published-models", s.publishingService.ListPublishedModels)
+			protected.GET("/published-models/lookup", s.publishingService.LookupPublishedModel)
 
 			// User info
 			protected.GET("/tenant", s.authService.GetTenantInfo)
+			protected.GET("/tenant/usage", s.publishingService.GetTenantUsage)
 
 			// Test execution endpoints for published models
-			protected.POST("/publish/test/execute", s.testExecutionService.ExecuteTest)
+			protected.POST("/publish/test/execute", longRunningRoute(), s.testExecutionService.ExecuteTest)
 			protected.GET("/publish/test/history", s.testExecutionService.GetTestHistory)
+			protected.GET("/publish/errors", s.publishingService.GetPublishErrors)
 			protected.POST("/publish/test/validate", s.testExecutionService.ValidateTestRequest)
 
 			// Admin-only endpoints
@@ -109,11 +197,30 @@ func (s *Server) SetupRoutes() {
 			admin.Use(s.authService.RequireAdmin())
 			{
 				admin.GET("/system", s.adminService.GetSystemInfo)
+				admin.GET("/summary", s.adminService.GetSummary)
 				admin.GET("/tenants", s.adminService.GetTenants)
 				admin.GET("/resources", s.adminService.GetResources)
-				admin.GET("/logs", s.adminService.GetLogs)
-				admin.POST("/kubectl", s.adminService.ExecuteKubectl)
+				admin.GET("/models", s.adminService.ListModels)
+				admin.GET("/models/duplicates", s.adminService.GetDuplicateModels)
+				admin.POST("/impersonate", s.authService.Impersonate)
+				admin.GET("/logs", longRunningRoute(), s.adminService.GetLogs)
+				admin.POST("/logs/prune", longRunningRoute(), s.logPruner.PruneLogs)
+				admin.POST("/serving-runtimes", s.adminService.CreateServingRuntime)
+				admin.PUT("/serving-runtimes/:name", s.adminService.UpdateServingRuntime)
+				admin.DELETE("/serving-runtimes/:name", s.adminService.DeleteServingRuntime)
+				admin.POST("/kubectl", longRunningRoute(), s.adminService.ExecuteKubectl)
 				admin.GET("/ai-gateway-service", s.adminService.GetAIGatewayService)
+				admin.GET("/reconciler", s.reconciler.GetStatus)
+				admin.GET("/proxy-load", s.modelService.GetProxyLoad)
+				admin.POST("/usage/compare", longRunningRoute(), s.adminService.CompareUsage)
+				admin.POST("/config/reload", s.adminService.ReloadConfig)
+				admin.GET("/published-models/orphaned", s.reconciler.GetOrphanedModels)
+				admin.DELETE("/publish/:modelName/force", s.publishingService.ForceUnpublishModel)
+				admin.GET("/gateway/hostnames", s.publishingService.GetGatewayHostnames)
+				admin.GET("/gateway/tls-status", s.publishingService.GetGatewayTLSStatus)
+				admin.GET("/gateway/connectivity-check", s.adminService.GetGatewayConnectivity)
+				admin.GET("/hostname-reservations", s.adminService.GetHostnameReservations)
+				admin.PUT("/hostname-reservations", s.adminService.UpdateHostnameReservations)
 			}
 		}
 	}
@@ -0,0 +1,450 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Container port of runtimes that don't set one, the KServe model server default
+const defaultServingRuntimePort = 8080
+
+// CreateServingRuntime handles POST /api/admin/serving-runtimes
+func (s *AdminService) CreateServingRuntime(c *gin.Context) {
+	var req ServingRuntimeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if !validServingRuntimeRequest(c, req) {
+		return
+	}
+
+	created, err := s.k8sClient.CreateServingRuntime(req.Namespace, buildServingRuntime(req, nil))
+	if err != nil {
+		status := HTTPStatusForK8sError(err)
+		message := "Failed to create serving runtime"
+		if IsConflict(err) {
+			message = "Serving runtime already exists"
+		}
+		c.JSON(status, ErrorResponse{
+			Error:   message,
+			Details: err.Error(),
+		})
+		return
+	}
+
+	log.Printf("Created serving runtime %s/%s with image %s", req.Namespace, req.Name, req.Image)
+	c.JSON(http.StatusCreated, ServingRuntimeResponse{
+		Message: "Serving runtime created successfully",
+		Runtime: toServingRuntimeInfo(created),
+	})
+}
+
+// UpdateServingRuntime handles PUT /api/admin/serving-runtimes/:name
+// The runtime's spec is replaced by the request; labels and annotations set elsewhere are kept.
+func (s *AdminService) UpdateServingRuntime(c *gin.Context) {
+	var req ServingRuntimeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	req.Name = c.Param("name")
+	if ns := c.Query("namespace"); ns != "" {
+		req.Namespace = ns
+	}
+
+	if !validServingRuntimeRequest(c, req) {
+		return
+	}
+
+	existing, err := s.k8sClient.GetServingRuntime(req.Namespace, req.Name)
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Serving runtime not found",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	updated, err := s.k8sClient.UpdateServingRuntime(req.Namespace, buildServingRuntime(req, existing))
+	if err != nil {
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   "Failed to update serving runtime",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	log.Printf("Updated serving runtime %s/%s with image %s", req.Namespace, req.Name, req.Image)
+	c.JSON(http.StatusOK, ServingRuntimeResponse{
+		Message: "Serving runtime updated successfully",
+		Runtime: toServingRuntimeInfo(updated),
+	})
+}
+
+// DeleteServingRuntime handles DELETE /api/admin/serving-runtimes/:name
+func (s *AdminService) DeleteServingRuntime(c *gin.Context) {
+	name := c.Param("name")
+	namespace := c.Query("namespace")
+	if namespace == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "namespace query parameter is required",
+		})
+		return
+	}
+
+	if err := s.k8sClient.DeleteServingRuntime(namespace, name); err != nil {
+		message := "Failed to delete serving runtime"
+		if IsNotFound(err) {
+			message = "Serving runtime not found"
+		}
+		c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+			Error:   message,
+			Details: err.Error(),
+		})
+		return
+	}
+
+	log.Printf("Deleted serving runtime %s/%s", namespace, name)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Serving runtime deleted successfully",
+	})
+}
+
+// validServingRuntimeRequest writes a 400 response and returns false when the request is invalid
+func validServingRuntimeRequest(c *gin.Context, req ServingRuntimeRequest) bool {
+	validationErrors := validateServingRuntimeRequest(req)
+	if len(validationErrors) == 0 {
+		return true
+	}
+
+	var errorMessages []string
+	for _, err := range validationErrors {
+		errorMessages = append(errorMessages, err.Error())
+	}
+	c.JSON(http.StatusBadRequest, ErrorResponse{
+		Error:   "Validation failed",
+		Details: strings.Join(errorMessages, "; "),
+	})
+	return false
+}
+
+// validateServingRuntimeRequest checks names, the image, ports, resources and that every model
+// format is a known framework
+func validateServingRuntimeRequest(req ServingRuntimeRequest) []ValidationError {
+	var errors []ValidationError
+
+	if errs := validation.IsDNS1123Label(req.Name); len(errs) > 0 {
+		errors = append(errors, ValidationError{Field: "name", Value: req.Name, Message: strings.Join(errs, "; ")})
+	}
+	if req.Namespace == "" {
+		errors = append(errors, ValidationError{Field: "namespace", Message: "Namespace is required"})
+	} else if errs := validation.IsDNS1123Label(req.Namespace); len(errs) > 0 {
+		errors = append(errors, ValidationError{Field: "namespace", Value: req.Namespace, Message: strings.Join(errs, "; ")})
+	}
+	if strings.TrimSpace(req.Image) == "" {
+		errors = append(errors, ValidationError{Field: "image", Message: "Container image is required"})
+	}
+	if req.Port != 0 && (req.Port < 1 || req.Port > 65535) {
+		errors = append(errors, ValidationError{Field: "port", Value: req.Port, Message: "Port must be between 1 and 65535"})
+	}
+
+	if len(req.ModelFormats) == 0 {
+		errors = append(errors, ValidationError{Field: "modelFormats", Message: "At least one model format is required"})
+	}
+	known := knownModelFormats()
+	for i, format := range req.ModelFormats {
+		if !known[format.Name] {
+			errors = append(errors, ValidationError{
+				Field:   fmt.Sprintf("modelFormats[%d].name", i),
+				Value:   format.Name,
+				Message: fmt.Sprintf("Unknown model format. Known: %s", strings.Join(sortedKeys(known), ", ")),
+			})
+		}
+	}
+
+	for _, protocol := range req.ProtocolVersions {
+		if protocol != "v1" && protocol != "v2" && protocol != "grpc-v2" {
+			errors = append(errors, ValidationError{Field: "protocolVersions", Value: protocol, Message: "Protocol version must be v1, v2 or grpc-v2"})
+		}
+	}
+
+	errors = append(errors, validateResourceQuantities("resources", req.Resources)...)
+	return errors
+}
+
+// knownModelFormats returns the supported frameworks together with the frameworks of the known
+// model tasks, such as huggingface
+func knownModelFormats() map[string]bool {
+	known := make(map[string]bool)
+	for _, framework := range ActiveConfig().SupportedFrameworks {
+		known[framework.Name] = true
+	}
+	for _, task := range knownModelTasks {
+		for _, framework := range task.frameworks {
+			known[framework] = true
+		}
+	}
+	return known
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// buildServingRuntime returns the ServingRuntime object for the request. When existing is set,
+// its metadata is kept so the result can be used as an update.
+func buildServingRuntime(req ServingRuntimeRequest, existing map[string]interface{}) map[string]interface{} {
+	formats := make([]interface{}, 0, len(req.ModelFormats))
+	for _, format := range req.ModelFormats {
+		entry := map[string]interface{}{
+			"name":       format.Name,
+			"autoSelect": true,
+		}
+		if format.Version != "" {
+			entry["version"] = format.Version
+		}
+		if format.AutoSelect != nil {
+			entry["autoSelect"] = *format.AutoSelect
+		}
+		if format.Priority != nil {
+			entry["priority"] = *format.Priority
+		}
+		formats = append(formats, entry)
+	}
+
+	port := req.Port
+	if port == 0 {
+		port = defaultServingRuntimePort
+	}
+	container := map[string]interface{}{
+		"name":  "kserve-container",
+		"image": req.Image,
+		"ports": []interface{}{
+			map[string]interface{}{"containerPort": port, "protocol": "TCP"},
+		},
+	}
+	if len(req.Command) > 0 {
+		container["command"] = stringsToInterfaces(req.Command)
+	}
+	if len(req.Args) > 0 {
+		container["args"] = stringsToInterfaces(req.Args)
+	}
+	if len(req.Env) > 0 {
+		names := make([]string, 0, len(req.Env))
+		for name := range req.Env {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		env := make([]interface{}, 0, len(names))
+		for _, name := range names {
+			env = append(env, map[string]interface{}{"name": name, "value": req.Env[name]})
+		}
+		container["env"] = env
+	}
+	if len(req.Resources) > 0 {
+		container["resources"] = req.Resources
+	}
+
+	spec := map[string]interface{}{
+		"supportedModelFormats": formats,
+		"containers":            []interface{}{container},
+		"disabled":              req.Disabled,
+	}
+	if len(req.ProtocolVersions) > 0 {
+		spec["protocolVersions"] = stringsToInterfaces(req.ProtocolVersions)
+	}
+
+	metadata := map[string]interface{}{
+		"name":      req.Name,
+		"namespace": req.Namespace,
+		"labels": map[string]interface{}{
+			"managed-by": "management-service",
+		},
+	}
+	if existing != nil {
+		if existingMetadata, ok := existing["metadata"].(map[string]interface{}); ok {
+			metadata = existingMetadata
+		}
+	}
+
+	return map[string]interface{}{
+		"apiVersion": "serving.kserve.io/v1alpha1",
+		"kind":       "ServingRuntime",
+		"metadata":   metadata,
+		"spec":       spec,
+	}
+}
+
+func stringsToInterfaces(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
+
+// servingRuntimeModelFormats reads the supportedModelFormats of a ServingRuntime or
+// ClusterServingRuntime spec
+func servingRuntimeModelFormats(spec map[string]interface{}) []ServingRuntimeModelFormat {
+	formats := []ServingRuntimeModelFormat{}
+	supportedModelFormats, _ := spec["supportedModelFormats"].([]interface{})
+	for _, item := range supportedModelFormats {
+		f, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		format := ServingRuntimeModelFormat{}
+		if format.Name, _ = f["name"].(string); format.Name == "" {
+			continue
+		}
+		format.Version, _ = f["version"].(string)
+		if autoSelect, ok := f["autoSelect"].(bool); ok {
+			format.AutoSelect = &autoSelect
+		}
+		// Objects from the API server carry int64 numbers, decoded JSON carries float64
+		switch priority := f["priority"].(type) {
+		case int64:
+			p := int(priority)
+			format.Priority = &p
+		case float64:
+			p := int(priority)
+			format.Priority = &p
+		}
+		formats = append(formats, format)
+	}
+	return formats
+}
+
+// modelFormatNames returns the names of model formats, in order
+func modelFormatNames(formats []ServingRuntimeModelFormat) []string {
+	var names []string
+	for _, format := range formats {
+		names = append(names, format.Name)
+	}
+	return names
+}
+
+// toServingRuntimeFormats converts a ServingRuntime or ClusterServingRuntime object to its model formats
+func toServingRuntimeFormats(kind string, runtime map[string]interface{}) ServingRuntimeFormatsResponse {
+	metadata, _ := runtime["metadata"].(map[string]interface{})
+	spec, _ := runtime["spec"].(map[string]interface{})
+
+	response := ServingRuntimeFormatsResponse{
+		Kind:         kind,
+		ModelFormats: servingRuntimeModelFormats(spec),
+	}
+	response.Name, _ = metadata["name"].(string)
+	response.Namespace, _ = metadata["namespace"].(string)
+	response.Disabled, _ = spec["disabled"].(bool)
+	if protocolVersions, ok := spec["protocolVersions"].([]interface{}); ok {
+		for _, version := range protocolVersions {
+			if v, ok := version.(string); ok {
+				response.ProtocolVersions = append(response.ProtocolVersions, v)
+			}
+		}
+	}
+	return response
+}
+
+// GetServingRuntimeFormats handles GET /api/serving-runtimes/:name/formats for a runtime in the
+// user's namespace. Admins can select another namespace with ?namespace=.
+func (s *ModelService) GetServingRuntimeFormats(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin && c.Query("namespace") != "" {
+		namespace = c.Query("namespace")
+	}
+
+	runtime, err := s.k8sClient.GetServingRuntime(namespace, c.Param("name"))
+	if err != nil {
+		if IsNotFound(err) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: "Serving runtime not found",
+			})
+		} else {
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+				Error:   "Failed to get serving runtime",
+				Details: err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toServingRuntimeFormats("ServingRuntime", runtime))
+}
+
+// GetClusterServingRuntimeFormats handles GET /api/cluster-serving-runtimes/:name/formats
+func (s *ModelService) GetClusterServingRuntimeFormats(c *gin.Context) {
+	runtime, err := s.k8sClient.GetClusterServingRuntime(c.Param("name"))
+	if err != nil {
+		if IsNotFound(err) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: "Cluster serving runtime not found",
+			})
+		} else {
+			c.JSON(HTTPStatusForK8sError(err), ErrorResponse{
+				Error:   "Failed to get cluster serving runtime",
+				Details: err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toServingRuntimeFormats("ClusterServingRuntime", runtime))
+}
+
+// toServingRuntimeInfo converts a ServingRuntime object to its admin summary
+func toServingRuntimeInfo(sr map[string]interface{}) ServingRuntimeInfo {
+	metadata, _ := sr["metadata"].(map[string]interface{})
+	spec, _ := sr["spec"].(map[string]interface{})
+
+	info := ServingRuntimeInfo{}
+	info.Name, _ = metadata["name"].(string)
+	info.Namespace, _ = metadata["namespace"].(string)
+	if creationTimestamp, ok := metadata["creationTimestamp"].(string); ok {
+		info.CreatedAt = parseTime(creationTimestamp)
+	}
+	info.Disabled, _ = spec["disabled"].(bool)
+	info.ModelFormat = modelFormatNames(servingRuntimeModelFormats(spec))
+	if containers, ok := spec["containers"].([]interface{}); ok && len(containers) > 0 {
+		if container, ok := containers[0].(map[string]interface{}); ok {
+			info.Image, _ = container["image"].(string)
+		}
+	}
+	return info
+}
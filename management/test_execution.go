@@ -2,12 +2,13 @@ package main
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net"
+	"log"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,13 +17,11 @@ import (
 
 type TestExecutionService struct {
 	publishingService *PublishingService
-	config            *Config
 }
 
-func NewTestExecutionService(publishingService *PublishingService, config *Config) *TestExecutionService {
+func NewTestExecutionService(publishingService *PublishingService) *TestExecutionService {
 	return &TestExecutionService{
 		publishingService: publishingService,
-		config:            config,
 	}
 }
 
@@ -61,6 +60,12 @@ func (s *TestExecutionService) ExecuteTest(c *gin.Context) {
 	// Calculate response time
 	testResult.ResponseTime = time.Since(startTime).Milliseconds()
 	testResult.Timestamp = time.Now()
+	testResult.ModelName = req.ModelName
+
+	// Persist a summary of the result so it shows up in the test history
+	if err := s.recordTestResult(u.Tenant, testResult); err != nil {
+		log.Printf("Failed to record test result for %s/%s: %v", u.Tenant, req.ModelName, err)
+	}
 
 	// Return the test result
 	c.JSON(http.StatusOK, testResult)
@@ -156,14 +161,17 @@ func (s *TestExecutionService) executeModelTest(req TestExecutionRequest, user *
 	}
 
 	// Create HTTP client with DNS resolution support
-	var client *http.Client
-	if req.ConnectionSettings != nil {
-		client = s.createHTTPClient(req.ConnectionSettings)
-	} else {
-		client = &http.Client{
-			Timeout: 30 * time.Second,
+	if err := checkInsecureSkipVerify(ActiveConfig(), user, req.ConnectionSettings); err != nil {
+		return TestExecutionResponse{
+			Success:    false,
+			Error:      err.Error(),
+			Request:    req.TestData,
+			Endpoint:   endpoint,
+			Status:     "Forbidden",
+			StatusCode: 403,
 		}
 	}
+	client := NewProxyHTTPClient(req.ConnectionSettings, proxyClientOptionsFor(ProxyClientOptions{FollowRedirects: true}, req.ConnectionSettings, user, httpReq.URL.Host))
 	
 	resp, err := client.Do(httpReq)
 	if err != nil {
@@ -235,66 +243,211 @@ func (s *TestExecutionService) executeModelTest(req TestExecutionRequest, user *
 	return result
 }
 
-// createHTTPClient creates an HTTP client with custom DNS resolution support
-func (s *TestExecutionService) createHTTPClient(settings *ConnectionSettings) *http.Client {
-	// Build DNS resolution map
-	dnsResolveMap := make(map[string]string)
-	for _, dnsResolve := range settings.DNSResolve {
-		if dnsResolve.Host != "" && dnsResolve.Port != "" && dnsResolve.Address != "" {
-			addressKey := dnsResolve.Host + ":" + dnsResolve.Port
-			dnsResolveMap[addressKey] = dnsResolve.Address + ":" + dnsResolve.Port
+// recordTestResult appends a test result summary to the tenant's daily test-history log.
+// Request and response bodies are not stored to keep the ConfigMaps small.
+func (s *TestExecutionService) recordTestResult(namespace string, result TestExecutionResponse) error {
+	entry := map[string]interface{}{
+		"timestamp":    result.Timestamp.Format(time.RFC3339),
+		"modelName":    result.ModelName,
+		"success":      result.Success,
+		"endpoint":     result.Endpoint,
+		"status":       result.Status,
+		"statusCode":   result.StatusCode,
+		"responseTime": result.ResponseTime,
+		"error":        result.Error,
+	}
+
+	historyName := fmt.Sprintf("test-history-%s", result.Timestamp.Format("2006-01-02"))
+	return appendLogEntry(s.publishingService.k8sClient, namespace, historyName, entry, newEntriesLog, nil)
+}
+
+// GetTestHistory handles GET /api/publish/test/history
+func (s *TestExecutionService) GetTestHistory(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	u, ok := user.(*User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Invalid user context",
+		})
+		return
+	}
+
+	namespace := u.Tenant
+	if u.IsAdmin && c.Query("namespace") != "" {
+		namespace = c.Query("namespace")
+	}
+
+	modelFilter := c.Query("model")
+	statusFilter := c.Query("status")
+	if statusFilter != "" && statusFilter != "success" && statusFilter != "failure" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid status filter, expected 'success' or 'failure'",
+		})
+		return
+	}
+
+	// Default to the last 7 days
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -7)
+	if start := c.Query("start"); start != "" {
+		t, err := parseHistoryTime(start, false)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid start time",
+				Details: err.Error(),
+			})
+			return
 		}
+		startTime = t
+	}
+	if end := c.Query("end"); end != "" {
+		t, err := parseHistoryTime(end, true)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid end time",
+				Details: err.Error(),
+			})
+			return
+		}
+		endTime = t
+	}
+	if endTime.Before(startTime) {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "End time must not be before start time",
+		})
+		return
+	}
+	if endTime.Sub(startTime) > 90*24*time.Hour {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Time range cannot exceed 90 days",
+		})
+		return
 	}
 
-	// Create custom dialer
-	dialer := &net.Dialer{
-		Timeout:   10 * time.Second,
-		KeepAlive: 30 * time.Second,
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 || limit > 500 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Limit must be between 1 and 500",
+		})
+		return
 	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Offset must be a non-negative integer",
+		})
+		return
+	}
+
+	tests := []TestExecutionResponse{}
+	for _, day := range logDays(startTime, endTime) {
+		historyName := fmt.Sprintf("test-history-%s", day)
+		history, err := readDailyLog(s.publishingService.k8sClient, namespace, historyName)
+		if err != nil {
+			continue // Skip days with no tests
+		}
+
+		entries, _ := history["entries"].([]interface{})
+		for _, entry := range entries {
+			entryMap, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
 
-	// Create custom transport with DNS override
-	transport := &http.Transport{
-		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
-			// Validate the format of addr (expected format: host:port)
-			if !strings.Contains(addr, ":") {
-				return nil, fmt.Errorf("invalid address format: %s, expected host:port", addr)
+			result := convertTestHistoryEntry(entryMap)
+			if result.Timestamp.Before(startTime) || result.Timestamp.After(endTime) {
+				continue
 			}
-			
-			// DNS Override Logic:
-			// This allows overriding DNS resolution for specific addresses.
-			// The dnsResolveMap contains mappings from original addresses (host:port)
-			// to target addresses (host:port). This is useful for:
-			// - Testing against local services
-			// - Bypassing DNS resolution issues
-			// - Routing to specific service instances
-			if dnsOverride, exists := dnsResolveMap[addr]; exists {
-				addr = dnsOverride
+			if modelFilter != "" && result.ModelName != modelFilter {
+				continue
 			}
-			
-			return dialer.DialContext(ctx, network, addr)
-		},
-		MaxIdleConns:          100,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
+			if statusFilter == "success" && !result.Success || statusFilter == "failure" && result.Success {
+				continue
+			}
+			tests = append(tests, result)
+		}
 	}
 
-	return &http.Client{
-		Transport: transport,
-		Timeout:   30 * time.Second,
+	// Newest first
+	sort.SliceStable(tests, func(i, j int) bool {
+		return tests[i].Timestamp.After(tests[j].Timestamp)
+	})
+
+	total := len(tests)
+	page := []TestExecutionResponse{}
+	if offset < total {
+		end := offset + limit
+		if end > total {
+			end = total
+		}
+		page = tests[offset:end]
 	}
-}
 
-// GetTestHistory handles GET /api/test/history
-func (s *TestExecutionService) GetTestHistory(c *gin.Context) {
-	// For now, return empty history since we're not persisting test results
-	// This could be extended to store test results in a database or cache
 	c.JSON(http.StatusOK, TestHistoryResponse{
-		Tests: []TestExecutionResponse{},
-		Total: 0,
+		Tests:  page,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
 	})
 }
 
+// convertTestHistoryEntry converts a stored test-history entry back to a test result
+func convertTestHistoryEntry(entry map[string]interface{}) TestExecutionResponse {
+	result := TestExecutionResponse{}
+
+	if v, ok := entry["timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			result.Timestamp = t
+		}
+	}
+	if v, ok := entry["modelName"].(string); ok {
+		result.ModelName = v
+	}
+	if v, ok := entry["success"].(bool); ok {
+		result.Success = v
+	}
+	if v, ok := entry["endpoint"].(string); ok {
+		result.Endpoint = v
+	}
+	if v, ok := entry["status"].(string); ok {
+		result.Status = v
+	}
+	if v, ok := entry["statusCode"].(float64); ok {
+		result.StatusCode = int(v)
+	}
+	if v, ok := entry["responseTime"].(float64); ok {
+		result.ResponseTime = int64(v)
+	}
+	if v, ok := entry["error"].(string); ok {
+		result.Error = v
+	}
+
+	return result
+}
+
+// parseHistoryTime accepts RFC3339 timestamps or YYYY-MM-DD dates. A bare end date covers the whole day.
+func parseHistoryTime(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected RFC3339 timestamp or YYYY-MM-DD date: %s", value)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return t, nil
+}
+
 // ValidateTestRequest handles POST /api/test/validate
 func (s *TestExecutionService) ValidateTestRequest(c *gin.Context) {
 	var req TestExecutionRequest
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -13,6 +14,7 @@ type User struct {
 	Audience string `json:"aud,omitempty"`
 	IsAdmin  bool   `json:"isAdmin"`
 	ExpiresAt int64  `json:"exp,omitempty"`
+	ImpersonatedBy string `json:"impersonatedBy,omitempty"` // Admin acting as the tenant with an impersonation token
 }
 
 // LoginRequest represents admin login request
@@ -27,6 +29,21 @@ type LoginResponse struct {
 	User  User   `json:"user"`
 }
 
+// ImpersonateRequest represents an admin request for a token scoped to a tenant
+type ImpersonateRequest struct {
+	Tenant string `json:"tenant" binding:"required"`
+	TTL    string `json:"ttl,omitempty"`    // Token lifetime, defaults to IMPERSONATION_TOKEN_TTL
+	Reason string `json:"reason,omitempty"` // Recorded in the audit log
+}
+
+// ImpersonateResponse carries the issued impersonation token
+type ImpersonateResponse struct {
+	Token     string `json:"token"`
+	TokenID   string `json:"tokenId"`
+	ExpiresAt string `json:"expiresAt"`
+	User      User   `json:"user"`
+}
+
 // ModelRequest represents model creation/update request
 type ModelRequest struct {
 	Name        string `json:"name" binding:"required"`
@@ -37,6 +54,36 @@ type ModelRequest struct {
 	ScaleTarget *int   `json:"scaleTarget,omitempty"`
 	ScaleMetric string `json:"scaleMetric,omitempty"`
 	Namespace   string `json:"namespace,omitempty"`
+	InitContainers []ContainerSpec `json:"initContainers,omitempty"`
+	Sidecars       []ContainerSpec `json:"sidecars,omitempty"`
+	ContainerConcurrency *int `json:"containerConcurrency,omitempty"`
+	TargetBurstCapacity  *int `json:"targetBurstCapacity,omitempty"`
+	DeploymentMode       string `json:"deploymentMode,omitempty"`
+	WarmupPayload        json.RawMessage `json:"warmupPayload,omitempty"` // Sent once as a prediction when the model first becomes ready
+	ReadinessProbe       *ProbeSpec      `json:"readinessProbe,omitempty"`
+	LivenessProbe        *ProbeSpec      `json:"livenessProbe,omitempty"`
+}
+
+// ProbeSpec is an HTTP health check on the predictor container
+type ProbeSpec struct {
+	Path                string `json:"path"`
+	Port                int    `json:"port,omitempty"` // Defaults to the KServe container port
+	InitialDelaySeconds int    `json:"initialDelaySeconds,omitempty"`
+}
+
+// ContainerSpec represents an init or sidecar container added to the predictor pod
+type ContainerSpec struct {
+	Name    string       `json:"name"`
+	Image   string       `json:"image"`
+	Command []string     `json:"command,omitempty"`
+	Args    []string     `json:"args,omitempty"`
+	Env     []ContainerEnv `json:"env,omitempty"`
+}
+
+// ContainerEnv represents a plain environment variable for a ContainerSpec
+type ContainerEnv struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 
 // ModelResponse represents model operation response
@@ -55,6 +102,56 @@ type ModelConfig struct {
 	MaxReplicas int    `json:"maxReplicas"`
 	ScaleTarget int    `json:"scaleTarget"`
 	ScaleMetric string `json:"scaleMetric"`
+	InitContainers []ContainerSpec `json:"initContainers,omitempty"`
+	Sidecars       []ContainerSpec `json:"sidecars,omitempty"`
+	ContainerConcurrency *int `json:"containerConcurrency,omitempty"` // Max in-flight requests per replica, 0 for unlimited
+	TargetBurstCapacity  *int `json:"targetBurstCapacity,omitempty"`  // Knative activator burst capacity, 0 disables buffering
+	DeploymentMode       string `json:"deploymentMode,omitempty"`     // serverless or raw, empty for the cluster default
+	ReadinessProbe       *ProbeSpec `json:"readinessProbe,omitempty"`
+	LivenessProbe        *ProbeSpec `json:"livenessProbe,omitempty"`
+}
+
+// ModelVersion is a stored snapshot of the configuration a model was created or updated with
+type ModelVersion struct {
+	ID        int         `json:"id"`
+	CreatedAt time.Time   `json:"createdAt"`
+	CreatedBy string      `json:"createdBy,omitempty"`
+	Active    bool        `json:"active"`
+	Config    ModelConfig `json:"config"`
+}
+
+// ModelVersionsResponse lists the stored versions of a model, oldest first
+type ModelVersionsResponse struct {
+	ModelName   string         `json:"modelName"`
+	Namespace   string         `json:"namespace"`
+	MaxVersions int            `json:"maxVersions"`
+	Versions    []ModelVersion `json:"versions"`
+}
+
+// ModelValidationResponse reports the result of a dry-run model validation
+type ModelValidationResponse struct {
+	Valid     bool              `json:"valid"`
+	Source    string            `json:"source"` // "request" or "manifest"
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Framework string            `json:"framework,omitempty"`
+	Errors    []ValidationError `json:"errors"`
+	Warnings  []ValidationError `json:"warnings"`
+}
+
+// ModelDiffResponse lists the spec changes an update would apply to a model
+type ModelDiffResponse struct {
+	ModelName  string        `json:"modelName"`
+	Namespace  string        `json:"namespace"`
+	HasChanges bool          `json:"hasChanges"`
+	Changes    []FieldChange `json:"changes"`
+}
+
+// FieldChange describes a single managed field whose value differs between two specs
+type FieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
 }
 
 // ModelCondition represents a model condition
@@ -75,9 +172,10 @@ type ModelComponent struct {
 
 // ModelReplicas represents replica information
 type ModelReplicas struct {
-	Desired int `json:"desired"`
-	Ready   int `json:"ready"`
-	Total   int `json:"total"`
+	Desired int    `json:"desired"`
+	Ready   int    `json:"ready"`
+	Total   int    `json:"total"`
+	Scaling string `json:"scaling,omitempty"` // up, down, stable or idle (scaled to zero)
 }
 
 // ModelStatusDetails represents detailed model status
@@ -94,22 +192,122 @@ type ModelStatusDetails struct {
 	Address                interface{}                `json:"address,omitempty"`
 	LatestCreatedRevision  string                     `json:"latestCreatedRevision,omitempty"`
 	LatestReadyRevision    string                     `json:"latestReadyRevision,omitempty"`
+	StorageInitializer     []StorageInitializerStatus `json:"storageInitializer,omitempty"`
 	Error                  string                     `json:"error,omitempty"`
 }
 
+// StorageInitializerStatus reports the storage-initializer init container of one predictor pod,
+// which downloads the model before the model server starts
+type StorageInitializerStatus struct {
+	Pod        string     `json:"pod"`
+	State      string     `json:"state"` // waiting, running, completed or failed
+	Reason     string     `json:"reason,omitempty"`
+	Message    string     `json:"message,omitempty"`
+	ExitCode   int32      `json:"exitCode,omitempty"`
+	Restarts   int32      `json:"restarts"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
 // ModelInfo represents model information
 type ModelInfo struct {
 	Name          string                 `json:"name"`
 	Namespace     string                 `json:"namespace"`
 	Status        string                 `json:"status"`
 	Ready         bool                   `json:"ready"`
+	Disabled      bool                   `json:"disabled"`
 	URL           string                 `json:"url,omitempty"`
+	Framework     string                 `json:"framework,omitempty"` // Detected from the predictor, "custom" for container-only predictors
+	CreatedBy     string                 `json:"createdBy,omitempty"` // From the created-by label, empty for models created outside the API
+	ModelType     string                 `json:"modelType"`           // "openai" or "traditional", detected the same way as when publishing
+	ModelTypeReason string               `json:"modelTypeReason"`     // Detection rule that decided the model type
+	SupportsOpenAI bool                  `json:"supportsOpenAI"`      // Whether the model can be published as an OpenAI model
 	Predictor     interface{}            `json:"predictor"`
 	CreatedAt     time.Time              `json:"createdAt"`
 	StatusDetails ModelStatusDetails     `json:"statusDetails"`
 	Spec          interface{}            `json:"spec,omitempty"`
 	FullStatus    interface{}            `json:"fullStatus,omitempty"`
 	Metadata      map[string]interface{} `json:"metadata"`
+	Warmup        *ModelWarmupStatus     `json:"warmup,omitempty"`
+	Runtime       *RuntimeInfo           `json:"runtime,omitempty"`
+	ProxyLoad     *ProxyLoad             `json:"proxyLoad,omitempty"` // Prediction proxy load on this replica, set by Get Model
+}
+
+// ProxyLoadStats counts the prediction proxy requests of a tenant or model on this replica since
+// it started
+type ProxyLoadStats struct {
+	InFlight    int64   `json:"inFlight"`
+	Queued      int64   `json:"queued"`      // Requests waiting for a slot now
+	Limit       int     `json:"limit"`       // In-flight limit, 0 when unlimited
+	Utilization float64 `json:"utilization"` // inFlight / limit, 0 when unlimited
+	Admitted    int64   `json:"admitted"`
+	QueuedTotal int64   `json:"queuedTotal"` // Requests that waited for a slot, whether admitted or rejected
+	Rejected    int64   `json:"rejected"`    // Requests turned away with 429 at this level's limit
+}
+
+// ProxyLoad is the prediction proxy load of a model and of its tenant
+type ProxyLoad struct {
+	Model  ProxyLoadStats `json:"model"`
+	Tenant ProxyLoadStats `json:"tenant"`
+}
+
+// TenantProxyLoad is the prediction proxy load of one tenant
+type TenantProxyLoad struct {
+	Namespace string `json:"namespace"`
+	ProxyLoadStats
+}
+
+// ModelProxyLoad is the prediction proxy load of one model
+type ModelProxyLoad struct {
+	Namespace string `json:"namespace"`
+	ModelName string `json:"modelName"`
+	ProxyLoadStats
+}
+
+// ProxyLoadResponse lists the prediction proxy load of every tenant and model on this replica
+type ProxyLoadResponse struct {
+	Tenants []TenantProxyLoad `json:"tenants"`
+	Models  []ModelProxyLoad  `json:"models"`
+}
+
+// RuntimeInfo describes what a model actually runs, normalized from its predictor pod or spec
+type RuntimeInfo struct {
+	Source             string           `json:"source"` // "pod" when read from a predictor pod, "spec" when no pod exists
+	Runtime            string           `json:"runtime,omitempty"` // ServingRuntime named by the predictor
+	Container          string           `json:"container,omitempty"`
+	Image              string           `json:"image,omitempty"`
+	Args               []string         `json:"args,omitempty"`
+	Env                []RuntimeEnvVar  `json:"env,omitempty"`
+	Resources          RuntimeResources `json:"resources"`
+	ServiceAccountName string           `json:"serviceAccountName,omitempty"`
+}
+
+// RuntimeEnvVar is an environment variable of a model container. Values taken from Secrets,
+// ConfigMaps or fields are shown as a reference instead of the value.
+type RuntimeEnvVar struct {
+	Name      string `json:"name"`
+	Value     string `json:"value,omitempty"`
+	ValueFrom string `json:"valueFrom,omitempty"` // e.g. "secretKeyRef:hf-token/token"
+}
+
+// RuntimeResources lists a container's resource requests and limits, with the CPU, memory and
+// GPU amounts summarized from the limits, or the requests when no limit is set
+type RuntimeResources struct {
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
+	CPU      string            `json:"cpu,omitempty"`
+	Memory   string            `json:"memory,omitempty"`
+	GPU      string            `json:"gpu,omitempty"`
+}
+
+// ModelWarmupStatus reports the warm-up prediction sent after a model was created
+type ModelWarmupStatus struct {
+	State       string    `json:"state"` // pending, completed or failed
+	RequestedAt time.Time `json:"requestedAt"`
+	CompletedAt time.Time `json:"completedAt,omitempty"`
+	LatencyMs   int64     `json:"latencyMs,omitempty"`
+	StatusCode  int       `json:"statusCode,omitempty"`
+	Error       string    `json:"error,omitempty"`
 }
 
 // ModelListResponse represents model list response
@@ -119,8 +317,26 @@ type ModelListResponse struct {
 
 // PredictRequest represents prediction request
 type PredictRequest struct {
-	InputData          interface{}         `json:"inputData" binding:"required"`
+	InputData          json.RawMessage     `json:"inputData" binding:"required"` // Forwarded to the model unchanged
 	ConnectionSettings *ConnectionSettings `json:"connectionSettings,omitempty"`
+	DeadlineMs         int                 `json:"deadlineMs,omitempty"` // Abort the upstream call with 504 after this many milliseconds
+}
+
+// TimedPredictionResponse wraps a prediction result with its latency breakdown, returned
+// with ?includeTiming=true
+type TimedPredictionResponse struct {
+	Prediction interface{}      `json:"prediction"`
+	Timing     PredictionTiming `json:"timing"`
+}
+
+// PredictionTiming breaks down the latency of a proxied prediction in milliseconds
+type PredictionTiming struct {
+	TotalMs         int64  `json:"totalMs"`                   // From receiving the request to sending the response
+	UpstreamMs      int64  `json:"upstreamMs"`                // Upstream round trip, including cold-start and throttle retries
+	ModelMs         *int64 `json:"modelMs,omitempty"`         // Model processing time, when reported by upstream headers
+	ModelTimeSource string `json:"modelTimeSource,omitempty"` // Header modelMs was read from
+	ColdStartMs     int64  `json:"coldStartMs,omitempty"`
+	Cached          bool   `json:"cached,omitempty"` // Served from the prediction cache without an upstream call
 }
 
 // ConnectionSettings represents custom connection settings
@@ -133,6 +349,8 @@ type ConnectionSettings struct {
 	Headers    []HeaderSetting `json:"headers,omitempty"`
 	Namespace  string          `json:"namespace,omitempty"`
 	DNSResolve []DNSResolve    `json:"dnsResolve,omitempty"`
+	APIKey     string          `json:"apiKey,omitempty"` // Sent as X-API-Key to exercise published-model key auth
+	InsecureSkipVerify bool    `json:"insecureSkipVerify,omitempty"` // Accept self-signed certificates, admins only unless ALLOW_INSECURE_SKIP_VERIFY is set
 }
 
 // HeaderSetting represents a header key-value pair
@@ -143,14 +361,16 @@ type HeaderSetting struct {
 
 // DNSResolve represents a DNS resolution override (like curl --resolve)
 type DNSResolve struct {
-	Host    string `json:"host"`
-	Port    string `json:"port"`
-	Address string `json:"address"`
+	Host      string   `json:"host"`
+	Port      string   `json:"port"`
+	Address   string   `json:"address"`
+	Addresses []string `json:"addresses,omitempty"` // Further addresses, tried in order when the previous ones refuse the connection
 }
 
 // LogsResponse represents logs response
 type LogsResponse struct {
-	Logs []string `json:"logs"`
+	Logs                   []string `json:"logs"`
+	StorageInitializerLogs []string `json:"storageInitializerLogs,omitempty"` // Model download progress
 }
 
 // TenantResponse represents tenant information response
@@ -160,6 +380,7 @@ type TenantResponse struct {
 	Issuer    string `json:"issuer,omitempty"`
 	Audience  string `json:"audience,omitempty"`
 	ExpiresAt string `json:"expiresAt,omitempty"`
+	ImpersonatedBy string `json:"impersonatedBy,omitempty"`
 }
 
 // FrameworksResponse represents frameworks response
@@ -167,6 +388,28 @@ type FrameworksResponse struct {
 	Frameworks []Framework `json:"frameworks"`
 }
 
+// ModelTask describes a model task and how models serving it are published
+type ModelTask struct {
+	Name                     string                   `json:"name"`
+	Description              string                   `json:"description"`
+	Aliases                  []string                 `json:"aliases,omitempty"`
+	Frameworks               []string                 `json:"frameworks"`
+	ModelType                string                   `json:"modelType"` // openai or traditional
+	RecommendedPublishConfig RecommendedPublishConfig `json:"recommendedPublishConfig"`
+}
+
+// RecommendedPublishConfig is the suggested starting point for publishing a model of a task
+type RecommendedPublishConfig struct {
+	ModelType    string          `json:"modelType"`
+	RateLimiting RateLimitConfig `json:"rateLimiting"`
+	Endpoint     string          `json:"endpoint"` // Inference path callers use once published
+}
+
+// ModelTasksResponse lists the known model tasks
+type ModelTasksResponse struct {
+	Tasks []ModelTask `json:"tasks"`
+}
+
 // HealthResponse represents health check response
 type HealthResponse struct {
 	Status    string `json:"status"`
@@ -337,18 +580,156 @@ type InferenceServiceInfo struct {
 	Ready     bool      `json:"ready"`
 	URL       string    `json:"url"`
 	Framework string    `json:"framework"`
+	CreatedBy string    `json:"createdBy,omitempty"`
 	CreatedAt time.Time `json:"created"`
 }
 
+// AdminModelsResponse represents a filtered page of InferenceServices across namespaces
+type AdminModelsResponse struct {
+	Models []InferenceServiceInfo `json:"models"`
+	Total  int                    `json:"total"`
+	Limit  int                    `json:"limit"`
+	Offset int                    `json:"offset"`
+}
+
+// DuplicateModelsResponse lists model names that exist in more than one namespace
+type DuplicateModelsResponse struct {
+	Duplicates []DuplicateModelName `json:"duplicates"`
+	Total      int                  `json:"total"`
+	CheckedAt  time.Time            `json:"checkedAt"`
+}
+
+// DuplicateModelName is a model name and the namespaces it exists in
+type DuplicateModelName struct {
+	ModelName      string                    `json:"modelName"`
+	Namespaces     []DuplicateModelNamespace `json:"namespaces"`
+	PublishedCount int                       `json:"publishedCount"` // Namespaces where the name is published
+}
+
+// DuplicateModelNamespace tells what exists under a duplicated model name in one namespace
+type DuplicateModelNamespace struct {
+	Namespace        string `json:"namespace"`
+	InferenceService bool   `json:"inferenceService"`
+	Published        bool   `json:"published"`
+}
+
+// AdminSummaryResponse aggregates the counts shown on the admin overview
+type AdminSummaryResponse struct {
+	Models          ReadyCount           `json:"models"`
+	PublishedModels int                  `json:"publishedModels"`
+	APIKeys         int                  `json:"apiKeys"`
+	Tenants         []TenantModelSummary `json:"tenants"`
+	Nodes           ReadyCount           `json:"nodes"`
+	Pods            PodCounts            `json:"pods"`
+	GeneratedAt     time.Time            `json:"generatedAt"`
+}
+
+// ReadyCount counts resources and how many of them are ready
+type ReadyCount struct {
+	Total int `json:"total"`
+	Ready int `json:"ready"`
+}
+
+// PodCounts counts pods and how many of them are unhealthy
+type PodCounts struct {
+	Total     int `json:"total"`
+	Unhealthy int `json:"unhealthy"`
+}
+
+// TenantModelSummary counts the models of one namespace
+type TenantModelSummary struct {
+	Namespace       string `json:"namespace"`
+	Models          int    `json:"models"`
+	Ready           int    `json:"ready"`
+	PublishedModels int    `json:"publishedModels"`
+}
+
 // ServingRuntimeInfo represents KServe ServingRuntime information
 type ServingRuntimeInfo struct {
 	Name      string    `json:"name"`
 	Namespace string    `json:"namespace"`
 	Disabled  bool      `json:"disabled"`
 	ModelFormat []string `json:"modelFormat"`
+	Image     string    `json:"image,omitempty"`
 	CreatedAt time.Time `json:"created"`
 }
 
+// PublishedResourceRef names a resource created when publishing a model
+type PublishedResourceRef struct {
+	Kind       string `json:"kind"`
+	APIVersion string `json:"apiVersion"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	Exists     bool   `json:"exists"`
+	Error      string `json:"error,omitempty"` // Set when existence could not be checked
+}
+
+// PublishResourcesResponse lists the resources of a published, or not yet published, model
+type PublishResourcesResponse struct {
+	ModelName string                 `json:"modelName"`
+	Namespace string                 `json:"namespace"`
+	ModelType string                 `json:"modelType"`
+	Published bool                   `json:"published"`
+	Resources []PublishedResourceRef `json:"resources"`
+}
+
+// HostnameReservation reserves a public hostname, or with a "*." prefix a domain and all of its
+// subdomains, for one tenant's published models
+type HostnameReservation struct {
+	Tenant   string `json:"tenant"`
+	Hostname string `json:"hostname"`
+}
+
+// HostnameReservationsRequest replaces all hostname reservations
+type HostnameReservationsRequest struct {
+	Reservations []HostnameReservation `json:"reservations"`
+}
+
+// HostnameReservationsResponse lists the hostname reservations
+type HostnameReservationsResponse struct {
+	Reservations []HostnameReservation `json:"reservations"`
+	UpdatedAt    string                `json:"updatedAt,omitempty"`
+}
+
+// ServingRuntimeModelFormat is a model format supported by a serving runtime
+type ServingRuntimeModelFormat struct {
+	Name       string `json:"name"`
+	Version    string `json:"version,omitempty"`
+	AutoSelect *bool  `json:"autoSelect,omitempty"` // Defaults to true
+	Priority   *int   `json:"priority,omitempty"`
+}
+
+// ServingRuntimeFormatsResponse lists the model formats a serving runtime supports
+type ServingRuntimeFormatsResponse struct {
+	Name             string                      `json:"name"`
+	Namespace        string                      `json:"namespace,omitempty"` // Empty for ClusterServingRuntimes
+	Kind             string                      `json:"kind"`                // "ServingRuntime" or "ClusterServingRuntime"
+	Disabled         bool                        `json:"disabled"`
+	ModelFormats     []ServingRuntimeModelFormat `json:"modelFormats"`
+	ProtocolVersions []string                    `json:"protocolVersions,omitempty"`
+}
+
+// ServingRuntimeRequest represents a request to create or update a KServe ServingRuntime
+type ServingRuntimeRequest struct {
+	Name             string                      `json:"name"`
+	Namespace        string                      `json:"namespace"`
+	ModelFormats     []ServingRuntimeModelFormat `json:"modelFormats"`
+	Image            string                      `json:"image"`
+	Command          []string                    `json:"command,omitempty"`
+	Args             []string                    `json:"args,omitempty"`
+	Env              map[string]string           `json:"env,omitempty"`
+	Port             int                         `json:"port,omitempty"` // Defaults to 8080
+	Resources        map[string]interface{}      `json:"resources,omitempty"`
+	ProtocolVersions []string                    `json:"protocolVersions,omitempty"`
+	Disabled         bool                        `json:"disabled"`
+}
+
+// ServingRuntimeResponse represents the result of creating or updating a ServingRuntime
+type ServingRuntimeResponse struct {
+	Message string             `json:"message"`
+	Runtime ServingRuntimeInfo `json:"runtime"`
+}
+
 // ClusterServingRuntimeInfo represents KServe ClusterServingRuntime information
 type ClusterServingRuntimeInfo struct {
 	Name      string    `json:"name"`
@@ -380,6 +761,64 @@ type PublishConfig struct {
 	RateLimiting    RateLimitConfig   `json:"rateLimiting"`
 	Authentication  AuthConfig        `json:"authentication"`
 	Metadata        map[string]string `json:"metadata"`
+	CacheTTL        string            `json:"cacheTTL,omitempty"` // Cache prediction responses for this duration (e.g. "5m"), traditional models only
+	PathMappings    []PathMapping     `json:"pathMappings,omitempty"` // Additional paths exposed on the route, traditional models only
+	Mirror          *MirrorConfig     `json:"mirror,omitempty"`       // Shadow model receiving a copy of predict traffic, traditional models only
+	PredictMethods  []string          `json:"predictMethods,omitempty"` // Methods accepted on the predict path, POST when empty, traditional models only
+	CORS            *CORSConfig       `json:"cors,omitempty"`           // Cross-origin access for browser consumers, traditional models only
+	OpenAI          *OpenAIConfig     `json:"openai,omitempty"`         // Served model name and example defaults, openai models only
+	RequestTransform *RequestTransformConfig `json:"requestTransform,omitempty"` // Reshapes inputs on the management predict path
+	ErrorRateThreshold float64 `json:"errorRateThreshold,omitempty"` // Error rate (0-1] above which the reconciler marks the model degraded, 0 disables
+}
+
+// RequestTransformConfig reshapes prediction inputs before the management proxy forwards them,
+// so clients can send one format to models with different input contracts. Exactly one of
+// Remap and Template is set.
+type RequestTransformConfig struct {
+	Remap    []FieldRemap `json:"remap,omitempty"`    // Fields moved to another path, applied in order
+	Template string       `json:"template,omitempty"` // Go text/template rendering the forwarded JSON body from the input
+}
+
+// FieldRemap moves the value at one dot-separated field path to another
+type FieldRemap struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// OpenAIConfig describes how clients address and call an OpenAI-compatible model. The served
+// model name is what clients send in the request's "model" field; the sampling defaults are used
+// in the generated examples.
+type OpenAIConfig struct {
+	ServedModelName string   `json:"servedModelName,omitempty"` // Defaults to the InferenceService name
+	Temperature     *float64 `json:"temperature,omitempty"`
+	MaxTokens       int      `json:"maxTokens,omitempty"`
+	MinTokens       int      `json:"minTokens,omitempty"`
+}
+
+// CORSConfig lets browsers call a published model from other origins. Preflight OPTIONS requests
+// are answered at the gateway without an API key; every other request still requires one.
+type CORSConfig struct {
+	AllowOrigins     []string `json:"allowOrigins"`
+	AllowMethods     []string `json:"allowMethods,omitempty"`  // Defaults to the predict methods
+	AllowHeaders     []string `json:"allowHeaders,omitempty"`  // Content-Type, X-API-Key and Authorization are always allowed
+	ExposeHeaders    []string `json:"exposeHeaders,omitempty"`
+	MaxAge           string   `json:"maxAge,omitempty"` // How long browsers may cache a preflight response, e.g. "10m"
+	AllowCredentials bool     `json:"allowCredentials,omitempty"`
+}
+
+// MirrorConfig copies a percentage of a published model's predict requests to a shadow model
+// in the same namespace. Mirrored responses are discarded by the gateway.
+type MirrorConfig struct {
+	ModelName string `json:"modelName"`
+	Percent   int    `json:"percent"`
+}
+
+// PathMapping exposes an additional model path on a published route. ExternalPath is relative
+// to the model's external path and InternalPath is the path on the model server.
+type PathMapping struct {
+	ExternalPath string   `json:"externalPath"`
+	InternalPath string   `json:"internalPath"`
+	Methods      []string `json:"methods,omitempty"` // Empty allows all methods
 }
 
 // RateLimitConfig represents rate limiting configuration
@@ -388,6 +827,8 @@ type RateLimitConfig struct {
 	RequestsPerHour   int `json:"requestsPerHour"`
 	TokensPerHour     int `json:"tokensPerHour"` // For OpenAI models
 	BurstLimit        int `json:"burstLimit"`
+	MaxRequestBytes          int `json:"maxRequestBytes"`          // Largest accepted request body, 0 for no limit
+	MaxConcurrentConnections int `json:"maxConcurrentConnections"` // Upstream connections allowed to the model, 0 for no limit
 }
 
 // AuthConfig represents authentication configuration
@@ -404,13 +845,36 @@ type PublishedModel struct {
 	ModelType       string            `json:"modelType"`
 	ExternalURL     string            `json:"externalUrl"`
 	PublicHostname  string            `json:"publicHostname"`
-	APIKey          string            `json:"apiKey"`
+	APIKey          string            `json:"apiKey"`                    // Read from the key Secret, never stored in metadata
+	APIKeyID        string            `json:"apiKeyId,omitempty"`
+	APIKeyMasked    string            `json:"apiKeyMasked,omitempty"`
 	RateLimiting    RateLimitConfig   `json:"rateLimiting"`
 	Status          string            `json:"status"`
 	CreatedAt       time.Time         `json:"createdAt"`
 	UpdatedAt       time.Time         `json:"updatedAt"`
 	Usage           UsageStats        `json:"usage"`
 	Documentation   APIDocumentation  `json:"documentation"`
+	MissingResources []string         `json:"missingResources,omitempty"` // Set by the reconciler when status is degraded
+	OrphanedAt      *time.Time        `json:"orphanedAt,omitempty"`       // Set by the reconciler when the InferenceService is gone
+	CacheTTL        string            `json:"cacheTTL,omitempty"`
+	PathMappings    []PathMapping     `json:"pathMappings,omitempty"`
+	Mirror          *MirrorConfig     `json:"mirror,omitempty"`
+	PredictMethods  []string          `json:"predictMethods,omitempty"`
+	CORS            *CORSConfig       `json:"cors,omitempty"`
+	OpenAI          *OpenAIConfig     `json:"openai,omitempty"`
+	RequestTransform *RequestTransformConfig `json:"requestTransform,omitempty"`
+	ErrorRateThreshold float64        `json:"errorRateThreshold,omitempty"`
+	ErrorRateExceeded bool            `json:"errorRateExceeded,omitempty"` // Set by the reconciler when today's error rate is above the threshold
+	ErrorRate       *ModelErrorRate   `json:"errorRate,omitempty"`         // Resolved when returned, not stored
+	Config          *PublishConfig    `json:"config,omitempty"` // Configuration as last submitted, used to pre-populate edit forms
+	InternalTarget  *InternalTarget   `json:"internalTarget,omitempty"` // Resolved when returned, not stored
+}
+
+// InternalTarget is the KServe predictor address the gateway rewrites published requests to
+type InternalTarget struct {
+	Hostname string `json:"hostname"`
+	Path     string `json:"path,omitempty"` // Predict path of traditional models, empty for OpenAI models whose path is forwarded unchanged
+	Source   string `json:"source"`         // "status" when read from the InferenceService URL, "fallback" when built from the model name
 }
 
 // APIKeyMetadata represents API key metadata
@@ -432,9 +896,97 @@ type UsageStats struct {
 	TotalRequests   int64     `json:"totalRequests"`
 	RequestsToday   int64     `json:"requestsToday"`
 	TokensUsed      int64     `json:"tokensUsed"` // For OpenAI models
+	ErrorCount      int64     `json:"errorCount"`
+	ErrorRate       float64   `json:"errorRate"` // errorCount / totalRequests, 0 without requests
 	LastAccessTime  time.Time `json:"lastAccessTime"`
 }
 
+// ModelErrorRate is a published model's error rate today compared with its threshold
+type ModelErrorRate struct {
+	Window        string  `json:"window"` // "today", since local midnight like the daily usage logs
+	TotalRequests int64   `json:"totalRequests"`
+	ErrorCount    int64   `json:"errorCount"`
+	ErrorRate     float64 `json:"errorRate"`
+	Threshold     float64 `json:"threshold,omitempty"`
+	Exceeded      bool    `json:"exceeded"` // Only true once the day has enough requests to judge
+}
+
+// ModelUsage is one model's share of a tenant usage rollup
+type ModelUsage struct {
+	ModelName string            `json:"modelName"`
+	Usage     UsageStats        `json:"usage"`
+	Daily     []DailyUsageStats `json:"daily,omitempty"` // Set with granularity=daily
+}
+
+// TenantUsageResponse aggregates usage across all of a tenant's published models
+type TenantUsageResponse struct {
+	Namespace   string            `json:"namespace"`
+	Days        int               `json:"days"`
+	Granularity string            `json:"granularity"`
+	Total       UsageStats        `json:"total"`
+	Daily       []DailyUsageStats `json:"daily,omitempty"` // Per-day totals across the models, set with granularity=daily
+	Models      []ModelUsage      `json:"models"`
+	CheckedAt   time.Time         `json:"checkedAt"`
+}
+
+// UsageCompareTarget names one model of a usage comparison
+type UsageCompareTarget struct {
+	ModelName string `json:"modelName" binding:"required"`
+	Namespace string `json:"namespace" binding:"required"`
+}
+
+// UsageCompareRequest selects the models and YYYY-MM-DD date range of a usage comparison
+type UsageCompareRequest struct {
+	Models    []UsageCompareTarget `json:"models" binding:"required,min=1,dive"`
+	StartDate string               `json:"startDate,omitempty"` // Defaults to 6 days before endDate
+	EndDate   string               `json:"endDate,omitempty"`   // Defaults to today
+}
+
+// ModelUsageComparison is one model's usage over the compared range
+type ModelUsageComparison struct {
+	ModelName       string  `json:"modelName"`
+	Namespace       string  `json:"namespace"`
+	TotalRequests   int64   `json:"totalRequests"`
+	TotalTokens     int64   `json:"totalTokens"`
+	TotalErrors     int64   `json:"totalErrors"`
+	ErrorRate       float64 `json:"errorRate"`
+	RequestsPerDay  float64 `json:"requestsPerDay"` // Over every day of the range, including days without usage
+	TokensPerDay    float64 `json:"tokensPerDay"`
+	ActiveDays      int     `json:"activeDays"`      // Days of the range with at least one request
+	AvgResponseTime float64 `json:"avgResponseTime"` // Weighted by each day's requests
+	ColdStartCount  int64   `json:"coldStartCount"`
+	AvgColdStartMs  float64 `json:"avgColdStartMs"`
+	RequestShare    float64 `json:"requestShare"` // Fraction of the compared models' requests
+	TokenShare      float64 `json:"tokenShare"`
+	RequestRank     int     `json:"requestRank"` // 1 for the most requests, equal values share a rank
+	TokenRank       int     `json:"tokenRank"`
+}
+
+// UsageCompareTotals sums the compared models
+type UsageCompareTotals struct {
+	TotalRequests int64   `json:"totalRequests"`
+	TotalTokens   int64   `json:"totalTokens"`
+	TotalErrors   int64   `json:"totalErrors"`
+	ErrorRate     float64 `json:"errorRate"`
+}
+
+// UsageCompareRanking lists the compared models as namespace/model, highest first
+type UsageCompareRanking struct {
+	ByRequests []string `json:"byRequests"`
+	ByTokens   []string `json:"byTokens"`
+}
+
+// UsageCompareResponse compares the usage of several models over the same date range
+type UsageCompareResponse struct {
+	StartDate string                 `json:"startDate"`
+	EndDate   string                 `json:"endDate"`
+	Days      int                    `json:"days"`
+	Total     UsageCompareTotals     `json:"total"`
+	Models    []ModelUsageComparison `json:"models"` // In request order
+	Ranking   UsageCompareRanking    `json:"ranking"`
+	CheckedAt time.Time              `json:"checkedAt"`
+}
+
 // APIDocumentation represents API documentation
 type APIDocumentation struct {
 	EndpointURL     string            `json:"endpointUrl"`
@@ -461,11 +1013,144 @@ type PublishModelRequest struct {
 type PublishModelResponse struct {
 	Message       string        `json:"message"`
 	PublishedModel PublishedModel `json:"publishedModel"`
+	DNSInstructions *DNSInstructions `json:"dnsInstructions,omitempty"` // Set when a custom hostname is used
+	ModelTypeReason string           `json:"modelTypeReason,omitempty"` // Why the model type was chosen when publishing
+	Warnings        []string         `json:"warnings,omitempty"`        // Caveats that did not fail the operation
+}
+
+// PublishedModelBundle is a portable export of a published model, used for backups and for
+// promoting a published config between environments
+type PublishedModelBundle struct {
+	Version        int                      `json:"version"`
+	ExportedAt     time.Time                `json:"exportedAt"`
+	PublishedModel PublishedModel           `json:"publishedModel"` // API key replaced with a placeholder
+	Resources      []map[string]interface{} `json:"resources"`      // Gateway resources as they exist in the source cluster
+}
+
+// PublishDocsPreviewResponse contains the documentation a model would get if it were published
+type PublishDocsPreviewResponse struct {
+	ModelName       string           `json:"modelName"`
+	Namespace       string           `json:"namespace"`
+	ModelType       string           `json:"modelType"`
+	ModelTypeReason string           `json:"modelTypeReason"`
+	ExternalURL     string           `json:"externalUrl"`
+	Documentation   APIDocumentation `json:"documentation"`
+}
+
+// DNSInstructions describes the DNS record needed to reach a published model's hostname
+type DNSInstructions struct {
+	Hostname   string `json:"hostname"`
+	RecordType string `json:"recordType,omitempty"` // "A" for an IP target, "CNAME" for a hostname target
+	Target     string `json:"target,omitempty"`
+	Gateway    string `json:"gateway,omitempty"`
+	Note       string `json:"note,omitempty"`
+}
+
+// ForceUnpublishResponse reports the resources removed by an admin force-unpublish
+type ForceUnpublishResponse struct {
+	Message   string            `json:"message"`
+	ModelName string            `json:"modelName"`
+	Removed   []RemovedResource `json:"removed"`
+	Errors    []string          `json:"errors,omitempty"`
+}
+
+type RemovedResource struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// GatewayHostnamesResponse reports the hostnames served by the shared inference gateway
+type GatewayHostnamesResponse struct {
+	Gateway         string            `json:"gateway"`
+	Hostnames       []GatewayHostname `json:"hostnames"`
+	UnmatchedModels []string          `json:"unmatchedModels"` // Published models whose hostname no listener serves
+}
+
+// GatewayHostname groups the listeners serving one hostname and the published models routed through them
+type GatewayHostname struct {
+	Hostname        string            `json:"hostname"` // "*" for listeners without a hostname
+	Listeners       []GatewayListener `json:"listeners"`
+	PublishedModels []string          `json:"publishedModels"`
+}
+
+// GatewayTLSStatusResponse reports the certificates served by the gateway's HTTPS listeners
+type GatewayTLSStatusResponse struct {
+	Gateway   string             `json:"gateway"`
+	WarnDays  int                `json:"warnDays"`
+	Expiring  int                `json:"expiring"` // Secrets whose worst certificate expires within warnDays
+	Expired   int                `json:"expired"`
+	Errors    int                `json:"errors"` // Secrets that could not be read or parsed
+	Secrets   []GatewayTLSSecret `json:"secrets"`
+	CheckedAt time.Time          `json:"checkedAt"`
+}
+
+// GatewayTLSSecret is a certificate Secret referenced by gateway listeners
+type GatewayTLSSecret struct {
+	Namespace          string               `json:"namespace"`
+	Name               string               `json:"name"`
+	Listeners          []string             `json:"listeners"`
+	Hostnames          []string             `json:"hostnames"` // Listener hostnames served with this Secret
+	Status             string               `json:"status"`    // valid, expiring, not-yet-valid, expired or error
+	Error              string               `json:"error,omitempty"`
+	Certificates       []TLSCertificateInfo `json:"certificates"` // The served certificate first, then its chain
+	UncoveredHostnames []string             `json:"uncoveredHostnames,omitempty"`
+}
+
+// TLSCertificateInfo summarizes one certificate of a TLS Secret
+type TLSCertificateInfo struct {
+	Subject       string    `json:"subject"`
+	Issuer        string    `json:"issuer"`
+	DNSNames      []string  `json:"dnsNames"` // Subject alternative names, including IP addresses
+	SerialNumber  string    `json:"serialNumber"`
+	NotBefore     time.Time `json:"notBefore"`
+	NotAfter      time.Time `json:"notAfter"`
+	DaysRemaining int       `json:"daysRemaining"` // Negative once expired
+	Status        string    `json:"status"`
+}
+
+// GatewayConnectivityResponse reports whether the management service can reach the gateway service
+type GatewayConnectivityResponse struct {
+	Gateway   string                    `json:"gateway"` // "istio-ingressgateway" or "envoy-gateway"
+	Service   string                    `json:"service"`
+	Namespace string                    `json:"namespace"`
+	ClusterIP string                    `json:"clusterIP"`
+	Reachable bool                      `json:"reachable"` // The service name resolved and every port accepted a connection
+	DNS       ConnectivityCheck         `json:"dns"`
+	Ports     []GatewayPortConnectivity `json:"ports"`
+	Hostname  *ConnectivityCheck        `json:"hostname,omitempty"` // Set when a hostname was given to check
+	CheckedAt time.Time                 `json:"checkedAt"`
+}
+
+// GatewayPortConnectivity is the result of connecting to one port of the gateway service
+type GatewayPortConnectivity struct {
+	Name string             `json:"name,omitempty"`
+	Port int32              `json:"port"`
+	TCP  ConnectivityCheck  `json:"tcp"`
+	HTTP *ConnectivityCheck `json:"http,omitempty"` // Only for plain HTTP ports
+}
+
+// ConnectivityCheck is the outcome of a single DNS lookup, TCP connect or HTTP request
+type ConnectivityCheck struct {
+	Target     string   `json:"target"`
+	Success    bool     `json:"success"`
+	LatencyMs  int64    `json:"latencyMs"`
+	Addresses  []string `json:"addresses,omitempty"`  // Resolved addresses of a DNS lookup
+	StatusCode int      `json:"statusCode,omitempty"` // Response status of an HTTP request, any status counts as reachable
+	Error      string   `json:"error,omitempty"`
+}
+
+type GatewayListener struct {
+	Name     string `json:"name"`
+	Protocol string `json:"protocol"`
+	Port     int64  `json:"port"`
 }
 
 type ListPublishedModelsResponse struct {
 	PublishedModels []PublishedModel `json:"publishedModels"`
 	Total           int              `json:"total"`
+	Limit           int              `json:"limit"`
+	Offset          int              `json:"offset"`
 }
 
 type RotateAPIKeyResponse struct {
@@ -474,6 +1159,94 @@ type RotateAPIKeyResponse struct {
 	UpdatedAt  time.Time     `json:"updatedAt"`
 }
 
+// ReconcileModelResult represents the reconciliation outcome for a single published model
+type ReconcileModelResult struct {
+	ModelName        string   `json:"modelName"`
+	Namespace        string   `json:"namespace"`
+	Status           string   `json:"status"`
+	MissingResources []string   `json:"missingResources,omitempty"`
+	Recreated        []string   `json:"recreated,omitempty"`
+	OrphanedAt       *time.Time `json:"orphanedAt,omitempty"`
+	ErrorRateExceeded bool      `json:"errorRateExceeded,omitempty"`
+	Error            string     `json:"error,omitempty"`
+}
+
+// ReconcileRunResult represents the results of a single reconciler pass
+type ReconcileRunResult struct {
+	StartedAt   time.Time              `json:"startedAt"`
+	CompletedAt time.Time              `json:"completedAt"`
+	Checked     int                    `json:"checked"`
+	Degraded    int                    `json:"degraded"`
+	Orphaned    int                    `json:"orphaned"`
+	Unpublished int                    `json:"unpublished"` // Orphaned models removed after the grace period
+	Recreate    bool                   `json:"recreate"`
+	Models      []ReconcileModelResult `json:"models"`
+	Error       string                 `json:"error,omitempty"`
+}
+
+// LogPruneResult lists the daily log ConfigMaps deleted by a prune run
+type LogPruneResult struct {
+	OlderThanDays int         `json:"olderThanDays"`
+	Cutoff        time.Time   `json:"cutoff"` // Logs of days before this are pruned
+	DryRun        bool        `json:"dryRun"`
+	Pruned        []PrunedLog `json:"pruned"`
+	Failed        []PrunedLog `json:"failed"`
+}
+
+// PrunedLog is one part of a daily usage, audit or error log
+type PrunedLog struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Kind      string `json:"kind"` // "usage", "audit" or "errors"
+	Date      string `json:"date"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ConfigReloadResponse reports which settings a configuration reload changed
+type ConfigReloadResponse struct {
+	Message         string    `json:"message"`
+	Changed         []string  `json:"changed"`
+	RestartRequired []string  `json:"restartRequired"` // Changed startup-only settings, still at their old values
+	ReloadedAt      time.Time `json:"reloadedAt"`
+}
+
+// OrphanedPublishedModel represents a published model whose InferenceService no longer exists
+type OrphanedPublishedModel struct {
+	ModelName       string     `json:"modelName"`
+	Namespace       string     `json:"namespace"`
+	ExternalURL     string     `json:"externalUrl"`
+	OrphanedAt      time.Time  `json:"orphanedAt"`
+	AutoUnpublishAt *time.Time `json:"autoUnpublishAt,omitempty"`
+}
+
+// OrphanedPublishedModelsResponse represents the orphaned published models awaiting attention
+type OrphanedPublishedModelsResponse struct {
+	Models      []OrphanedPublishedModel `json:"models"`
+	Total       int                      `json:"total"`
+	GracePeriod string                   `json:"gracePeriod,omitempty"` // Empty when auto-unpublish is disabled
+}
+
+// RateLimitWindowStatus represents request counts within a single rate-limit window
+type RateLimitWindowStatus struct {
+	Limit       int       `json:"limit"`
+	Current     int64     `json:"current"`
+	Remaining   int64     `json:"remaining"`
+	WindowStart time.Time `json:"windowStart"`
+	ResetAt     time.Time `json:"resetAt"`
+	Throttled   bool      `json:"throttled"`
+}
+
+// RateLimitStatusResponse represents the effective rate-limit counters for a published model
+type RateLimitStatusResponse struct {
+	ModelName         string                `json:"modelName"`
+	Namespace         string                `json:"namespace"`
+	Source            string                `json:"source"` // Where the counters were read from
+	RequestsPerMinute RateLimitWindowStatus `json:"requestsPerMinute"`
+	RequestsPerHour   RateLimitWindowStatus `json:"requestsPerHour"`
+	Throttled         bool                  `json:"throttled"`
+	CheckedAt         time.Time             `json:"checkedAt"`
+}
+
 // Test execution types for DeveloperConsole
 type TestExecutionRequest struct {
 	ModelName         string             `json:"modelName" binding:"required"`
@@ -486,6 +1259,7 @@ type TestExecutionRequest struct {
 }
 
 type TestExecutionResponse struct {
+	ModelName    string                 `json:"modelName,omitempty"`
 	Success      bool                   `json:"success"`
 	Data         interface{}            `json:"data,omitempty"`
 	Error        string                 `json:"error,omitempty"`
@@ -498,7 +1272,31 @@ type TestExecutionResponse struct {
 	Timestamp    time.Time              `json:"timestamp"`
 }
 
+// PublishingErrorEntry is a publishing failure recorded by the ErrorReporter
+type PublishingErrorEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	ModelName string    `json:"modelName"`
+	Namespace string    `json:"namespace"`
+	User      string    `json:"user,omitempty"`
+	Operation string    `json:"operation"`
+	Code      string    `json:"code,omitempty"`
+	Step      string    `json:"step,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	Details   string    `json:"details,omitempty"`
+	Error     string    `json:"error"`
+}
+
+// PublishingErrorsResponse is a page of publishing errors, newest first
+type PublishingErrorsResponse struct {
+	Errors []PublishingErrorEntry `json:"errors"`
+	Total  int                    `json:"total"`
+	Limit  int                    `json:"limit"`
+	Offset int                    `json:"offset"`
+}
+
 type TestHistoryResponse struct {
-	Tests []TestExecutionResponse `json:"tests"`
-	Total int                     `json:"total"`
+	Tests  []TestExecutionResponse `json:"tests"`
+	Total  int                     `json:"total"`
+	Limit  int                     `json:"limit"`
+	Offset int                     `json:"offset"`
 }
\ No newline at end of file
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Most models one usage comparison can include
+const maxUsageCompareModels = 20
+
+// CompareUsage handles POST /api/admin/usage/compare
+// It reads the detailed usage report of each model over the same date range and ranks the
+// models by requests and tokens.
+func (s *AdminService) CompareUsage(c *gin.Context) {
+	var req UsageCompareRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if len(req.Models) > maxUsageCompareModels {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: fmt.Sprintf("At most %d models can be compared", maxUsageCompareModels),
+		})
+		return
+	}
+	seen := make(map[string]bool, len(req.Models))
+	for _, target := range req.Models {
+		for _, name := range []string{target.Namespace, target.ModelName} {
+			if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+				c.JSON(http.StatusBadRequest, ErrorResponse{
+					Error:   "Invalid model or namespace name",
+					Details: fmt.Sprintf("%q: %s", name, strings.Join(errs, "; ")),
+				})
+				return
+			}
+		}
+		key := target.Namespace + "/" + target.ModelName
+		if seen[key] {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Duplicate model",
+				Details: fmt.Sprintf("%s is listed more than once", key),
+			})
+			return
+		}
+		seen[key] = true
+	}
+
+	startDate, endDate, err := usageCompareRange(req.StartDate, req.EndDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid date range",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	comparison, err := NewUsageTracker(s.k8sClient).CompareUsage(req.Models, startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to compare usage",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, comparison)
+}
+
+// usageCompareRange parses the YYYY-MM-DD bounds of a comparison as local dates, like the daily
+// usage logs. The range defaults to the last 7 days and can cover at most 90.
+func usageCompareRange(start, end string) (time.Time, time.Time, error) {
+	now := time.Now()
+	endDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if end != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", end, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("endDate must be a YYYY-MM-DD date: %s", end)
+		}
+		endDate = parsed
+	}
+
+	startDate := endDate.AddDate(0, 0, 1-defaultTenantUsageDays)
+	if start != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", start, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("startDate must be a YYYY-MM-DD date: %s", start)
+		}
+		startDate = parsed
+	}
+
+	if startDate.After(endDate) {
+		return time.Time{}, time.Time{}, fmt.Errorf("startDate cannot be after endDate")
+	}
+	if usageCompareDays(startDate, endDate) > maxTenantUsageDays {
+		return time.Time{}, time.Time{}, fmt.Errorf("the range cannot cover more than %d days", maxTenantUsageDays)
+	}
+	return startDate, endDate, nil
+}
+
+// usageCompareDays counts the days of a range, both ends included. Rounding absorbs DST changes.
+func usageCompareDays(startDate, endDate time.Time) int {
+	return int(endDate.Sub(startDate).Hours()/24+0.5) + 1
+}
+
+// CompareUsage builds a comparison of the given models from their detailed usage reports. Models
+// stay in the order they were given, and each is ranked by requests and by tokens.
+func (t *UsageTracker) CompareUsage(targets []UsageCompareTarget, startDate, endDate time.Time) (*UsageCompareResponse, error) {
+	days := usageCompareDays(startDate, endDate)
+	models := make([]ModelUsageComparison, len(targets))
+	errs := make([]error, len(targets))
+
+	// Each model costs one ConfigMap read per day, so fan the models out like a tenant rollup
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, tenantUsageWorkers)
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target UsageCompareTarget) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			report, err := t.GetDetailedUsageReport(target.Namespace, target.ModelName, startDate, endDate)
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to get usage for %s/%s: %w", target.Namespace, target.ModelName, err)
+				return
+			}
+			models[i] = usageComparisonFromReport(report, days)
+		}(i, target)
+	}
+	wg.Wait()
+
+	comparison := &UsageCompareResponse{
+		StartDate: startDate.Format("2006-01-02"),
+		EndDate:   endDate.Format("2006-01-02"),
+		Days:      days,
+		Models:    models,
+		CheckedAt: time.Now(),
+	}
+	for i, model := range models {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		comparison.Total.TotalRequests += model.TotalRequests
+		comparison.Total.TotalTokens += model.TotalTokens
+		comparison.Total.TotalErrors += model.TotalErrors
+	}
+	comparison.Total.ErrorRate = usageErrorRate(comparison.Total.TotalErrors, comparison.Total.TotalRequests)
+
+	for i := range models {
+		if comparison.Total.TotalRequests > 0 {
+			models[i].RequestShare = float64(models[i].TotalRequests) / float64(comparison.Total.TotalRequests)
+		}
+		if comparison.Total.TotalTokens > 0 {
+			models[i].TokenShare = float64(models[i].TotalTokens) / float64(comparison.Total.TotalTokens)
+		}
+	}
+	comparison.Ranking.ByRequests = rankUsageComparison(models, func(m ModelUsageComparison) int64 { return m.TotalRequests }, func(m *ModelUsageComparison, rank int) { m.RequestRank = rank })
+	comparison.Ranking.ByTokens = rankUsageComparison(models, func(m ModelUsageComparison) int64 { return m.TotalTokens }, func(m *ModelUsageComparison, rank int) { m.TokenRank = rank })
+
+	return comparison, nil
+}
+
+// usageComparisonFromReport normalizes a detailed usage report over the days of the range, so
+// models with missing days compare fairly with models that were used every day
+func usageComparisonFromReport(report *DetailedUsageReport, days int) ModelUsageComparison {
+	comparison := ModelUsageComparison{
+		ModelName:      report.ModelName,
+		Namespace:      report.Namespace,
+		TotalRequests:  report.TotalRequests,
+		TotalTokens:    report.TotalTokens,
+		TotalErrors:    report.TotalErrors,
+		ErrorRate:      usageErrorRate(report.TotalErrors, report.TotalRequests),
+		RequestsPerDay: float64(report.TotalRequests) / float64(days),
+		TokensPerDay:   float64(report.TotalTokens) / float64(days),
+		ColdStartCount: report.ColdStartCount,
+		AvgColdStartMs: report.AvgColdStartMs,
+	}
+
+	var total DailyUsageStats
+	for _, day := range report.DailyStats {
+		if day.TotalRequests > 0 {
+			comparison.ActiveDays++
+		}
+		addDailyUsage(&total, day)
+	}
+	comparison.AvgResponseTime = total.AvgResponseTime
+	return comparison
+}
+
+// rankUsageComparison sets each model's rank by value, highest first, and returns the
+// namespace/model keys in rank order. Models with equal values share a rank.
+func rankUsageComparison(models []ModelUsageComparison, value func(ModelUsageComparison) int64, setRank func(*ModelUsageComparison, int)) []string {
+	order := make([]int, len(models))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return value(models[order[a]]) > value(models[order[b]])
+	})
+
+	keys := make([]string, len(order))
+	rank := 0
+	for position, i := range order {
+		if position == 0 || value(models[i]) != value(models[order[position-1]]) {
+			rank = position + 1
+		}
+		setRank(&models[i], rank)
+		keys[position] = models[i].Namespace + "/" + models[i].ModelName
+	}
+	return keys
+}
@@ -1,11 +1,17 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os/exec"
+	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // ExecuteCommand executes a shell command and returns the output
@@ -33,6 +39,34 @@ func ToYAML(data map[string]interface{}) (string, error) {
 	return string(yamlBytes), nil
 }
 
+// ParseManifest parses a YAML or JSON Kubernetes manifest into a map
+func ParseManifest(data []byte) (map[string]interface{}, error) {
+	var manifest map[string]interface{}
+	if err := yamlv3.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("manifest is empty")
+	}
+	return manifest, nil
+}
+
+// StripServerFields removes status and server-populated metadata from a manifest so it can be re-applied
+func StripServerFields(manifest map[string]interface{}) {
+	delete(manifest, "status")
+
+	metadata, ok := manifest["metadata"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp", "selfLink", "ownerReferences"} {
+		delete(metadata, field)
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		delete(annotations, "kubectl.kubernetes.io/last-applied-configuration")
+	}
+}
+
 // ConvertToModelInfo converts a Kubernetes object to ModelInfo
 func ConvertToModelInfo(obj map[string]interface{}) ModelInfo {
 	modelInfo := ModelInfo{
@@ -50,6 +84,10 @@ func ConvertToModelInfo(obj map[string]interface{}) ModelInfo {
 		if creationTimestamp, ok := metadata["creationTimestamp"].(string); ok {
 			modelInfo.CreatedAt = parseTime(creationTimestamp)
 		}
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			modelInfo.Disabled = annotations[ModelDisabledAnnotation] == "true"
+		}
+		modelInfo.CreatedBy = modelCreatedBy(obj)
 		modelInfo.Metadata = metadata
 	}
 	
@@ -59,8 +97,13 @@ func ConvertToModelInfo(obj map[string]interface{}) ModelInfo {
 		if predictor, ok := spec["predictor"].(map[string]interface{}); ok {
 			modelInfo.Predictor = predictor
 		}
+		modelInfo.Framework = detectFramework(spec)
 	}
 	
+	// Use the publishing detection rules, so the UI can offer the publish mode that will be used
+	modelInfo.ModelType, modelInfo.ModelTypeReason = detectModelTypeFromSpec(obj, ActiveConfig().ModelTypeDetection)
+	modelInfo.SupportsOpenAI = modelInfo.ModelType == "openai"
+	
 	// Extract status
 	if status, ok := obj["status"].(map[string]interface{}); ok {
 		modelInfo.FullStatus = status
@@ -146,7 +189,9 @@ func ConvertToModelInfo(obj map[string]interface{}) ModelInfo {
 		}
 		
 		// Set model status
-		if modelInfo.Ready {
+		if modelInfo.Disabled {
+			modelInfo.Status = "Disabled"
+		} else if modelInfo.Ready {
 			modelInfo.Status = "Ready"
 		} else if statusDetails.Phase != "" {
 			modelInfo.Status = statusDetails.Phase
@@ -183,6 +228,459 @@ func GenerateModelYAML(modelName, namespace string, config ModelConfig) (map[str
 		},
 	}
 
+	// Extra containers are emitted into the predictor pod spec
+	predictor := inferenceService["spec"].(map[string]interface{})["predictor"].(map[string]interface{})
+	if config.ContainerConcurrency != nil {
+		predictor["containerConcurrency"] = *config.ContainerConcurrency
+	}
+	annotations := map[string]interface{}{}
+	if config.TargetBurstCapacity != nil {
+		annotations[TargetBurstCapacityAnnotation] = strconv.Itoa(*config.TargetBurstCapacity)
+	}
+	if mode, ok := deploymentModeAnnotationValues[config.DeploymentMode]; ok {
+		annotations[DeploymentModeAnnotation] = mode
+	}
+	if len(annotations) > 0 {
+		inferenceService["metadata"].(map[string]interface{})["annotations"] = annotations
+	}
+	// Probes go on the predictor container itself, which KServe names kserve-container
+	frameworkSpec := predictor[config.Framework].(map[string]interface{})
+	if config.ReadinessProbe != nil {
+		frameworkSpec["readinessProbe"] = probeSpecToManifest(*config.ReadinessProbe)
+	}
+	if config.LivenessProbe != nil {
+		frameworkSpec["livenessProbe"] = probeSpecToManifest(*config.LivenessProbe)
+	}
+	if len(config.InitContainers) > 0 {
+		predictor["initContainers"] = containerSpecsToManifest(config.InitContainers)
+	}
+	if len(config.Sidecars) > 0 {
+		predictor["containers"] = containerSpecsToManifest(config.Sidecars)
+	}
+
 	return inferenceService, nil
 }
 
+// Predictor fields managed by the API, in the order they are reported by diffModelSpecs
+var managedPredictorFields = []string{"minReplicas", "maxReplicas", "scaleTarget", "scaleMetric", "containerConcurrency", "initContainers", "containers"}
+
+// Knative annotation controlling how much spare capacity the activator keeps buffered
+const TargetBurstCapacityAnnotation = "autoscaling.knative.dev/target-burst-capacity"
+
+// KServe annotation selecting between Knative (Serverless) and plain Deployment (RawDeployment) serving
+const DeploymentModeAnnotation = "serving.kserve.io/deploymentMode"
+
+// Deployment modes accepted by the API and the annotation values they map to
+const (
+	DeploymentModeServerless = "serverless"
+	DeploymentModeRaw        = "raw"
+)
+
+var deploymentModeAnnotationValues = map[string]string{
+	DeploymentModeServerless: "Serverless",
+	DeploymentModeRaw:        "RawDeployment",
+}
+
+// deploymentModeFromAnnotation maps a deploymentMode annotation value back to the API value,
+// returning "" for modes the API does not manage such as ModelMesh
+func deploymentModeFromAnnotation(value string) string {
+	for mode, annotation := range deploymentModeAnnotationValues {
+		if value == annotation {
+			return mode
+		}
+	}
+	return ""
+}
+
+// diffModelSpecs compares the API-managed fields (framework, storageUri, replicas, scaling,
+// resources, probes and extra containers) of two InferenceService manifests
+func diffModelSpecs(current, proposed map[string]interface{}, frameworks []Framework) []FieldChange {
+	currentFields := managedSpecFields(current, frameworks)
+	proposedFields := managedSpecFields(proposed, frameworks)
+
+	fields := append(append([]string{"framework", "storageUri", "resources", "readinessProbe", "livenessProbe"}, managedPredictorFields...), "targetBurstCapacity", "deploymentMode")
+	changes := []FieldChange{}
+	for _, field := range fields {
+		oldValue, newValue := currentFields[field], proposedFields[field]
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, FieldChange{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+
+	return changes
+}
+
+// managedSpecFields extracts the API-managed fields from an InferenceService manifest. The
+// manifest is round-tripped through JSON so generated and fetched specs compare equal.
+func managedSpecFields(manifest map[string]interface{}, frameworks []Framework) map[string]interface{} {
+	fields := make(map[string]interface{})
+
+	var normalized map[string]interface{}
+	data, err := json.Marshal(manifest)
+	if err != nil || json.Unmarshal(data, &normalized) != nil {
+		return fields
+	}
+
+	spec, _ := normalized["spec"].(map[string]interface{})
+	predictor, _ := spec["predictor"].(map[string]interface{})
+	if predictor == nil {
+		return fields
+	}
+
+	for _, field := range managedPredictorFields {
+		if value, ok := predictor[field]; ok {
+			fields[field] = value
+		}
+	}
+
+	metadata, _ := normalized["metadata"].(map[string]interface{})
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	if value, ok := annotations[TargetBurstCapacityAnnotation]; ok {
+		fields["targetBurstCapacity"] = value
+	}
+	if value, ok := annotations[DeploymentModeAnnotation]; ok {
+		fields["deploymentMode"] = value
+	}
+
+	for _, framework := range frameworks {
+		if frameworkConfig, ok := predictor[framework.Name].(map[string]interface{}); ok {
+			fields["framework"] = framework.Name
+			if storageUri, ok := frameworkConfig["storageUri"]; ok {
+				fields["storageUri"] = storageUri
+			}
+			if resources, ok := frameworkConfig["resources"]; ok {
+				fields["resources"] = resources
+			}
+			for _, probe := range []string{"readinessProbe", "livenessProbe"} {
+				if value, ok := frameworkConfig[probe]; ok {
+					fields[probe] = value
+				}
+			}
+			break
+		}
+	}
+
+	return fields
+}
+
+// ValidateConcurrencySettings checks the optional queue-proxy concurrency settings are non-negative
+func ValidateConcurrencySettings(config ModelConfig) error {
+	if config.ContainerConcurrency != nil && *config.ContainerConcurrency < 0 {
+		return fmt.Errorf("containerConcurrency must be non-negative, got %d", *config.ContainerConcurrency)
+	}
+	if config.TargetBurstCapacity != nil && *config.TargetBurstCapacity < 0 {
+		return fmt.Errorf("targetBurstCapacity must be non-negative, got %d", *config.TargetBurstCapacity)
+	}
+	return nil
+}
+
+// ValidateDeploymentMode checks the deployment mode and the settings that depend on it. RawDeployment
+// scales with a HorizontalPodAutoscaler, so it cannot scale to zero, only scales on cpu or memory,
+// and has no Knative activator to apply targetBurstCapacity.
+func ValidateDeploymentMode(config ModelConfig) error {
+	switch config.DeploymentMode {
+	case "", DeploymentModeServerless:
+		return nil
+	case DeploymentModeRaw:
+	default:
+		return fmt.Errorf("deploymentMode must be %s or %s, got %q", DeploymentModeServerless, DeploymentModeRaw, config.DeploymentMode)
+	}
+
+	if config.MinReplicas < 1 {
+		return fmt.Errorf("minReplicas must be at least 1 in raw deployment mode, scale to zero requires serverless")
+	}
+	if config.ScaleMetric != "cpu" && config.ScaleMetric != "memory" {
+		return fmt.Errorf("scaleMetric must be cpu or memory in raw deployment mode, got %q", config.ScaleMetric)
+	}
+	if config.TargetBurstCapacity != nil {
+		return fmt.Errorf("targetBurstCapacity requires serverless deployment mode")
+	}
+	return nil
+}
+
+// Port KServe predictor containers listen on, used for probes that do not set one
+const defaultProbePort = 8080
+
+// ValidateProbes checks the optional readiness and liveness probes have an absolute path,
+// a valid port and a non-negative initial delay
+func ValidateProbes(config ModelConfig) error {
+	probes := []struct {
+		field string
+		probe *ProbeSpec
+	}{
+		{"readinessProbe", config.ReadinessProbe},
+		{"livenessProbe", config.LivenessProbe},
+	}
+	for _, p := range probes {
+		if p.probe == nil {
+			continue
+		}
+		if !strings.HasPrefix(p.probe.Path, "/") {
+			return fmt.Errorf("%s.path must start with /, got %q", p.field, p.probe.Path)
+		}
+		if strings.ContainsAny(p.probe.Path, " ?#") {
+			return fmt.Errorf("%s.path must be a plain path without spaces, query or fragment, got %q", p.field, p.probe.Path)
+		}
+		if p.probe.Port != 0 {
+			if errs := validation.IsValidPortNum(p.probe.Port); len(errs) > 0 {
+				return fmt.Errorf("%s.port is invalid: %s", p.field, strings.Join(errs, "; "))
+			}
+		}
+		if p.probe.InitialDelaySeconds < 0 {
+			return fmt.Errorf("%s.initialDelaySeconds must be non-negative, got %d", p.field, p.probe.InitialDelaySeconds)
+		}
+	}
+	return nil
+}
+
+// Container names reserved by KServe and Knative in predictor pods
+var reservedContainerNames = map[string]bool{
+	"kserve-container":    true,
+	"queue-proxy":         true,
+	"storage-initializer": true,
+	"istio-proxy":         true,
+	"istio-init":          true,
+}
+
+// ValidateContainerSpecs checks init and sidecar containers for valid, unique names and
+// images from an allowed registry. An empty allow list rejects all extra containers.
+func ValidateContainerSpecs(containers []ContainerSpec, allowedRegistries []string) error {
+	if len(containers) > 0 && len(allowedRegistries) == 0 {
+		return fmt.Errorf("init containers and sidecars are disabled, ALLOWED_IMAGE_REGISTRIES is not configured")
+	}
+
+	names := make(map[string]bool)
+	for _, container := range containers {
+		if errs := validation.IsDNS1123Label(container.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid container name %q: %s", container.Name, strings.Join(errs, "; "))
+		}
+		if reservedContainerNames[container.Name] {
+			return fmt.Errorf("container name %q is reserved", container.Name)
+		}
+		if names[container.Name] {
+			return fmt.Errorf("duplicate container name %q", container.Name)
+		}
+		names[container.Name] = true
+
+		if container.Image == "" {
+			return fmt.Errorf("container %s has no image", container.Name)
+		}
+		if !isImageAllowed(container.Image, allowedRegistries) {
+			return fmt.Errorf("image %s of container %s is not from an allowed registry (%s)", container.Image, container.Name, strings.Join(allowedRegistries, ", "))
+		}
+		for _, env := range container.Env {
+			if env.Name == "" {
+				return fmt.Errorf("container %s has an environment variable without a name", container.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isImageAllowed reports whether an image reference belongs to one of the allowed registries or
+// registry paths, e.g. "ghcr.io" or "ghcr.io/my-org". Images without a registry are on docker.io.
+func isImageAllowed(image string, allowedRegistries []string) bool {
+	reference := image
+	if first := strings.SplitN(image, "/", 2)[0]; !strings.ContainsAny(first, ".:") && first != "localhost" {
+		reference = "docker.io/" + image
+	}
+
+	for _, allowed := range allowedRegistries {
+		allowed = strings.TrimSuffix(allowed, "/")
+		if strings.HasPrefix(reference, allowed+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func containerSpecsToManifest(containers []ContainerSpec) []interface{} {
+	var manifest []interface{}
+	for _, container := range containers {
+		entry := map[string]interface{}{
+			"name":  container.Name,
+			"image": container.Image,
+		}
+		if len(container.Command) > 0 {
+			entry["command"] = container.Command
+		}
+		if len(container.Args) > 0 {
+			entry["args"] = container.Args
+		}
+		if len(container.Env) > 0 {
+			var env []interface{}
+			for _, variable := range container.Env {
+				env = append(env, map[string]interface{}{"name": variable.Name, "value": variable.Value})
+			}
+			entry["env"] = env
+		}
+		manifest = append(manifest, entry)
+	}
+	return manifest
+}
+
+// manifestContainerSpecs returns the init containers and sidecars of an InferenceService manifest's
+// predictor, so imported manifests are held to the same registry allowlist as created models. The
+// predictor's own container, named kserve-container or left unnamed as the first container, is
+// not an extra container and is skipped.
+func manifestContainerSpecs(manifest map[string]interface{}) []ContainerSpec {
+	spec, _ := manifest["spec"].(map[string]interface{})
+	predictor, _ := spec["predictor"].(map[string]interface{})
+
+	var containers []ContainerSpec
+	for _, listKey := range []string{"initContainers", "containers"} {
+		list, _ := predictor[listKey].([]interface{})
+		for i, item := range list {
+			entry, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := entry["name"].(string)
+			if listKey == "containers" && (name == "kserve-container" || (i == 0 && name == "")) {
+				continue
+			}
+			container := ContainerSpec{Name: name}
+			container.Image, _ = entry["image"].(string)
+			env, _ := entry["env"].([]interface{})
+			for _, item := range env {
+				if variable, ok := item.(map[string]interface{}); ok {
+					name, _ := variable["name"].(string)
+					value, _ := variable["value"].(string)
+					container.Env = append(container.Env, ContainerEnv{Name: name, Value: value})
+				}
+			}
+			containers = append(containers, container)
+		}
+	}
+	return containers
+}
+
+// probeSpecToManifest converts a probe into an httpGet container probe
+func probeSpecToManifest(probe ProbeSpec) map[string]interface{} {
+	port := probe.Port
+	if port == 0 {
+		port = defaultProbePort
+	}
+	manifest := map[string]interface{}{
+		"httpGet": map[string]interface{}{
+			"path": probe.Path,
+			"port": port,
+		},
+	}
+	if probe.InitialDelaySeconds > 0 {
+		manifest["initialDelaySeconds"] = probe.InitialDelaySeconds
+	}
+	return manifest
+}
+
+// manifestToProbeSpec reads an httpGet probe from an existing predictor container, returning nil
+// for probes of other kinds or with named ports, which the API does not manage
+func manifestToProbeSpec(value interface{}) *ProbeSpec {
+	manifest, _ := value.(map[string]interface{})
+	httpGet, _ := manifest["httpGet"].(map[string]interface{})
+	path, _ := httpGet["path"].(string)
+	port, ok := httpGet["port"].(float64)
+	if path == "" || !ok {
+		return nil
+	}
+	probe := &ProbeSpec{Path: path, Port: int(port)}
+	if delay, ok := manifest["initialDelaySeconds"].(float64); ok {
+		probe.InitialDelaySeconds = int(delay)
+	}
+	return probe
+}
+
+// manifestToContainerSpecs reads containers from an existing predictor spec
+func manifestToContainerSpecs(value interface{}) []ContainerSpec {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var containers []ContainerSpec
+	if err := json.Unmarshal(data, &containers); err != nil {
+		return nil
+	}
+	return containers
+}
+
+// ManifestFramework returns the framework declared by an InferenceService predictor, if any
+func ManifestFramework(manifest map[string]interface{}, frameworks []Framework) string {
+	spec, _ := manifest["spec"].(map[string]interface{})
+	predictor, _ := spec["predictor"].(map[string]interface{})
+	if predictor == nil {
+		return ""
+	}
+
+	// New-style predictor: spec.predictor.model.modelFormat.name
+	if model, ok := predictor["model"].(map[string]interface{}); ok {
+		if modelFormat, ok := model["modelFormat"].(map[string]interface{}); ok {
+			if name, ok := modelFormat["name"].(string); ok {
+				return name
+			}
+		}
+	}
+
+	// Legacy predictor: spec.predictor.<framework>
+	for _, framework := range frameworks {
+		if _, ok := predictor[framework.Name]; ok {
+			return framework.Name
+		}
+	}
+	for _, name := range []string{"lightgbm", "paddle", "pmml", "triton", "huggingface"} {
+		if _, ok := predictor[name]; ok {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// detectFramework returns the framework of an InferenceService spec: the declared model format,
+// else the known framework key of a legacy predictor, else "custom" for predictors that only run
+// their own containers. It returns "" when none of these apply.
+func detectFramework(spec map[string]interface{}) string {
+	if framework := ManifestFramework(map[string]interface{}{"spec": spec}, ActiveConfig().SupportedFrameworks); framework != "" {
+		return strings.ToLower(framework)
+	}
+
+	predictor, _ := spec["predictor"].(map[string]interface{})
+	if containers, ok := predictor["containers"].([]interface{}); ok && len(containers) > 0 {
+		return "custom"
+	}
+	return ""
+}
+
+// logDays returns the dates, in the service's time zone, of the daily logs covering start to end.
+// Daily logs are named by local date, so the days run from local midnight, not UTC midnight.
+func logDays(start, end time.Time) []string {
+	start, end = start.In(time.Local), end.In(time.Local)
+
+	var days []string
+	for d := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local); !d.After(end); d = d.AddDate(0, 0, 1) {
+		days = append(days, d.Format("2006-01-02"))
+	}
+	return days
+}
+
+// parseDuration parses a Go duration string such as "30s" or "5m" and checks it lies within
+// [min, max]. A max of 0 means no upper bound. Bare numbers without a unit are rejected.
+func parseDuration(value string, min, max time.Duration) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("duration is empty")
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: expected a number with a unit such as 30s, 5m or 1h", value)
+	}
+	if duration < min {
+		return 0, fmt.Errorf("duration %s is below the minimum of %s", value, min)
+	}
+	if max > 0 && duration > max {
+		return 0, fmt.Errorf("duration %s exceeds the maximum of %s", value, max)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		min     time.Duration
+		max     time.Duration
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "seconds", value: "30s", min: time.Second, max: time.Minute, want: 30 * time.Second},
+		{name: "surrounding spaces", value: " 5m ", min: time.Second, max: time.Hour, want: 5 * time.Minute},
+		{name: "zero allowed by min", value: "0s", min: 0, max: time.Minute, want: 0},
+		{name: "zero below min", value: "0s", min: time.Second, max: time.Minute, wantErr: true},
+		{name: "negative", value: "-5s", min: 0, max: time.Minute, wantErr: true},
+		{name: "missing unit", value: "10", min: 0, max: time.Minute, wantErr: true},
+		{name: "empty", value: "", min: 0, max: time.Minute, wantErr: true},
+		{name: "blank", value: "   ", min: 0, max: time.Minute, wantErr: true},
+		{name: "not a duration", value: "soon", min: 0, max: time.Minute, wantErr: true},
+		{name: "below min", value: "500ms", min: time.Second, max: time.Minute, wantErr: true},
+		{name: "at min", value: "1s", min: time.Second, max: time.Minute, want: time.Second},
+		{name: "at max", value: "1m", min: time.Second, max: time.Minute, want: time.Minute},
+		{name: "above max", value: "61s", min: time.Second, max: time.Minute, wantErr: true},
+		{name: "no upper bound", value: "720h", min: time.Second, max: 0, want: 720 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDuration(tt.value, tt.min, tt.max)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseDuration(%q) = %s, want an error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDuration(%q) returned error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseDuration(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogDays(t *testing.T) {
+	// Daily logs are named by local date, so run in a zone far from UTC
+	local := time.Local
+	time.Local = time.FixedZone("UTC-5", -5*60*60)
+	defer func() { time.Local = local }()
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  []string
+	}{
+		{
+			name:  "same local day",
+			start: time.Date(2024, 3, 10, 1, 0, 0, 0, time.Local),
+			end:   time.Date(2024, 3, 10, 23, 0, 0, 0, time.Local),
+			want:  []string{"2024-03-10"},
+		},
+		{
+			// 20:00 local is already the next day in UTC
+			name:  "evening start",
+			start: time.Date(2024, 3, 10, 20, 0, 0, 0, time.Local),
+			end:   time.Date(2024, 3, 11, 9, 0, 0, 0, time.Local),
+			want:  []string{"2024-03-10", "2024-03-11"},
+		},
+		{
+			// 02:00 UTC is the previous local day
+			name:  "bounds given in UTC",
+			start: time.Date(2024, 3, 11, 2, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 3, 12, 3, 0, 0, 0, time.UTC),
+			want:  []string{"2024-03-10", "2024-03-11"},
+		},
+		{
+			name:  "end at local midnight",
+			start: time.Date(2024, 3, 10, 12, 0, 0, 0, time.Local),
+			end:   time.Date(2024, 3, 12, 0, 0, 0, 0, time.Local),
+			want:  []string{"2024-03-10", "2024-03-11", "2024-03-12"},
+		},
+		{
+			name:  "end before start",
+			start: time.Date(2024, 3, 12, 12, 0, 0, 0, time.Local),
+			end:   time.Date(2024, 3, 10, 12, 0, 0, 0, time.Local),
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := logDays(tt.start, tt.end); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("logDays() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}